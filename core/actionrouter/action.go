@@ -10,6 +10,7 @@ import (
 	"github.com/opensvc/om3/core/clientcontext"
 	"github.com/opensvc/om3/core/monitor"
 	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/util/testreport"
 )
 
 type (
@@ -63,6 +64,12 @@ type (
 		//
 		TargetOptions any
 
+		//
+		// DryRun asks the daemon for the plan of the orchestration needed
+		// to reach the Target, instead of queuing the orchestration.
+		//
+		DryRun bool
+
 		// Wait runs an event watcher to wait for target state or global expect reached
 		Wait bool
 
@@ -145,12 +152,31 @@ func (t Result) Unstructured() map[string]any {
 	}
 }
 
+// TestSuites returns the test suites of the result data, if renderable as
+// test suites, with the hostname set to the result nodename.
+func (t Result) TestSuites() []testreport.Suite {
+	i, ok := t.Data.(testreport.Suiter)
+	if !ok {
+		return nil
+	}
+	suites := i.TestSuites()
+	for j := range suites {
+		if suites[j].Hostname == "" {
+			suites[j].Hostname = t.Nodename
+		}
+	}
+	return suites
+}
+
 // Do is the switch method between local, remote or async mode.
 // If Watch is set, end up starting a monitor on the selected objects.
+// If DryRun is set, only the async mode plan is requested.
 func Do(t Actioner) error {
 	var errs error
 	o := t.Options()
 	switch {
+	case o.DryRun && o.Target != "":
+		errs = t.DoAsync()
 	case o.NodeSelector != "":
 		errs = t.DoRemote()
 	case o.Local, o.DefaultIsLocal, o.RID != "", o.Subset != "", o.Tag != "":
@@ -163,7 +189,7 @@ func Do(t Actioner) error {
 		// post action on context endpoint
 		errs = t.DoRemote()
 	}
-	if o.Watch {
+	if o.Watch && !o.DryRun {
 		m := monitor.New()
 		m.SetColor(o.Color)
 		m.SetFormat(o.Output)
@@ -4,9 +4,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 
+	"github.com/opensvc/om3/core/driver"
 	"github.com/opensvc/om3/core/provisioned"
 	"github.com/opensvc/om3/core/resource"
+	"github.com/opensvc/om3/core/xconfig"
+	"github.com/opensvc/om3/util/device"
 )
 
 type (
@@ -28,6 +32,12 @@ type (
 		Instance    string `json:"instance"`
 		Unit        string `json:"unit"`
 		Value       int64  `json:"value"`
+
+		// Status is the state of the check instance, ok if not set.
+		Status string `json:"status,omitempty"`
+
+		// Hint is the suggested action to fix a check instance not ok.
+		Hint string `json:"hint,omitempty"`
 	}
 
 	header interface {
@@ -36,6 +46,25 @@ type (
 	resourceLister interface {
 		Resources() resource.Drivers
 	}
+	nodeConfiger interface {
+		MergedConfig() *xconfig.T
+	}
+	devExposer interface {
+		ExposedDevices() device.L
+	}
+	devUser interface {
+		SubDevices() device.L
+	}
+	devClaimer interface {
+		ClaimedDevices() device.L
+	}
+)
+
+const (
+	StatusOk   = "ok"
+	StatusWarn = "warn"
+	StatusNok  = "nok"
+	StatusNA   = "n/a"
 )
 
 var checkers = make([]Checker, 0)
@@ -99,3 +128,101 @@ func ObjectPathClaimingDir(p string, objs []interface{}) string {
 	}
 	return ""
 }
+
+// isProvisioned returns true if the resource provisioned state is not
+// false.
+func isProvisioned(r resource.Driver) bool {
+	if v, err := r.Provisioned(); err != nil {
+		return false
+	} else if v == provisioned.False {
+		return false
+	}
+	return true
+}
+
+// ObjectPathClaimingResource returns the first object with a provisioned
+// resource of one of the drivers and labelled as the check instance. For
+// example the "disk.vg" resource labelled with the volume group name.
+func ObjectPathClaimingResource(label string, objs []interface{}, drvIDs ...driver.ID) string {
+	for _, obj := range objs {
+		b, ok := obj.(resourceLister)
+		if !ok {
+			continue
+		}
+		for _, r := range b.Resources() {
+			if r.Label() != label {
+				continue
+			}
+			drvID := r.Manifest().DriverID
+			for _, id := range drvIDs {
+				if id == drvID && isProvisioned(r) {
+					return fmt.Sprint(obj)
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// ObjectPathClaimingDevice returns the first object with a provisioned
+// resource exposing, using or claiming one of the device paths. The paths
+// are compared after symlinks resolution, so the /dev/mapper/<name> and
+// /dev/dm-<minor> aliases are equivalent.
+func ObjectPathClaimingDevice(objs []interface{}, paths ...string) string {
+	want := make(map[string]any)
+	for _, p := range paths {
+		want[p] = nil
+		if resolved, err := filepath.EvalSymlinks(p); err == nil {
+			want[resolved] = nil
+		}
+	}
+	has := func(l device.L) bool {
+		for _, dev := range l {
+			p := dev.Path()
+			if _, ok := want[p]; ok {
+				return true
+			}
+			if resolved, err := filepath.EvalSymlinks(p); err == nil {
+				if _, ok := want[resolved]; ok {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	for _, obj := range objs {
+		b, ok := obj.(resourceLister)
+		if !ok {
+			continue
+		}
+		for _, r := range b.Resources() {
+			var i interface{} = r
+			var l device.L
+			if o, ok := i.(devExposer); ok {
+				l = append(l, o.ExposedDevices()...)
+			}
+			if o, ok := i.(devUser); ok {
+				l = append(l, o.SubDevices()...)
+			}
+			if o, ok := i.(devClaimer); ok {
+				l = append(l, o.ClaimedDevices()...)
+			}
+			if has(l) && isProvisioned(r) {
+				return fmt.Sprint(obj)
+			}
+		}
+	}
+	return ""
+}
+
+// NodeConfig returns the merged configuration of the node object passed
+// to the checkers along with the svc and vol objects, or nil if the node
+// object is not passed.
+func NodeConfig(objs []interface{}) *xconfig.T {
+	for _, obj := range objs {
+		if o, ok := obj.(nodeConfiger); ok {
+			return o.MergedConfig()
+		}
+	}
+	return nil
+}
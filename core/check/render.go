@@ -17,6 +17,7 @@ func (t ResultSet) Render() string {
 	tree.AddColumn().AddText("object")
 	tree.AddColumn().AddText("value")
 	tree.AddColumn().AddText("unit")
+	tree.AddColumn().AddText("status")
 	for _, r := range t.Data {
 		n := tree.AddNode()
 		n.AddColumn().AddText(r.DriverGroup).SetColor(rawconfig.Color.Primary)
@@ -25,6 +26,13 @@ func (t ResultSet) Render() string {
 		n.AddColumn().AddText(r.Path)
 		n.AddColumn().AddText(fmt.Sprintf("%d", r.Value))
 		n.AddColumn().AddText(r.Unit)
+		status := n.AddColumn().AddText(r.Status)
+		switch r.Status {
+		case StatusWarn:
+			status.SetColor(rawconfig.Color.Warning)
+		case StatusNok:
+			status.SetColor(rawconfig.Color.Error)
+		}
 	}
 	return tree.Render()
 }
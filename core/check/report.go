@@ -0,0 +1,42 @@
+package check
+
+import (
+	"fmt"
+
+	"github.com/opensvc/om3/util/hostname"
+	"github.com/opensvc/om3/util/testreport"
+)
+
+// TestSuites returns the result set as a test suite, with a case per check
+// instance.
+func (t ResultSet) TestSuites() []testreport.Suite {
+	suite := testreport.Suite{
+		Name:     "checks",
+		Hostname: hostname.Hostname(),
+		Cases:    make([]testreport.Case, len(t.Data)),
+	}
+	for i, r := range t.Data {
+		c := testreport.Case{
+			Name:      r.Instance,
+			ClassName: r.DriverGroup + "." + r.DriverName,
+			Output:    fmt.Sprintf("value: %d%s", r.Value, r.Unit),
+			Hint:      r.Hint,
+		}
+		if r.Path != "" {
+			c.Output += "\nobject: " + r.Path
+		}
+		switch r.Status {
+		case "", StatusOk:
+			c.State = testreport.StatePass
+			c.Hint = ""
+		case StatusNA:
+			c.State = testreport.StateNA
+			c.Message = "not applicable"
+		default:
+			c.State = testreport.StateFail
+			c.Message = fmt.Sprintf("%s %s: %d%s", r.DriverGroup, r.Status, r.Value, r.Unit)
+		}
+		suite.Cases[i] = c
+	}
+	return []testreport.Suite{suite}
+}
@@ -0,0 +1,55 @@
+package check_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/core/check"
+)
+
+func TestParseThreshold(t *testing.T) {
+	cases := map[string]check.Threshold{
+		"fs_u>=90%,98%":           {DriverGroup: "fs_u", Op: ">=", Unit: "%", Warn: 90, Crit: 98, HasCrit: true},
+		"fs_u:/srv/*>=90,98%":     {DriverGroup: "fs_u", Instance: "/srv/*", Op: ">=", Unit: "%", Warn: 90, Crit: 98, HasCrit: true},
+		"mem_u:mem.avail<=10%,5%": {DriverGroup: "mem_u", Instance: "mem.avail", Op: "<=", Unit: "%", Warn: 10, Crit: 5, HasCrit: true},
+		"mpath<2":                 {DriverGroup: "mpath", Op: "<", Warn: 2},
+	}
+	for s, expected := range cases {
+		t.Run(s, func(t *testing.T) {
+			threshold, err := check.ParseThreshold(s)
+			require.NoError(t, err)
+			assert.Equal(t, expected, threshold)
+		})
+	}
+	for _, s := range []string{"", "fs_u", "fs_u=90", "fs_u>=90%,98kb", ">=90", "fs_u:[>=90"} {
+		t.Run("invalid "+s, func(t *testing.T) {
+			_, err := check.ParseThreshold(s)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestApplyThresholds(t *testing.T) {
+	rs := check.NewResultSet()
+	rs.Push(check.Result{DriverGroup: "fs_u", Instance: "/", Unit: "%", Value: 50})
+	rs.Push(check.Result{DriverGroup: "fs_u", Instance: "/srv/svc1", Unit: "%", Value: 92, Path: "svc1"})
+	rs.Push(check.Result{DriverGroup: "fs_u", Instance: "/srv/svc2", Unit: "%", Value: 99, Path: "svc2"})
+	rs.Push(check.Result{DriverGroup: "fs_u", Instance: "/srv/svc2", Unit: "kb", Value: 99, Path: "svc2"})
+	rs.Push(check.Result{DriverGroup: "mpath", Instance: "3600", Unit: "paths", Value: 0, Status: check.StatusNok})
+
+	objThresholds, err := check.ParseThresholds("svc1", []string{"fs_u>=95%"})
+	require.NoError(t, err)
+	nodeThresholds, err := check.ParseThresholds("", []string{"fs_u>=90%,98%", "mpath<2"})
+	require.NoError(t, err)
+	rs.ApplyThresholds(append(objThresholds, nodeThresholds...))
+
+	assert.Equal(t, check.StatusOk, rs.Data[0].Status, "below the node warn threshold")
+	assert.Equal(t, check.StatusOk, rs.Data[1].Status, "the object threshold overrides the node threshold")
+	assert.Equal(t, check.StatusNok, rs.Data[2].Status, "above the node crit threshold")
+	assert.Equal(t, "", rs.Data[3].Status, "unit not matching")
+	assert.Equal(t, check.StatusNok, rs.Data[4].Status, "driver status is more severe")
+	assert.True(t, rs.Data[2].IsBreached())
+	assert.False(t, rs.Data[1].IsBreached())
+}
@@ -0,0 +1,196 @@
+package check
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strconv"
+)
+
+type (
+	// Threshold is a warn and an optional crit limit on the value of the
+	// check instances of a driver group.
+	//
+	// Its string form is:
+	//
+	//	<group>[:<instance glob>]<op><warn>[<unit>][,<crit>[<unit>]]
+	//
+	// where op is one of >=, >, <=, <. For example:
+	//
+	//	fs_u>=90%,98%
+	//	mem_u:mem.avail<=10%,5%
+	//	mpath<2
+	Threshold struct {
+		// Path restricts the threshold to the check instances of an
+		// object. An empty path matches all check instances.
+		Path string
+
+		DriverGroup string
+
+		// Instance is a glob pattern matching the check instance names.
+		// An empty pattern matches all check instances.
+		Instance string
+
+		Op string
+
+		// Unit restricts the threshold to the check instances with
+		// this unit. An empty unit matches all check instances.
+		Unit string
+
+		Warn    int64
+		Crit    int64
+		HasCrit bool
+	}
+
+	// Thresholds is an ordered list of thresholds. The first threshold
+	// matching a check instance applies.
+	Thresholds []Threshold
+)
+
+var thresholdRegexp = regexp.MustCompile(`^([^:<>=\s]+)(?::([^<>=]+))?(>=|<=|>|<)(-?\d+)([^,\d\s]*)(?:,(-?\d+)([^,\d\s]*))?$`)
+
+// ParseThreshold returns the Threshold described by s.
+func ParseThreshold(s string) (Threshold, error) {
+	var t Threshold
+	m := thresholdRegexp.FindStringSubmatch(s)
+	if m == nil {
+		return t, fmt.Errorf("invalid threshold '%s': expected <group>[:<instance>]<op><warn>[,<crit>]", s)
+	}
+	t.DriverGroup = m[1]
+	t.Instance = m[2]
+	t.Op = m[3]
+	t.Warn, _ = strconv.ParseInt(m[4], 10, 64)
+	t.Unit = m[5]
+	if m[6] != "" {
+		t.Crit, _ = strconv.ParseInt(m[6], 10, 64)
+		t.HasCrit = true
+		switch {
+		case t.Unit == "":
+			t.Unit = m[7]
+		case m[7] != "" && m[7] != t.Unit:
+			return t, fmt.Errorf("invalid threshold '%s': warn and crit units differ", s)
+		}
+	}
+	if _, err := filepath.Match(t.Instance, ""); err != nil {
+		return t, fmt.Errorf("invalid threshold '%s': %w", s, err)
+	}
+	return t, nil
+}
+
+// ParseThresholds returns the Thresholds described by l, with their Path
+// set to path.
+func ParseThresholds(path string, l []string) (Thresholds, error) {
+	thresholds := make(Thresholds, 0, len(l))
+	for _, s := range l {
+		t, err := ParseThreshold(s)
+		if err != nil {
+			return thresholds, err
+		}
+		t.Path = path
+		thresholds = append(thresholds, t)
+	}
+	return thresholds, nil
+}
+
+// Match returns true if the threshold applies to the check instance r.
+func (t Threshold) Match(r Result) bool {
+	if t.DriverGroup != r.DriverGroup {
+		return false
+	}
+	if t.Path != "" && t.Path != r.Path {
+		return false
+	}
+	if t.Unit != "" && t.Unit != r.Unit {
+		return false
+	}
+	if t.Instance != "" {
+		if ok, _ := filepath.Match(t.Instance, r.Instance); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func (t Threshold) exceeded(v, limit int64) bool {
+	switch t.Op {
+	case ">=":
+		return v >= limit
+	case ">":
+		return v > limit
+	case "<=":
+		return v <= limit
+	case "<":
+		return v < limit
+	default:
+		return false
+	}
+}
+
+// Eval returns StatusNok if v exceeds the crit limit, StatusWarn if v
+// exceeds the warn limit, and StatusOk otherwise.
+func (t Threshold) Eval(v int64) string {
+	switch {
+	case t.HasCrit && t.exceeded(v, t.Crit):
+		return StatusNok
+	case t.exceeded(v, t.Warn):
+		return StatusWarn
+	default:
+		return StatusOk
+	}
+}
+
+// String returns the threshold in the keyword value format.
+func (t Threshold) String() string {
+	s := t.DriverGroup
+	if t.Instance != "" {
+		s += ":" + t.Instance
+	}
+	s += fmt.Sprintf("%s%d%s", t.Op, t.Warn, t.Unit)
+	if t.HasCrit {
+		s += fmt.Sprintf(",%d%s", t.Crit, t.Unit)
+	}
+	return s
+}
+
+// Match returns the first threshold applying to the check instance r.
+func (t Thresholds) Match(r Result) (Threshold, bool) {
+	for _, threshold := range t {
+		if threshold.Match(r) {
+			return threshold, true
+		}
+	}
+	return Threshold{}, false
+}
+
+func statusSeverity(s string) int {
+	switch s {
+	case StatusNok:
+		return 2
+	case StatusWarn:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// IsBreached returns true if the check instance status is warn or nok.
+func (t Result) IsBreached() bool {
+	return statusSeverity(t.Status) > 0
+}
+
+// ApplyThresholds evaluates the value of the results against the first
+// matching threshold and sets their status. A status set by the check
+// driver is kept if it is more severe.
+func (t *ResultSet) ApplyThresholds(thresholds Thresholds) {
+	for i, r := range t.Data {
+		threshold, ok := thresholds.Match(r)
+		if !ok {
+			continue
+		}
+		s := threshold.Eval(r.Value)
+		if statusSeverity(s) < statusSeverity(r.Status) {
+			continue
+		}
+		t.Data[i].Status = s
+	}
+}
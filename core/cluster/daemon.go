@@ -25,6 +25,10 @@ type (
 	// of the cluster. Only one node runs a collector thread.
 	DaemonCollector struct {
 		DaemonSubsystemStatus
+
+		// SpoolDepth is the number of feed calls queued on disk, waiting
+		// for the collector to be reachable again.
+		SpoolDepth int `json:"spool_depth"`
 	}
 
 	// DaemonDNS describes the OpenSVC daemon dns thread, which is
@@ -28,6 +28,7 @@ type (
 		endpoint string
 		secret   string
 		log      *plog.Logger
+		spool    *Spool
 	}
 	Pinger struct {
 		ctx    context.Context
@@ -49,6 +50,12 @@ func (c *Client) SetLogger(log *plog.Logger) {
 	c.log = log
 }
 
+// SetSpool sets the spool where the feed calls are queued when the
+// collector is unreachable.
+func (c *Client) SetSpool(spool *Spool) {
+	c.spool = spool
+}
+
 func (c *Client) NewPinger() *Pinger {
 	pinger := Pinger{
 		id:     uuid.New(),
@@ -262,7 +269,15 @@ func LogSimpleResponse(response *jsonrpc.RPCResponse, log *plog.Logger) {
 }
 
 // Call executes a jsonrpc2 collector call and returns the response.
+//
+// If the client has a spool and the method is a spooled feed method, the
+// call is queued instead of failing when the collector is unreachable,
+// and an empty response is returned.
 func (c *Client) Call(method string, params ...interface{}) (*jsonrpc.RPCResponse, error) {
+	if c.spool != nil && IsSpooled(method) && !spooledMethods[method] && c.spool.Len() > 0 {
+		// keep the action logs ordered behind the queued calls
+		return c.queue(method, params, "older calls are queued")
+	}
 	response, err := c.client.Call(method, c.paramsWithAuth(params))
 	l := c.log.Attr("collector_rpc_method", method).Attr("collector_rpc_params", params)
 	if response != nil && response.Error != nil {
@@ -272,12 +287,66 @@ func (c *Client) Call(method string, params ...interface{}) (*jsonrpc.RPCRespons
 			l.Errorf("disable collector clients: call: %s: %s", method, err)
 			Alive.Store(false)
 		}
+		if c.spool != nil && IsSpooled(method) {
+			return c.queue(method, params, err.Error())
+		}
 	} else {
 		l.Infof("call: %s", method)
+		if c.spool != nil {
+			if err := c.spool.Drop(method); err != nil {
+				l.Warnf("call: %s: drop obsolete queued calls: %s", method, err)
+			}
+		}
 	}
 	return response, err
 }
 
+// queue pushes the call to the spool, and warns the call is not delivered
+// yet, as the caller sees a success.
+func (c *Client) queue(method string, params []interface{}, reason string) (*jsonrpc.RPCResponse, error) {
+	if err := c.spool.Push(method, params); err != nil {
+		return nil, fmt.Errorf("call: %s: queue: %w", method, err)
+	}
+	c.log.Attr("collector_rpc_method", method).Warnf("call: %s: not delivered to the collector (%s), queued in %s for replay", method, reason, c.spool)
+	return &jsonrpc.RPCResponse{JSONRPC: "2.0"}, nil
+}
+
+// Replay sends the spool queued calls, oldest first, and returns the
+// number of calls removed from the spool. It stops on the first
+// transport error, leaving the remaining calls queued. The calls
+// rejected by the collector are removed from the spool.
+func (c *Client) Replay(spool *Spool) (int, error) {
+	entries, err := spool.List()
+	if err != nil {
+		return 0, err
+	}
+	var n int
+	for _, entry := range entries {
+		params := make([]interface{}, len(entry.Params))
+		for i, param := range entry.Params {
+			params[i] = param
+		}
+		l := c.log.Attr("collector_rpc_method", entry.Method).Attr("collector_rpc_queued_at", entry.QueuedAt)
+		response, err := c.client.Call(entry.Method, c.paramsWithAuth(params))
+		if response != nil && response.Error != nil {
+			l.Warnf("replay: %s queued at %s: drop rejected call: %s: %s", entry.Method, entry.QueuedAt, response.Error.Message, response.Error.Data)
+		} else if err != nil {
+			if Alive.Load() {
+				l.Errorf("disable collector clients: replay: %s: %s", entry.Method, err)
+				Alive.Store(false)
+			}
+			return n, err
+		} else {
+			l.Infof("replay: %s queued at %s", entry.Method, entry.QueuedAt)
+		}
+		if err := spool.Remove(entry); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, nil
+}
+
 func (c *Client) CallFor(out interface{}, method string, params ...interface{}) error {
 	l := c.log.Attr("collector_rpc_method", method).Attr("collector_rpc_params", params)
 	err := c.client.CallFor(out, method, c.paramsWithAuth(params))
@@ -0,0 +1,132 @@
+package collector
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeCollector is a jsonrpc2 server recording the called methods. It
+// fails the http requests while down, and rejects the calls of the methods
+// listed in reject.
+type fakeCollector struct {
+	sync.Mutex
+	down    atomic.Bool
+	reject  map[string]bool
+	methods []string
+}
+
+func (t *fakeCollector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if t.down.Load() {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		return
+	}
+	var req struct {
+		ID     int    `json:"id"`
+		Method string `json:"method"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	t.Lock()
+	t.methods = append(t.methods, req.Method)
+	t.Unlock()
+	resp := map[string]any{"jsonrpc": "2.0", "id": req.ID}
+	if t.reject[req.Method] {
+		resp["error"] = map[string]any{"code": 1, "message": "rejected"}
+	} else {
+		resp["result"] = map[string]any{}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// calls returns and resets the methods called since the last calls.
+func (t *fakeCollector) calls() []string {
+	t.Lock()
+	defer t.Unlock()
+	l := t.methods
+	t.methods = nil
+	return l
+}
+
+func queuedMethods(t *testing.T, spool *Spool) []string {
+	t.Helper()
+	l, err := spool.List()
+	require.NoError(t, err)
+	methods := make([]string, 0, len(l))
+	for _, e := range l {
+		methods = append(methods, e.Method)
+	}
+	return methods
+}
+
+func TestClientSpool(t *testing.T) {
+	oriAlive := Alive.Load()
+	defer Alive.Store(oriAlive)
+
+	collector := &fakeCollector{reject: map[string]bool{"register_disks": true}}
+	srv := httptest.NewServer(collector)
+	defer srv.Close()
+
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	c, err := newClient(u, "secret")
+	require.NoError(t, err)
+	spool := NewSpool(t.TempDir())
+	c.SetSpool(spool)
+
+	t.Run("queue the feed calls while the collector is down", func(t *testing.T) {
+		collector.down.Store(true)
+		Alive.Store(true)
+		_, err := c.Call("begin_action", []string{"svcname"}, []string{"foo"})
+		require.NoError(t, err)
+		assert.False(t, Alive.Load(), "the collector clients are disabled")
+		_, err = c.Call("insert_pkg", []string{"pkg_name"}, [][]string{{"bash"}})
+		require.NoError(t, err)
+		_, err = c.Call("daemon_ping")
+		assert.Error(t, err, "the calls of the not spooled methods fail")
+		assert.Equal(t, []string{"begin_action", "insert_pkg"}, queuedMethods(t, spool))
+	})
+
+	t.Run("queue the action logs behind the queued calls", func(t *testing.T) {
+		collector.down.Store(false)
+		_, err := c.Call("res_action_batch", []string{"svcname"}, [][]string{{"foo"}})
+		require.NoError(t, err)
+		assert.Empty(t, collector.calls())
+		assert.Equal(t, []string{"begin_action", "insert_pkg", "res_action_batch"}, queuedMethods(t, spool))
+	})
+
+	t.Run("drop the obsolete queued calls on a delivered snapshot call", func(t *testing.T) {
+		_, err := c.Call("insert_pkg", []string{"pkg_name"}, [][]string{{"zsh"}})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"insert_pkg"}, collector.calls())
+		assert.Equal(t, []string{"begin_action", "res_action_batch"}, queuedMethods(t, spool))
+	})
+
+	t.Run("replay stops on transport error", func(t *testing.T) {
+		collector.down.Store(true)
+		n, err := c.Replay(spool)
+		assert.Error(t, err)
+		assert.Equal(t, 0, n)
+		assert.Equal(t, []string{"begin_action", "res_action_batch"}, queuedMethods(t, spool))
+	})
+
+	t.Run("replay in queue order and drop the rejected calls", func(t *testing.T) {
+		require.NoError(t, spool.Push("register_disks", []any{[]string{"disk_id"}}))
+		collector.down.Store(false)
+		n, err := c.Replay(spool)
+		require.NoError(t, err)
+		assert.Equal(t, 3, n)
+		assert.Equal(t, []string{"begin_action", "res_action_batch", "register_disks"}, collector.calls())
+		assert.Equal(t, 0, spool.Len())
+	})
+}
@@ -0,0 +1,294 @@
+package collector
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/opensvc/om3/core/rawconfig"
+)
+
+type (
+	// Spool is a disk-backed queue of the feed calls that could not be
+	// delivered because the collector was unreachable. Each call is stored
+	// as a json file named after its queue time, so the directory listing
+	// order is the replay order.
+	Spool struct {
+		sync.Mutex
+		dir     string
+		maxSize int64
+		maxAge  time.Duration
+	}
+
+	// SpoolEntry is a feed call stored in the spool, without the auth
+	// parameter appended by the Client.
+	SpoolEntry struct {
+		Name     string            `json:"-"`
+		Size     int64             `json:"-"`
+		Method   string            `json:"method"`
+		Params   []json.RawMessage `json:"params"`
+		QueuedAt time.Time         `json:"queued_at"`
+	}
+)
+
+const (
+	DefaultSpoolMaxSize = 50 * 1024 * 1024
+	DefaultSpoolMaxAge  = 7 * 24 * time.Hour
+)
+
+var (
+	// spooledMethods lists the feed methods queued on transport error.
+	// The methods mapped to true push a full snapshot of the node data,
+	// so a new call obsoletes the older queued calls of the same method.
+	spooledMethods = map[string]bool{
+		"begin_action":      false,
+		"res_action_batch":  false,
+		"insert_generic":    true,
+		"update_asset":      true,
+		"insert_pkg":        true,
+		"insert_patch":      true,
+		"register_disks":    true,
+		"register_diskinfo": true,
+		"push_checks":       true,
+	}
+)
+
+// SpoolDir returns the directory where the undelivered feed calls are
+// stored.
+func SpoolDir() string {
+	return filepath.Join(rawconfig.Paths.Var, "collector", "spool")
+}
+
+// IsSpooled returns true if the calls to the feed method are queued in
+// the spool when the collector is unreachable.
+func IsSpooled(method string) bool {
+	_, ok := spooledMethods[method]
+	return ok
+}
+
+func NewSpool(dir string) *Spool {
+	return &Spool{
+		dir:     dir,
+		maxSize: DefaultSpoolMaxSize,
+		maxAge:  DefaultSpoolMaxAge,
+	}
+}
+
+// SetLimits sets the maximum total size and the maximum age of the
+// queued calls. A zero value disables the limit.
+func (t *Spool) SetLimits(maxSize int64, maxAge time.Duration) {
+	t.maxSize = maxSize
+	t.maxAge = maxAge
+}
+
+func (t *Spool) String() string {
+	return t.dir
+}
+
+// Push queues a feed call.
+func (t *Spool) Push(method string, params []any) error {
+	t.Lock()
+	defer t.Unlock()
+	entry := SpoolEntry{
+		Method:   method,
+		Params:   make([]json.RawMessage, len(params)),
+		QueuedAt: time.Now(),
+	}
+	for i, param := range params {
+		b, err := json.Marshal(param)
+		if err != nil {
+			return fmt.Errorf("spool %s: %w", method, err)
+		}
+		entry.Params[i] = b
+	}
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("spool %s: %w", method, err)
+	}
+	if err := os.MkdirAll(t.dir, 0700); err != nil {
+		return err
+	}
+	name := fmt.Sprintf("%020d-%s.json", entry.QueuedAt.UnixNano(), method)
+	tmp, err := os.CreateTemp(t.dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(b); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), filepath.Join(t.dir, name)); err != nil {
+		return err
+	}
+	if spooledMethods[method] {
+		if err := t.drop(method, name); err != nil {
+			return err
+		}
+	}
+	return t.prune()
+}
+
+// Drop removes the queued calls of a snapshot method. It is used when a
+// call of this method was delivered, so the older queued calls must not
+// be replayed over the fresher data.
+func (t *Spool) Drop(method string) error {
+	if !spooledMethods[method] {
+		return nil
+	}
+	t.Lock()
+	defer t.Unlock()
+	return t.drop(method, "")
+}
+
+func (t *Spool) drop(method, except string) error {
+	names, err := t.names()
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		if name == except || methodOf(name) != method {
+			continue
+		}
+		if err := t.remove(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Len returns the number of queued calls.
+func (t *Spool) Len() int {
+	names, _ := t.names()
+	return len(names)
+}
+
+// List returns the queued calls, oldest first.
+func (t *Spool) List() ([]SpoolEntry, error) {
+	names, err := t.names()
+	if err != nil {
+		return nil, err
+	}
+	l := make([]SpoolEntry, 0, len(names))
+	for _, name := range names {
+		entry, err := t.read(name)
+		if errors.Is(err, fs.ErrNotExist) {
+			// delivered or pruned by another process
+			continue
+		} else if err != nil {
+			return l, err
+		}
+		l = append(l, entry)
+	}
+	return l, nil
+}
+
+// Remove removes a queued call from the spool.
+func (t *Spool) Remove(entry SpoolEntry) error {
+	t.Lock()
+	defer t.Unlock()
+	return t.remove(entry.Name)
+}
+
+func (t *Spool) remove(name string) error {
+	if err := os.Remove(filepath.Join(t.dir, name)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (t *Spool) read(name string) (SpoolEntry, error) {
+	var entry SpoolEntry
+	b, err := os.ReadFile(filepath.Join(t.dir, name))
+	if err != nil {
+		return entry, err
+	}
+	if err := json.Unmarshal(b, &entry); err != nil {
+		return entry, fmt.Errorf("spool entry %s: %w", name, err)
+	}
+	entry.Name = name
+	entry.Size = int64(len(b))
+	return entry, nil
+}
+
+// names returns the sorted queued call file names.
+func (t *Spool) names() ([]string, error) {
+	entries, err := os.ReadDir(t.dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(entries))
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || strings.HasPrefix(name, ".") || !strings.HasSuffix(name, ".json") {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// prune removes the queued calls older than maxAge, then the oldest
+// queued calls until the spool size is below maxSize.
+func (t *Spool) prune() error {
+	names, err := t.names()
+	if err != nil {
+		return err
+	}
+	type sized struct {
+		name string
+		size int64
+	}
+	var (
+		total int64
+		l     []sized
+	)
+	now := time.Now()
+	for _, name := range names {
+		info, err := os.Stat(filepath.Join(t.dir, name))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		if t.maxAge > 0 && now.Sub(info.ModTime()) > t.maxAge {
+			if err := t.remove(name); err != nil {
+				return err
+			}
+			continue
+		}
+		total += info.Size()
+		l = append(l, sized{name: name, size: info.Size()})
+	}
+	for _, e := range l {
+		if t.maxSize <= 0 || total <= t.maxSize {
+			break
+		}
+		if err := t.remove(e.name); err != nil {
+			return err
+		}
+		total -= e.size
+	}
+	return nil
+}
+
+func methodOf(name string) string {
+	name = strings.TrimSuffix(name, ".json")
+	if i := strings.Index(name, "-"); i >= 0 {
+		return name[i+1:]
+	}
+	return ""
+}
@@ -0,0 +1,68 @@
+package collector
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpool(t *testing.T) {
+	spool := NewSpool(t.TempDir())
+	assert.Equal(t, 0, spool.Len())
+
+	require.NoError(t, spool.Push("begin_action", []any{[]string{"svcname"}, []string{"foo"}}))
+	require.NoError(t, spool.Push("insert_pkg", []any{[]string{"pkg_name"}, [][]string{{"bash"}}}))
+	require.NoError(t, spool.Push("res_action_batch", []any{[]string{"svcname"}, [][]string{{"foo"}}}))
+	require.NoError(t, spool.Push("insert_pkg", []any{[]string{"pkg_name"}, [][]string{{"zsh"}}}))
+
+	l, err := spool.List()
+	require.NoError(t, err)
+	methods := make([]string, len(l))
+	for i, e := range l {
+		methods[i] = e.Method
+	}
+	assert.Equal(t, []string{"begin_action", "res_action_batch", "insert_pkg"}, methods,
+		"queue order is preserved and the obsolete insert_pkg call is dropped")
+
+	var pkgs [][]string
+	require.NoError(t, json.Unmarshal(l[2].Params[1], &pkgs))
+	assert.Equal(t, [][]string{{"zsh"}}, pkgs)
+
+	t.Run("drop", func(t *testing.T) {
+		require.NoError(t, spool.Drop("insert_pkg"))
+		require.NoError(t, spool.Drop("begin_action"), "action logs are never dropped")
+		assert.Equal(t, 2, spool.Len())
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		l, err := spool.List()
+		require.NoError(t, err)
+		require.NoError(t, spool.Remove(l[0]))
+		assert.Equal(t, 1, spool.Len())
+	})
+
+	t.Run("max age", func(t *testing.T) {
+		spool.SetLimits(0, time.Hour)
+		l, err := spool.List()
+		require.NoError(t, err)
+		require.Len(t, l, 1)
+		old := time.Now().Add(-2 * time.Hour)
+		require.NoError(t, os.Chtimes(filepath.Join(spool.dir, l[0].Name), old, old))
+		require.NoError(t, spool.Push("insert_patch", []any{[]string{"patch_num"}}))
+		l, err = spool.List()
+		require.NoError(t, err)
+		require.Len(t, l, 1)
+		assert.Equal(t, "insert_patch", l[0].Method)
+	})
+
+	t.Run("max size", func(t *testing.T) {
+		spool.SetLimits(1, 0)
+		require.NoError(t, spool.Push("push_checks", []any{[]string{"chk_type"}}))
+		assert.Equal(t, 0, spool.Len())
+	})
+}
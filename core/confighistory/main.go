@@ -0,0 +1,300 @@
+// Package confighistory keeps the successive revisions of an object
+// configuration file.
+//
+// The revisions are stored in the object var directory. A revision id is
+// derived from the configuration file content and modification time, so the
+// nodes installing the same configuration, with the mtime preserved by the
+// daemon replication, record the same revision id.
+package confighistory
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hexops/gotextdiff"
+	"github.com/hexops/gotextdiff/myers"
+	"github.com/hexops/gotextdiff/span"
+
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/util/file"
+)
+
+type (
+	// Revision describes a committed object configuration.
+	Revision struct {
+		ID        string    `json:"id"`
+		Author    string    `json:"author"`
+		CreatedAt time.Time `json:"created_at"`
+		Checksum  string    `json:"csum"`
+	}
+
+	Revisions []Revision
+
+	// T is the configuration history of an object.
+	T struct {
+		dir string
+		max int
+	}
+)
+
+const (
+	// DefaultMax is the number of revisions kept by the history. The
+	// oldest revisions are pruned when a new revision is added.
+	DefaultMax = 100
+
+	idLength = 12
+)
+
+var (
+	ErrNotFound  = errors.New("config revision not found")
+	ErrAmbiguous = errors.New("ambiguous config revision id")
+)
+
+func (t Revision) Unstructured() map[string]any {
+	return map[string]any{
+		"id":         t.ID,
+		"author":     t.Author,
+		"created_at": t.CreatedAt,
+		"csum":       t.Checksum,
+	}
+}
+
+// New returns the configuration history of the object.
+func New(p naming.Path) *T {
+	return &T{
+		dir: filepath.Join(p.VarDir(), "config_history"),
+		max: DefaultMax,
+	}
+}
+
+// IsSupported returns true if the configuration of the objects of this kind
+// is historized.
+func IsSupported(kind naming.Kind) bool {
+	switch kind {
+	case naming.KindSvc, naming.KindVol, naming.KindCfg, naming.KindSec, naming.KindUsr:
+		return true
+	default:
+		return false
+	}
+}
+
+// RevisionID returns the id of the revision of a configuration file
+// content with modification time mtime.
+func RevisionID(b []byte, mtime time.Time) string {
+	h := sha256.New()
+	_, _ = fmt.Fprintf(h, "%d\n", mtime.UnixNano())
+	_, _ = h.Write(b)
+	return hex.EncodeToString(h.Sum(nil))[:idLength]
+}
+
+// LocalAuthor returns the author name of the revisions committed by the
+// local user: the user name, or the uid if the user is not resolvable.
+func LocalAuthor() string {
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		return u.Username
+	}
+	return fmt.Sprintf("uid:%d", os.Getuid())
+}
+
+// AddFile adds the current content of the configuration file to the
+// history.
+func (t *T) AddFile(filename, author string) (Revision, error) {
+	mtime := file.ModTime(filename)
+	if mtime.IsZero() {
+		return Revision{}, fmt.Errorf("%s: %w", filename, os.ErrNotExist)
+	}
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return Revision{}, err
+	}
+	return t.Add(b, mtime, author)
+}
+
+// Add adds a revision to the history. Adding an already known revision is
+// a noop, except the author is set if it was unknown.
+func (t *T) Add(b []byte, mtime time.Time, author string) (Revision, error) {
+	id := RevisionID(b, mtime)
+	if rev, err := t.load(id); err == nil {
+		if rev.Author != "" || author == "" {
+			return rev, nil
+		}
+		rev.Author = author
+		return rev, t.writeMeta(rev)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return Revision{}, err
+	}
+	rev := Revision{
+		ID:        id,
+		Author:    author,
+		CreatedAt: mtime,
+		Checksum:  fmt.Sprintf("%x", md5.Sum(b)),
+	}
+	if err := os.MkdirAll(t.dir, 0700); err != nil {
+		return rev, err
+	}
+	if err := writeFile(t.dataFile(id), b); err != nil {
+		return rev, err
+	}
+	if err := t.writeMeta(rev); err != nil {
+		return rev, err
+	}
+	return rev, t.prune()
+}
+
+// List returns the revisions, the oldest first.
+func (t *T) List() (Revisions, error) {
+	l := make(Revisions, 0)
+	matches, err := filepath.Glob(filepath.Join(t.dir, "*.json"))
+	if err != nil {
+		return l, err
+	}
+	for _, match := range matches {
+		id := strings.TrimSuffix(filepath.Base(match), ".json")
+		if rev, err := t.load(id); err != nil {
+			continue
+		} else {
+			l = append(l, rev)
+		}
+	}
+	sort.Slice(l, func(i, j int) bool {
+		if l[i].CreatedAt.Equal(l[j].CreatedAt) {
+			return l[i].ID < l[j].ID
+		}
+		return l[i].CreatedAt.Before(l[j].CreatedAt)
+	})
+	return l, nil
+}
+
+// Get returns the revision identified by id. A unique id prefix is
+// accepted.
+func (t *T) Get(id string) (Revision, error) {
+	if !isIDPrefix(id) {
+		return Revision{}, fmt.Errorf("%w: %s", ErrNotFound, id)
+	}
+	if rev, err := t.load(id); err == nil {
+		return rev, nil
+	}
+	l, err := t.List()
+	if err != nil {
+		return Revision{}, err
+	}
+	var found []Revision
+	for _, rev := range l {
+		if strings.HasPrefix(rev.ID, id) {
+			found = append(found, rev)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return Revision{}, fmt.Errorf("%w: %s", ErrNotFound, id)
+	case 1:
+		return found[0], nil
+	default:
+		return Revision{}, fmt.Errorf("%w: %s", ErrAmbiguous, id)
+	}
+}
+
+// Data returns the configuration file content of the revision identified
+// by id.
+func (t *T) Data(id string) (Revision, []byte, error) {
+	rev, err := t.Get(id)
+	if err != nil {
+		return rev, nil, err
+	}
+	b, err := os.ReadFile(t.dataFile(rev.ID))
+	return rev, b, err
+}
+
+// Diff returns the unified diff between the a and b configuration file
+// contents.
+func Diff(aName string, a []byte, bName string, b []byte) string {
+	edits := myers.ComputeEdits(span.URIFromPath(aName), string(a), string(b))
+	return fmt.Sprint(gotextdiff.ToUnified(aName, bName, string(a), edits))
+}
+
+// isIDPrefix returns true if s can be a revision id prefix. It prevents the
+// use of user submitted ids as paths outside of the history directory.
+func isIDPrefix(s string) bool {
+	if s == "" || len(s) > idLength {
+		return false
+	}
+	_, err := hex.DecodeString(s + strings.Repeat("0", len(s)%2))
+	return err == nil
+}
+
+func (t *T) prune() error {
+	l, err := t.List()
+	if err != nil {
+		return err
+	}
+	if len(l) <= t.max {
+		return nil
+	}
+	var errs error
+	for _, rev := range l[:len(l)-t.max] {
+		if err := os.Remove(t.metaFile(rev.ID)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			errs = errors.Join(errs, err)
+		}
+		if err := os.Remove(t.dataFile(rev.ID)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			errs = errors.Join(errs, err)
+		}
+	}
+	return errs
+}
+
+func (t *T) load(id string) (Revision, error) {
+	var rev Revision
+	b, err := os.ReadFile(t.metaFile(id))
+	if err != nil {
+		return rev, err
+	}
+	if err := json.Unmarshal(b, &rev); err != nil {
+		return rev, fmt.Errorf("%s: %w", t.metaFile(id), err)
+	}
+	return rev, nil
+}
+
+func (t *T) writeMeta(rev Revision) error {
+	b, err := json.Marshal(rev)
+	if err != nil {
+		return err
+	}
+	return writeFile(t.metaFile(rev.ID), b)
+}
+
+func (t *T) dataFile(id string) string {
+	return filepath.Join(t.dir, id+".conf")
+}
+
+func (t *T) metaFile(id string) string {
+	return filepath.Join(t.dir, id+".json")
+}
+
+// writeFile atomically installs the b content as the filename file, so
+// concurrent readers never see a partial revision.
+func writeFile(filename string, b []byte) error {
+	f, err := os.CreateTemp(filepath.Dir(filename), "."+filepath.Base(filename)+".*")
+	if err != nil {
+		return err
+	}
+	tmpFilename := f.Name()
+	defer os.Remove(tmpFilename)
+	if _, err := f.Write(b); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmpFilename, filename)
+}
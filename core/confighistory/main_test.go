@@ -0,0 +1,92 @@
+package confighistory
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/testhelper"
+)
+
+func TestHistory(t *testing.T) {
+	testhelper.Setup(t)
+	p, err := naming.ParsePath("svc1")
+	require.NoError(t, err)
+	h := New(p)
+
+	t0 := time.Unix(1700000000, 123456789)
+	t1 := t0.Add(time.Minute)
+	rev0, err := h.Add([]byte("[DEFAULT]\nnodes = n1\n"), t0, "")
+	require.NoError(t, err)
+	rev1, err := h.Add([]byte("[DEFAULT]\nnodes = n1 n2\n"), t1, "root")
+	require.NoError(t, err)
+	assert.NotEqual(t, rev0.ID, rev1.ID)
+	assert.Len(t, rev0.ID, idLength)
+
+	t.Run("same content and mtime is the same revision", func(t *testing.T) {
+		rev, err := h.Add([]byte("[DEFAULT]\nnodes = n1\n"), t0, "admin")
+		require.NoError(t, err)
+		assert.Equal(t, rev0.ID, rev.ID)
+		assert.Equal(t, "admin", rev.Author, "an unknown author is set")
+
+		rev, err = h.Add([]byte("[DEFAULT]\nnodes = n1\n"), t0, "other")
+		require.NoError(t, err)
+		assert.Equal(t, "admin", rev.Author, "a known author is preserved")
+	})
+
+	t.Run("list is ordered by creation time", func(t *testing.T) {
+		l, err := h.List()
+		require.NoError(t, err)
+		require.Len(t, l, 2)
+		assert.Equal(t, rev0.ID, l[0].ID)
+		assert.Equal(t, rev1.ID, l[1].ID)
+		assert.True(t, l[0].CreatedAt.Equal(t0))
+	})
+
+	t.Run("get by id prefix", func(t *testing.T) {
+		rev, b, err := h.Data(rev1.ID[:6])
+		require.NoError(t, err)
+		assert.Equal(t, rev1.ID, rev.ID)
+		assert.Equal(t, "[DEFAULT]\nnodes = n1 n2\n", string(b))
+	})
+
+	t.Run("get refuses invalid ids", func(t *testing.T) {
+		for _, id := range []string{"", "../svc1", "zz", rev1.ID + "0"} {
+			_, err := h.Get(id)
+			assert.ErrorIsf(t, err, ErrNotFound, "id %q", id)
+		}
+	})
+
+	t.Run("add file", func(t *testing.T) {
+		filename := filepath.Join(t.TempDir(), "svc1.conf")
+		require.NoError(t, os.WriteFile(filename, []byte("[DEFAULT]\nnodes = n2\n"), 0600))
+		rev, err := h.AddFile(filename, "")
+		require.NoError(t, err)
+		b, err := os.ReadFile(filename)
+		require.NoError(t, err)
+		assert.Equal(t, RevisionID(b, rev.CreatedAt), rev.ID)
+	})
+
+	t.Run("prune the oldest revisions", func(t *testing.T) {
+		h.max = 2
+		_, err := h.Add([]byte("[DEFAULT]\nnodes = n3\n"), t1.Add(time.Hour), "")
+		require.NoError(t, err)
+		l, err := h.List()
+		require.NoError(t, err)
+		require.Len(t, l, 2)
+		_, err = h.Get(rev0.ID)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func TestDiff(t *testing.T) {
+	s := Diff("a", []byte("[DEFAULT]\nnodes = n1\n"), "b", []byte("[DEFAULT]\nnodes = n2\n"))
+	assert.Contains(t, s, "-nodes = n1")
+	assert.Contains(t, s, "+nodes = n2")
+	assert.Empty(t, Diff("a", []byte("x\n"), "b", []byte("x\n")))
+}
@@ -7,8 +7,10 @@ import (
 	_ "github.com/opensvc/om3/drivers/networkbridge"
 	_ "github.com/opensvc/om3/drivers/networklo"
 	_ "github.com/opensvc/om3/drivers/networkroutedbridge"
+	_ "github.com/opensvc/om3/drivers/poolbtrfs"
 	_ "github.com/opensvc/om3/drivers/pooldrbd"
 	_ "github.com/opensvc/om3/drivers/poolloop"
+	_ "github.com/opensvc/om3/drivers/poolthinlvm"
 	_ "github.com/opensvc/om3/drivers/poolvg"
 	_ "github.com/opensvc/om3/drivers/rescontainerdocker"
 	_ "github.com/opensvc/om3/drivers/rescontainerkvm"
@@ -18,6 +20,7 @@ import (
 	_ "github.com/opensvc/om3/drivers/resdiskdrbd"
 	_ "github.com/opensvc/om3/drivers/resdiskzpool"
 	_ "github.com/opensvc/om3/drivers/resdiskzvol"
+	_ "github.com/opensvc/om3/drivers/resfsbtrfssubvol"
 	_ "github.com/opensvc/om3/drivers/resipcni"
 	_ "github.com/opensvc/om3/drivers/resipnetns"
 )
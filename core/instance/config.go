@@ -40,6 +40,10 @@ type (
 		// Volume specific
 		Pool *string `json:"pool,omitempty"`
 		Size *int64  `json:"size,omitempty"`
+
+		// Sec specific
+		CertNotAfter   *time.Time `json:"cert_not_after,omitempty"`
+		CertExpireDays *int       `json:"cert_expire_days,omitempty"`
 	}
 	ResourceConfigs map[string]ResourceConfig
 	ResourceConfig  struct {
@@ -84,6 +88,12 @@ func ConfigEqual(a, b *Config) bool {
 	if !stringslice.Equal(a.Scope, b.Scope) {
 		return false
 	}
+	if (a.CertExpireDays == nil) != (b.CertExpireDays == nil) {
+		return false
+	}
+	if a.CertExpireDays != nil && *a.CertExpireDays != *b.CertExpireDays {
+		return false
+	}
 	return true
 }
 
@@ -124,6 +134,12 @@ func (t Config) Unstructured() map[string]any {
 	if t.Size != nil {
 		m["size"] = t.Size
 	}
+	if t.CertNotAfter != nil {
+		m["cert_not_after"] = t.CertNotAfter
+	}
+	if t.CertExpireDays != nil {
+		m["cert_expire_days"] = t.CertExpireDays
+	}
 	return m
 }
 
@@ -0,0 +1,29 @@
+package keyprovider
+
+import (
+	"encoding/base64"
+
+	"github.com/opensvc/om3/core/omcrypto"
+)
+
+type (
+	// clusterProvider wraps the data keys with the cluster secret, so
+	// every cluster node can unwrap them.
+	clusterProvider struct{}
+)
+
+func (t *clusterProvider) Wrap(dataKey []byte) (string, error) {
+	b, err := omcrypto.NewMessage(dataKey).Encrypt()
+	if err != nil {
+		return "", err
+	}
+	return base64.URLEncoding.EncodeToString(b), nil
+}
+
+func (t *clusterProvider) Unwrap(s string) ([]byte, error) {
+	b, err := base64.URLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return omcrypto.NewMessage(b).Decrypt()
+}
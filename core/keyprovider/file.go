@@ -0,0 +1,58 @@
+package keyprovider
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+)
+
+type (
+	// fileProvider wraps the data keys with a master key derived from the
+	// content of a key file. The key file must be installed on every node
+	// needing to decrypt the values, and is not replicated by the agent.
+	fileProvider struct {
+		path string
+	}
+)
+
+// minKeyFileSize is the minimum size of the key file content, so the
+// derived master key has enough entropy.
+const minKeyFileSize = 32
+
+func (t *fileProvider) masterKey() ([]byte, error) {
+	if t.path == "" {
+		return nil, fmt.Errorf("the file keyword is not set")
+	}
+	b, err := os.ReadFile(t.path)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) < minKeyFileSize {
+		return nil, fmt.Errorf("%s: the key file must contain at least %d bytes", t.path, minKeyFileSize)
+	}
+	return deriveKey(b), nil
+}
+
+func (t *fileProvider) Wrap(dataKey []byte) (string, error) {
+	k, err := t.masterKey()
+	if err != nil {
+		return "", err
+	}
+	b, err := Seal(k, dataKey)
+	if err != nil {
+		return "", err
+	}
+	return base64.URLEncoding.EncodeToString(b), nil
+}
+
+func (t *fileProvider) Unwrap(s string) ([]byte, error) {
+	k, err := t.masterKey()
+	if err != nil {
+		return nil, err
+	}
+	b, err := base64.URLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return Open(k, b)
+}
@@ -0,0 +1,178 @@
+// Package keyprovider implements the key providers wrapping the data keys
+// used to encrypt the sec and usr objects values at rest.
+//
+// A data key is a random AES-256 key owned by an object. It is stored in
+// the object configuration, wrapped by a key provider, so the values can
+// only be decrypted by the nodes having access to the provider key.
+//
+// The "cluster" key provider is builtin and wraps the data keys with the
+// cluster secret. The other key providers are declared in the node or
+// cluster configuration, as keyprovider#<name> sections.
+package keyprovider
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/opensvc/om3/util/key"
+)
+
+type (
+	// T is the interface implemented by the key providers.
+	T interface {
+		// Wrap returns the data key encrypted by the provider key.
+		Wrap(dataKey []byte) (string, error)
+
+		// Unwrap returns the data key decrypted by the provider key.
+		Unwrap(s string) ([]byte, error)
+	}
+
+	// Config is the interface of the configuration declaring the key
+	// providers, usually the node merged configuration.
+	Config interface {
+		GetString(key.T) string
+		HasSectionString(string) bool
+	}
+)
+
+const (
+	// Cluster is the name of the builtin key provider wrapping the data
+	// keys with the cluster secret.
+	Cluster = "cluster"
+
+	// DataKeySize is the size in bytes of the AES-256 data keys.
+	DataKeySize = 32
+)
+
+var (
+	ErrNotFound = errors.New("key provider not found")
+	ErrType     = errors.New("unsupported key provider type")
+)
+
+// SectionName returns the name of the configuration section declaring the
+// key provider.
+func SectionName(name string) string {
+	return "keyprovider#" + name
+}
+
+// New returns the key provider named <name>, as declared in the config.
+func New(name string, config Config) (T, error) {
+	if name == "" || name == Cluster {
+		return &clusterProvider{}, nil
+	}
+	section := SectionName(name)
+	if !config.HasSectionString(section) {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, name)
+	}
+	get := func(option string) string {
+		return config.GetString(key.New(section, option))
+	}
+	switch s := get("type"); s {
+	case "file":
+		return &fileProvider{path: get("file")}, nil
+	case "passphrase":
+		return &passphraseProvider{env: get("passphrase_env")}, nil
+	case "vault":
+		return &vaultProvider{
+			url:       get("url"),
+			key:       get("key"),
+			tokenFile: get("token_file"),
+		}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s: %s", ErrType, name, s)
+	}
+}
+
+// WrapKey returns the data key wrapped by the key provider <name>,
+// prefixed by the provider name so the key can be unwrapped even if the
+// provider of the object is changed.
+func WrapKey(name string, config Config, dataKey []byte) (string, error) {
+	if name == "" {
+		name = Cluster
+	}
+	p, err := New(name, config)
+	if err != nil {
+		return "", err
+	}
+	s, err := p.Wrap(dataKey)
+	if err != nil {
+		return "", fmt.Errorf("key provider %s: wrap: %w", name, err)
+	}
+	return name + ":" + s, nil
+}
+
+// UnwrapKey returns the data key unwrapped by the key provider named in
+// the wrapped key prefix.
+func UnwrapKey(s string, config Config) ([]byte, error) {
+	name, payload, ok := strings.Cut(s, ":")
+	if !ok {
+		return nil, fmt.Errorf("invalid wrapped data key: no key provider prefix")
+	}
+	p, err := New(name, config)
+	if err != nil {
+		return nil, err
+	}
+	b, err := p.Unwrap(payload)
+	if err != nil {
+		return nil, fmt.Errorf("key provider %s: unwrap: %w", name, err)
+	}
+	if len(b) != DataKeySize {
+		return nil, fmt.Errorf("key provider %s: unwrap: unexpected data key size %d", name, len(b))
+	}
+	return b, nil
+}
+
+// NewDataKey returns a new random data key.
+func NewDataKey() ([]byte, error) {
+	b := make([]byte, DataKeySize)
+	if _, err := io.ReadFull(rand.Reader, b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// Seal encrypts b with the AES-256 key k, using GCM. The random nonce is
+// prepended to the returned cipher text.
+func Seal(k, b []byte) ([]byte, error) {
+	aead, err := newGCM(k)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, b, nil), nil
+}
+
+// Open decrypts the cipher text b sealed with the AES-256 key k.
+func Open(k, b []byte) ([]byte, error) {
+	aead, err := newGCM(k)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) < aead.NonceSize() {
+		return nil, fmt.Errorf("cipher text is too short")
+	}
+	return aead.Open(nil, b[:aead.NonceSize()], b[aead.NonceSize():], nil)
+}
+
+func newGCM(k []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(k)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// deriveKey returns a AES-256 key derived from the key material b.
+func deriveKey(b []byte) []byte {
+	sum := sha256.Sum256(b)
+	return sum[:]
+}
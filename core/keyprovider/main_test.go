@@ -0,0 +1,129 @@
+package keyprovider
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/core/omcrypto"
+	"github.com/opensvc/om3/util/key"
+)
+
+type testConfig map[string]map[string]string
+
+func (t testConfig) GetString(k key.T) string {
+	return t[k.Section][k.Option]
+}
+
+func (t testConfig) HasSectionString(s string) bool {
+	_, ok := t[s]
+	return ok
+}
+
+func TestWrapKey(t *testing.T) {
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "master.key")
+	require.NoError(t, os.WriteFile(keyFile, []byte(strings.Repeat("k", 64)), 0600))
+	shortKeyFile := filepath.Join(dir, "short.key")
+	require.NoError(t, os.WriteFile(shortKeyFile, []byte("k"), 0600))
+
+	// a fake transit api xoring the plaintext with a constant
+	vault := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "s3cr3t" {
+			w.WriteHeader(http.StatusForbidden)
+			_ = json.NewEncoder(w).Encode(vaultResponse{Errors: []string{"permission denied"}})
+			return
+		}
+		var req vaultRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		var resp vaultResponse
+		switch r.URL.Path {
+		case "/v1/transit/encrypt/opensvc":
+			resp.Data.Ciphertext = "vault:v1:" + req.Plaintext
+		case "/v1/transit/decrypt/opensvc":
+			resp.Data.Plaintext = strings.TrimPrefix(req.Ciphertext, "vault:v1:")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer vault.Close()
+
+	tokenFile := filepath.Join(dir, "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("s3cr3t\n"), 0600))
+	badTokenFile := filepath.Join(dir, "badtoken")
+	require.NoError(t, os.WriteFile(badTokenFile, []byte("bad"), 0600))
+
+	config := testConfig{
+		"keyprovider#f1":    {"type": "file", "file": keyFile},
+		"keyprovider#short": {"type": "file", "file": shortKeyFile},
+		"keyprovider#p1":    {"type": "passphrase", "passphrase_env": "TEST_KEY_PASSPHRASE"},
+		"keyprovider#v1":    {"type": "vault", "url": vault.URL, "key": "opensvc", "token_file": tokenFile},
+		"keyprovider#v2":    {"type": "vault", "url": vault.URL, "key": "opensvc", "token_file": badTokenFile},
+		"keyprovider#x":     {"type": "tpm"},
+	}
+	t.Setenv("TEST_KEY_PASSPHRASE", "correct horse battery staple")
+	omcrypto.SetClusterName("test")
+	omcrypto.SetClusterSecret(strings.Repeat("s", 32))
+
+	dataKey, err := NewDataKey()
+	require.NoError(t, err)
+
+	for _, name := range []string{"", "cluster", "f1", "p1", "v1"} {
+		t.Run("wrap and unwrap with provider "+name, func(t *testing.T) {
+			s, err := WrapKey(name, config, dataKey)
+			require.NoError(t, err)
+			assert.NotContains(t, s, base64.URLEncoding.EncodeToString(dataKey))
+			if name == "" {
+				assert.True(t, strings.HasPrefix(s, "cluster:"))
+			} else {
+				assert.True(t, strings.HasPrefix(s, name+":"))
+			}
+			b, err := UnwrapKey(s, config)
+			require.NoError(t, err)
+			assert.Equal(t, dataKey, b)
+		})
+	}
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := WrapKey("undeclared", config, dataKey)
+		assert.ErrorIs(t, err, ErrNotFound)
+		_, err = WrapKey("x", config, dataKey)
+		assert.ErrorIs(t, err, ErrType)
+		_, err = WrapKey("short", config, dataKey)
+		assert.ErrorContains(t, err, "at least 32 bytes")
+		_, err = WrapKey("v2", config, dataKey)
+		assert.ErrorContains(t, err, "permission denied")
+		_, err = UnwrapKey("nodelimiter", config)
+		assert.Error(t, err)
+
+		s, err := WrapKey("p1", config, dataKey)
+		require.NoError(t, err)
+		t.Setenv("TEST_KEY_PASSPHRASE", "wrong")
+		_, err = UnwrapKey(s, config)
+		assert.Error(t, err, "unwrap with a wrong passphrase")
+	})
+}
+
+func TestSeal(t *testing.T) {
+	k, err := NewDataKey()
+	require.NoError(t, err)
+	b, err := Seal(k, []byte("foo"))
+	require.NoError(t, err)
+	decoded, err := Open(k, b)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("foo"), decoded)
+
+	other, err := NewDataKey()
+	require.NoError(t, err)
+	_, err = Open(other, b)
+	assert.Error(t, err, "open with another key")
+}
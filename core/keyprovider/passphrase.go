@@ -0,0 +1,72 @@
+package keyprovider
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+type (
+	// passphraseProvider wraps the data keys with a master key derived
+	// from a passphrase read from the environment, so the passphrase is
+	// never stored on disk.
+	passphraseProvider struct {
+		env string
+	}
+)
+
+const (
+	// defaultPassphraseEnv is the default name of the environment
+	// variable holding the passphrase.
+	defaultPassphraseEnv = "OSVC_KEY_PASSPHRASE"
+
+	saltSize = 16
+)
+
+func (t *passphraseProvider) masterKey(salt []byte) ([]byte, error) {
+	env := t.env
+	if env == "" {
+		env = defaultPassphraseEnv
+	}
+	passphrase := os.Getenv(env)
+	if passphrase == "" {
+		return nil, fmt.Errorf("the %s environment variable is not set", env)
+	}
+	return scrypt.Key([]byte(passphrase), salt, 1<<15, 8, 1, DataKeySize)
+}
+
+// Wrap returns the random salt followed by the sealed data key.
+func (t *passphraseProvider) Wrap(dataKey []byte) (string, error) {
+	salt := make([]byte, saltSize)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return "", err
+	}
+	k, err := t.masterKey(salt)
+	if err != nil {
+		return "", err
+	}
+	b, err := Seal(k, dataKey)
+	if err != nil {
+		return "", err
+	}
+	return base64.URLEncoding.EncodeToString(append(salt, b...)), nil
+}
+
+func (t *passphraseProvider) Unwrap(s string) ([]byte, error) {
+	b, err := base64.URLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) < saltSize {
+		return nil, fmt.Errorf("wrapped key is too short")
+	}
+	k, err := t.masterKey(b[:saltSize])
+	if err != nil {
+		return nil, err
+	}
+	return Open(k, b[saltSize:])
+}
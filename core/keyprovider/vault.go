@@ -0,0 +1,106 @@
+package keyprovider
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+type (
+	// vaultProvider wraps the data keys using the transit secrets engine
+	// api of a Vault-compatible server, so the master key never leaves
+	// the server.
+	vaultProvider struct {
+		url       string
+		key       string
+		tokenFile string
+	}
+
+	vaultRequest struct {
+		Plaintext  string `json:"plaintext,omitempty"`
+		Ciphertext string `json:"ciphertext,omitempty"`
+	}
+
+	vaultResponse struct {
+		Data   vaultRequest `json:"data"`
+		Errors []string     `json:"errors"`
+	}
+)
+
+var (
+	vaultClient = &http.Client{Timeout: 10 * time.Second}
+)
+
+func (t *vaultProvider) token() (string, error) {
+	if t.tokenFile == "" {
+		if s := os.Getenv("VAULT_TOKEN"); s != "" {
+			return s, nil
+		}
+		return "", fmt.Errorf("the token_file keyword is not set and the VAULT_TOKEN environment variable is empty")
+	}
+	b, err := os.ReadFile(t.tokenFile)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(b)), nil
+}
+
+// do posts the request to the transit api operation <op>, encrypt or
+// decrypt, and returns the response data.
+func (t *vaultProvider) do(op string, req vaultRequest) (vaultRequest, error) {
+	var resp vaultResponse
+	if t.url == "" || t.key == "" {
+		return resp.Data, fmt.Errorf("the url and key keywords must be set")
+	}
+	token, err := t.token()
+	if err != nil {
+		return resp.Data, err
+	}
+	body, err := json.Marshal(req)
+	if err != nil {
+		return resp.Data, err
+	}
+	u := strings.TrimSuffix(t.url, "/") + "/v1/transit/" + op + "/" + t.key
+	r, err := http.NewRequest(http.MethodPost, u, bytes.NewReader(body))
+	if err != nil {
+		return resp.Data, err
+	}
+	r.Header.Set("X-Vault-Token", token)
+	r.Header.Set("Content-Type", "application/json")
+	httpResp, err := vaultClient.Do(r)
+	if err != nil {
+		return resp.Data, err
+	}
+	defer func() { _ = httpResp.Body.Close() }()
+	if err := json.NewDecoder(httpResp.Body).Decode(&resp); err != nil {
+		return resp.Data, fmt.Errorf("%s: unexpected response: %w", u, err)
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		return resp.Data, fmt.Errorf("%s: %s: %s", u, httpResp.Status, strings.Join(resp.Errors, ", "))
+	}
+	return resp.Data, nil
+}
+
+func (t *vaultProvider) Wrap(dataKey []byte) (string, error) {
+	data, err := t.do("encrypt", vaultRequest{Plaintext: base64.StdEncoding.EncodeToString(dataKey)})
+	if err != nil {
+		return "", err
+	}
+	if data.Ciphertext == "" {
+		return "", fmt.Errorf("empty ciphertext in response")
+	}
+	return data.Ciphertext, nil
+}
+
+func (t *vaultProvider) Unwrap(s string) ([]byte, error) {
+	data, err := t.do("decrypt", vaultRequest{Ciphertext: s})
+	if err != nil {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(data.Plaintext)
+}
@@ -0,0 +1,266 @@
+package keywords
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+type (
+	// Schema is a json schema document, or a subschema of a json schema
+	// document. Only the validation vocabulary used to describe the
+	// configuration documents is supported.
+	Schema struct {
+		Schema               string             `json:"$schema,omitempty"`
+		ID                   string             `json:"$id,omitempty"`
+		Ref                  string             `json:"$ref,omitempty"`
+		Title                string             `json:"title,omitempty"`
+		Description          string             `json:"description,omitempty"`
+		Type                 any                `json:"type,omitempty"`
+		Const                any                `json:"const,omitempty"`
+		Enum                 []string           `json:"enum,omitempty"`
+		Default              any                `json:"default,omitempty"`
+		Examples             []string           `json:"examples,omitempty"`
+		Items                *Schema            `json:"items,omitempty"`
+		Properties           map[string]*Schema `json:"properties,omitempty"`
+		PatternProperties    map[string]*Schema `json:"patternProperties,omitempty"`
+		AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+		MinProperties        int                `json:"minProperties,omitempty"`
+		Required             []string           `json:"required,omitempty"`
+		AnyOf                []*Schema          `json:"anyOf,omitempty"`
+		OneOf                []*Schema          `json:"oneOf,omitempty"`
+		Not                  *Schema            `json:"not,omitempty"`
+		Definitions          map[string]*Schema `json:"definitions,omitempty"`
+	}
+)
+
+const (
+	// SchemaDialect is the json schema draft the schemas comply with.
+	SchemaDialect = "http://json-schema.org/draft-07/schema#"
+)
+
+var (
+	// schemaFalse is the schema rejecting any value, used to deny the
+	// undefined properties.
+	schemaFalse = &Schema{Not: &Schema{}}
+)
+
+// NewSchemaObject returns the schema of a map of the properties, denying
+// the undefined properties.
+func NewSchemaObject() *Schema {
+	return &Schema{
+		Type:                 "object",
+		Properties:           make(map[string]*Schema),
+		PatternProperties:    make(map[string]*Schema),
+		AdditionalProperties: schemaFalse,
+	}
+}
+
+// NewSchemaMap returns the schema of a map of free form values, like the
+// env, labels and data sections.
+func NewSchemaMap(description string) *Schema {
+	return &Schema{
+		Type:        "object",
+		Description: description,
+		AdditionalProperties: &Schema{
+			AnyOf: []*Schema{
+				{Type: "string"},
+				{Type: "array", Items: &Schema{Type: "string"}},
+			},
+		},
+	}
+}
+
+// ValueSchema returns the documented schema of the keyword value, in its
+// unscoped form.
+func (t Keyword) ValueSchema() *Schema {
+	s := t.valueSchema()
+	t.document(s)
+	return s
+}
+
+// Schema returns the documented schema of the keyword in a section: the
+// value schema, or for scopable keywords, either the value schema or the
+// map of values indexed by "default" and "@<scope>".
+func (t Keyword) Schema() *Schema {
+	if !t.Scopable {
+		return t.ValueSchema()
+	}
+	s := &Schema{
+		AnyOf: []*Schema{
+			t.valueSchema(),
+			{
+				Type:          "object",
+				MinProperties: 1,
+				PatternProperties: map[string]*Schema{
+					"^(default|@.+)$": t.valueSchema(),
+				},
+				AdditionalProperties: schemaFalse,
+			},
+		},
+	}
+	t.document(s)
+	return s
+}
+
+// valueSchema returns the schema of the keyword value type.
+//
+// The list values are accepted both as strings and arrays. The values of
+// the numeric and boolean keywords are also accepted as strings, like the
+// ini values are, for example to support references.
+func (t Keyword) valueSchema() *Schema {
+	scalar := &Schema{Type: "string", Enum: t.Candidates}
+	var converter string
+	if t.Converter != nil {
+		converter = fmt.Sprint(t.Converter)
+	}
+	switch converter {
+	case "list", "list-lowercase", "set", "nodes", "other-nodes":
+		return &Schema{
+			AnyOf: []*Schema{
+				scalar,
+				{Type: "array", Items: scalar},
+			},
+		}
+	case "int", "int64":
+		return &Schema{Type: []string{"integer", "string"}}
+	case "float64":
+		return &Schema{Type: []string{"number", "string"}}
+	case "bool", "tristate":
+		return &Schema{Type: []string{"boolean", "string"}}
+	default:
+		return scalar
+	}
+}
+
+// document sets the keyword documentation in the schema annotations.
+func (t Keyword) document(s *Schema) {
+	s.Description = t.SchemaDescription()
+	if t.Default != "" {
+		s.Default = t.Default
+	}
+	if t.Example != "" {
+		s.Examples = []string{t.Example}
+	}
+}
+
+// SchemaDescription returns the keyword documentation, as used in the
+// schema descriptions.
+func (t Keyword) SchemaDescription() string {
+	var l []string
+	if !t.Text.IsZero() {
+		l = append(l, strings.TrimSpace(t.Text.String()))
+	}
+	if !t.DefaultText.IsZero() {
+		l = append(l, "Default: "+strings.TrimSpace(t.DefaultText.String()))
+	}
+	if t.Deprecated != "" {
+		s := "Deprecated since " + t.Deprecated + "."
+		if t.ReplacedBy != "" {
+			s += " Use " + t.ReplacedBy + " instead."
+		}
+		l = append(l, s)
+	}
+	return strings.Join(l, "\n\n")
+}
+
+// SectionSchema returns the schema of a section supporting the keywords.
+// The keywords are added in order, so a keyword takes precedence over the
+// following keywords with the same name.
+func SectionSchema(description string, kws ...Keyword) *Schema {
+	s := NewSchemaObject()
+	s.Description = description
+	for _, kw := range kws {
+		s.AddKeyword(kw)
+	}
+	sort.Strings(s.Required)
+	return s
+}
+
+// AddKeyword adds the keyword and its aliases to the properties of a
+// section schema. A scopable keyword is also accepted with a flat
+// "<option>@<scope>" name. A required keyword is added to the required
+// properties, unless it can be satisfied by a scoped, aliased or
+// conditional definition.
+func (t *Schema) AddKeyword(kw Keyword) {
+	if _, ok := t.Properties[kw.Option]; ok {
+		return
+	}
+	for _, name := range append([]string{kw.Option}, kw.Aliases...) {
+		t.Properties[name] = kw.Schema()
+		if kw.Scopable {
+			t.PatternProperties["^"+regexp.QuoteMeta(name)+"@.+$"] = kw.valueSchema()
+		}
+	}
+	if kw.Required && !kw.Scopable && kw.Default == "" && len(kw.Depends) == 0 && len(kw.Aliases) == 0 {
+		t.Required = append(t.Required, kw.Option)
+	}
+}
+
+// Factorize moves the property schemas used more than once to the
+// definitions of the root schema t, and replaces them by references.
+// The definitions are named after the property, suffixed by an index if
+// the property has different schemas.
+func (t *Schema) Factorize() {
+	count := make(map[string]int)
+	t.walkProperties(func(_ string, s *Schema) *Schema {
+		if b, err := json.Marshal(s); err == nil {
+			count[string(b)]++
+		}
+		return s
+	})
+	refs := make(map[string]string)
+	t.walkProperties(func(name string, s *Schema) *Schema {
+		b, err := json.Marshal(s)
+		if err != nil || count[string(b)] < 2 {
+			return s
+		}
+		if ref, ok := refs[string(b)]; ok {
+			return &Schema{Ref: ref}
+		}
+		if t.Definitions == nil {
+			t.Definitions = make(map[string]*Schema)
+		}
+		def := name
+		for i := 1; t.Definitions[def] != nil; i++ {
+			def = fmt.Sprintf("%s.%d", name, i)
+		}
+		t.Definitions[def] = s
+		refs[string(b)] = "#/definitions/" + def
+		return &Schema{Ref: refs[string(b)]}
+	})
+}
+
+// walkProperties calls fn for each property schema of t and its
+// subschemas, in a stable order, and replaces the property schema by the
+// returned schema.
+func (t *Schema) walkProperties(fn func(name string, s *Schema) *Schema) {
+	if t == nil {
+		return
+	}
+	for _, name := range sortedSchemaKeys(t.Properties) {
+		s := t.Properties[name]
+		s.walkProperties(fn)
+		t.Properties[name] = fn(name, s)
+	}
+	for _, pattern := range sortedSchemaKeys(t.PatternProperties) {
+		t.PatternProperties[pattern].walkProperties(fn)
+	}
+	for _, s := range t.OneOf {
+		s.walkProperties(fn)
+	}
+	for _, s := range t.AnyOf {
+		s.walkProperties(fn)
+	}
+}
+
+func sortedSchemaKeys(m map[string]*Schema) []string {
+	l := make([]string, 0, len(m))
+	for k := range m {
+		l = append(l, k)
+	}
+	sort.Strings(l)
+	return l
+}
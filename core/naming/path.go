@@ -298,6 +298,16 @@ func (t Paths) String() string {
 	return strings.Join(l, ",")
 }
 
+// Contains returns true if the path p is in the list.
+func (t Paths) Contains(p Path) bool {
+	for _, e := range t {
+		if e == p {
+			return true
+		}
+	}
+	return false
+}
+
 func (t Paths) Existing() Paths {
 	l := make(Paths, 0)
 	for _, p := range t {
@@ -27,8 +27,9 @@ type (
 		path naming.Path
 
 		// private
-		volatile bool
-		log      *plog.Logger
+		volatile     bool
+		log          *plog.Logger
+		configAuthor string
 
 		// caches
 		id         uuid.UUID
@@ -42,6 +43,10 @@ type (
 			tmpDir string
 		}
 
+		// templates are the objects extended by the configuration
+		templates    naming.Paths
+		templatesErr error
+
 		// method plugs
 		postCommit func() error
 	}
@@ -7,12 +7,20 @@ func (t *core) NewCompliance() (*compliance.T, error) {
 	if err != nil {
 		return nil, err
 	}
+	comp := compliance.New()
+	comp.SetObjectPath(t.path)
+	if n.complianceIsLocal() {
+		local, err := newComplianceLocal()
+		if err != nil {
+			return nil, err
+		}
+		comp.SetLocal(local, t.config.SectionMap("labels"))
+		return comp, nil
+	}
 	client, err := n.CollectorComplianceClient()
 	if err != nil {
 		return nil, err
 	}
-	comp := compliance.New()
 	comp.SetCollectorClient(client)
-	comp.SetObjectPath(t.path)
 	return comp, nil
 }
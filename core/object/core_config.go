@@ -47,6 +47,10 @@ func (t *core) loadConfig(referrer xconfig.Referrer) error {
 	}
 	t.config.Path = t.path
 	t.config.Referrer = referrer
+	if err := t.extend(); err != nil {
+		return err
+	}
+	t.config.RegisterPostCommit(t.addConfigRevision)
 	t.config.NodeReferrer, err = t.Node()
 	return nil
 }
@@ -0,0 +1,31 @@
+package object
+
+import (
+	"github.com/opensvc/om3/core/confighistory"
+)
+
+// ConfigAuthor returns the name recorded as the author of the configuration
+// revisions committed by the object.
+func (t *core) ConfigAuthor() string {
+	if t.configAuthor == "" {
+		t.configAuthor = confighistory.LocalAuthor()
+	}
+	return t.configAuthor
+}
+
+// addConfigRevision adds the installed configuration file to the object
+// configuration history. It is a noop for volatile objects, for objects
+// loaded from an alternate configuration file and for the kinds without
+// configuration history.
+func (t *core) addConfigRevision() error {
+	if t.volatile || !confighistory.IsSupported(t.path.Kind) {
+		return nil
+	}
+	if t.ConfigFile() != t.path.ConfigFile() {
+		return nil
+	}
+	if _, err := confighistory.New(t.path).AddFile(t.ConfigFile(), t.ConfigAuthor()); err != nil {
+		t.log.Warnf("add config revision: %s", err)
+	}
+	return nil
+}
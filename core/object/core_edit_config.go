@@ -2,16 +2,28 @@ package object
 
 import (
 	"github.com/opensvc/om3/core/xconfig"
+	"github.com/opensvc/om3/util/file"
 )
 
 func (t *core) RecoverAndEditConfig() error {
-	return xconfig.Edit(t.ConfigFile(), xconfig.EditModeRecover, t.config.Referrer)
+	return t.editConfig(xconfig.EditModeRecover)
 }
 
 func (t *core) DiscardAndEditConfig() error {
-	return xconfig.Edit(t.ConfigFile(), xconfig.EditModeDiscard, t.config.Referrer)
+	return t.editConfig(xconfig.EditModeDiscard)
 }
 
 func (t *core) EditConfig() error {
-	return xconfig.Edit(t.ConfigFile(), xconfig.EditModeNormal, t.config.Referrer)
+	return t.editConfig(xconfig.EditModeNormal)
+}
+
+func (t *core) editConfig(mode xconfig.EditMode) error {
+	mtime := file.ModTime(t.ConfigFile())
+	if err := xconfig.Edit(t.ConfigFile(), mode, t.config.Referrer); err != nil {
+		return err
+	}
+	if file.ModTime(t.ConfigFile()).Equal(mtime) {
+		return nil
+	}
+	return t.addConfigRevision()
 }
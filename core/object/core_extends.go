@@ -0,0 +1,110 @@
+package object
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/xconfig"
+	"github.com/opensvc/om3/util/key"
+)
+
+var (
+	keyExtends = key.New("DEFAULT", "extends")
+	keyID      = key.New("DEFAULT", "id")
+)
+
+// Templates returns the paths of the template objects layered under the
+// object configuration, in layering order.
+func (t *core) Templates() naming.Paths {
+	return t.templates
+}
+
+// extend layers the configurations of the templates listed in the
+// DEFAULT.extends keyword under the object configuration.
+//
+// A template can itself extend other templates, which are layered before
+// it. The templates that can not be loaded are skipped, and reported by
+// the config validation.
+func (t *core) extend() error {
+	var sources []any
+	t.templates, sources, t.templatesErr = resolveTemplates(t.path, t.config)
+	if t.templatesErr != nil {
+		t.log.Warnf("extends: %s", t.templatesErr)
+	}
+	return t.config.Extend(sources...)
+}
+
+// resolveTemplates returns the paths and the configurations of the
+// templates extended by the configuration c of the object p, bases first.
+//
+// The templates must be in the namespace of p, so extending a template
+// does not give access to the configuration of another namespace, and
+// can not be sec or usr objects. The id and extends keywords of the
+// templates are not layered, nor the data section and the keywords
+// protecting it.
+func resolveTemplates(p naming.Path, c *xconfig.T) (naming.Paths, []any, error) {
+	var (
+		errs    error
+		paths   naming.Paths
+		sources []any
+	)
+	namespace := p.Namespace
+	done := make(map[naming.Path]bool)
+	visiting := map[naming.Path]bool{p: true}
+
+	var walk func(naming.Path, *xconfig.T)
+	walk = func(p naming.Path, c *xconfig.T) {
+		for _, s := range strings.Fields(c.Get(keyExtends)) {
+			templatePath, err := naming.ParsePath(s)
+			switch {
+			case err != nil:
+				errs = errors.Join(errs, fmt.Errorf("%s: %s: %w", p, s, err))
+				continue
+			case templatePath.Namespace != namespace:
+				errs = errors.Join(errs, fmt.Errorf("%s: %s: the template is not in the %s namespace", p, templatePath, namespace))
+				continue
+			case templatePath.Kind == naming.KindSec || templatePath.Kind == naming.KindUsr:
+				errs = errors.Join(errs, fmt.Errorf("%s: %s: %s objects can not be templates", p, templatePath, templatePath.Kind))
+				continue
+			case done[templatePath]:
+				continue
+			case visiting[templatePath]:
+				errs = errors.Join(errs, fmt.Errorf("%s: %s: extends cycle", p, templatePath))
+				continue
+			case !templatePath.Exists():
+				errs = errors.Join(errs, fmt.Errorf("%s: %s: object does not exist", p, templatePath))
+				continue
+			}
+			cf := templatePath.ConfigFile()
+			templateConfig, err := xconfig.NewObject(cf, cf)
+			if err != nil {
+				errs = errors.Join(errs, fmt.Errorf("%s: %s: %w", p, templatePath, err))
+				continue
+			}
+			visiting[templatePath] = true
+			walk(templatePath, templateConfig)
+			delete(visiting, templatePath)
+			done[templatePath] = true
+
+			if err := templateConfig.PrepareUnset(keyID, keyExtends, keyDataKey, keyKeyProvider); err != nil {
+				errs = errors.Join(errs, fmt.Errorf("%s: %s: %w", p, templatePath, err))
+				continue
+			}
+			if err := templateConfig.PrepareDeleteSections(dataSectionName); err != nil {
+				errs = errors.Join(errs, fmt.Errorf("%s: %s: %w", p, templatePath, err))
+				continue
+			}
+			b, err := templateConfig.Bytes()
+			if err != nil {
+				errs = errors.Join(errs, fmt.Errorf("%s: %s: %w", p, templatePath, err))
+				continue
+			}
+			paths = append(paths, templatePath)
+			sources = append(sources, b)
+		}
+	}
+	walk(p, c)
+	return paths, sources, errs
+}
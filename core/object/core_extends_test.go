@@ -0,0 +1,165 @@
+package object_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/core/keyop"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/testhelper"
+	"github.com/opensvc/om3/util/key"
+)
+
+func TestExtends(t *testing.T) {
+	install := func(t *testing.T, s string, b string) naming.Path {
+		t.Helper()
+		p, err := naming.ParsePath(s)
+		require.NoError(t, err)
+		require.NoError(t, os.MkdirAll(filepath.Dir(p.ConfigFile()), os.ModePerm))
+		require.NoError(t, os.WriteFile(p.ConfigFile(), []byte(b), 0644))
+		return p
+	}
+
+	t.Run("layers the templates under the local config", func(t *testing.T) {
+		testhelper.Setup(t)
+		install(t, "test/cfg/base", `
+[DEFAULT]
+id = 7fdc2a5c-59c4-4fb1-9f3a-2c1f4d16d2b1
+
+[app#1]
+start = /bin/true
+stop = /bin/true
+`)
+		install(t, "test/cfg/app", `
+[DEFAULT]
+extends = test/cfg/base
+
+[app#1]
+check = /bin/true
+`)
+		p := install(t, "test/svc/s1", `
+[DEFAULT]
+extends = test/cfg/app
+
+[app#1]
+stop = /bin/false
+`)
+		o, err := object.NewSvc(p)
+		require.NoError(t, err)
+		assert.Equal(t, naming.Paths{naming.Path{Namespace: "test", Kind: naming.KindCfg, Name: "base"}, naming.Path{Namespace: "test", Kind: naming.KindCfg, Name: "app"}}, o.Templates())
+
+		c := o.Config()
+		assert.Equal(t, "/bin/true", c.GetString(key.New("app#1", "start")), "inherited from a template of the template")
+		assert.Equal(t, "/bin/true", c.GetString(key.New("app#1", "check")), "inherited from the template")
+		assert.Equal(t, "/bin/false", c.GetString(key.New("app#1", "stop")), "overridden by the local config")
+		assert.NotEqual(t, "7fdc2a5c-59c4-4fb1-9f3a-2c1f4d16d2b1", o.ID().String(), "id is not inherited")
+
+		raw, err := o.EvalConfig()
+		require.NoError(t, err)
+		assert.Contains(t, raw.String(), "check")
+
+		alerts, err := o.ValidateConfig(context.Background())
+		assert.NoError(t, err, "alerts: %s", alerts)
+
+		require.NoError(t, o.Set(context.Background(), *keyop.Parse("app#1.timeout=10s")))
+		b, err := os.ReadFile(p.ConfigFile())
+		require.NoError(t, err)
+		assert.Contains(t, string(b), "timeout = 10s")
+		assert.NotContains(t, string(b), "start", "the commit only writes the local config")
+	})
+
+	t.Run("reports the templates that can not be extended", func(t *testing.T) {
+		testhelper.Setup(t)
+		install(t, "test/cfg/loop", `
+[DEFAULT]
+extends = test/svc/s2
+
+[app#1]
+start = /bin/true
+`)
+		p := install(t, "test/svc/s2", `
+[DEFAULT]
+extends = test/cfg/loop test/cfg/missing
+`)
+		o, err := object.NewSvc(p)
+		require.NoError(t, err)
+		assert.Equal(t, naming.Paths{naming.Path{Namespace: "test", Kind: naming.KindCfg, Name: "loop"}}, o.Templates())
+		assert.Equal(t, "/bin/true", o.Config().GetString(key.New("app#1", "start")))
+
+		alerts, err := o.ValidateConfig(context.Background())
+		assert.Error(t, err)
+		require.NotEmpty(t, alerts)
+		alert := alerts[len(alerts)-1]
+		assert.Equal(t, key.New("DEFAULT", "extends"), alert.Key)
+		assert.Contains(t, alert.Comment, "extends cycle")
+		assert.Contains(t, alert.Comment, "test/cfg/missing: object does not exist")
+	})
+
+	t.Run("refuses the templates of other namespaces and the sec and usr templates", func(t *testing.T) {
+		testhelper.Setup(t)
+		install(t, "system/cfg/base", `
+[app#1]
+start = /bin/true
+`)
+		install(t, "test/sec/s1", `
+[DEFAULT]
+data_key = secret
+
+[data]
+key = value
+`)
+		install(t, "test/usr/u1", `
+[DEFAULT]
+grant = root
+`)
+		p := install(t, "test/svc/s3", `
+[DEFAULT]
+extends = system/cfg/base test/sec/s1 test/usr/u1
+`)
+		o, err := object.NewSvc(p)
+		require.NoError(t, err)
+		assert.Empty(t, o.Templates())
+		assert.Equal(t, "", o.Config().GetString(key.New("app#1", "start")))
+
+		alerts, err := o.ValidateConfig(context.Background())
+		assert.Error(t, err)
+		require.NotEmpty(t, alerts)
+		alert := alerts[len(alerts)-1]
+		assert.Equal(t, key.New("DEFAULT", "extends"), alert.Key)
+		assert.Contains(t, alert.Comment, "system/cfg/base: the template is not in the test namespace")
+		assert.Contains(t, alert.Comment, "test/sec/s1: sec objects can not be templates")
+		assert.Contains(t, alert.Comment, "test/usr/u1: usr objects can not be templates")
+	})
+
+	t.Run("does not layer the data section and the data keys", func(t *testing.T) {
+		testhelper.Setup(t)
+		install(t, "test/cfg/c1", `
+[DEFAULT]
+data_key = secret
+key_provider = tpm
+
+[data]
+key = value
+`)
+		p := install(t, "test/sec/s2", `
+[DEFAULT]
+extends = test/cfg/c1
+`)
+		o, err := object.NewSec(p)
+		require.NoError(t, err)
+		assert.Equal(t, naming.Paths{naming.Path{Namespace: "test", Kind: naming.KindCfg, Name: "c1"}}, o.Templates())
+		c := o.Config()
+		assert.False(t, c.HasKey(key.New("DEFAULT", "data_key")))
+		assert.False(t, c.HasKey(key.New("DEFAULT", "key_provider")))
+		assert.False(t, c.HasSectionString("data"))
+		keys, err := o.AllKeys()
+		require.NoError(t, err)
+		assert.Empty(t, keys)
+	})
+}
@@ -335,6 +335,13 @@ var keywordStore = keywords.Store{
 		Converter: converters.ListLowercase,
 		Text:      keywords.NewText(fs, "text/kw/core/parents"),
 	},
+	{
+		Section:   "DEFAULT",
+		Option:    "extends",
+		Converter: converters.List,
+		Text:      keywords.NewText(fs, "text/kw/core/extends"),
+		Example:   "ns1/cfg/base-app",
+	},
 	{
 		Section:   "DEFAULT",
 		Option:    "children",
@@ -367,6 +374,15 @@ var keywordStore = keywords.Store{
 		Converter: converters.Int,
 		Text:      keywords.NewText(fs, "text/kw/core/priority"),
 	},
+	{
+		Section:   "DEFAULT",
+		Option:    "check_thresholds",
+		Inherit:   keywords.InheritHead,
+		Converter: converters.List,
+		Kind:      naming.NewKinds(naming.KindSvc, naming.KindVol),
+		Example:   "fs_u>=95%,99%",
+		Text:      keywords.NewText(fs, "text/kw/core/check_thresholds"),
+	},
 	{
 		Section:   "subset",
 		Option:    "parallel",
@@ -376,6 +392,20 @@ var keywordStore = keywords.Store{
 	},
 
 	// Secrets
+	{
+		Section: "DEFAULT",
+		Option:  "key_provider",
+		Default: "cluster",
+		Example: "vault1",
+		Kind:    naming.NewKinds(naming.KindSec, naming.KindUsr),
+		Text:    keywords.NewText(fs, "text/kw/core/key_provider"),
+	},
+	{
+		Section: "DEFAULT",
+		Option:  "data_key",
+		Kind:    naming.NewKinds(naming.KindSec, naming.KindUsr),
+		Text:    keywords.NewText(fs, "text/kw/core/data_key"),
+	},
 	{
 		Section:  "DEFAULT",
 		Option:   "cn",
@@ -463,6 +493,13 @@ var keywordStore = keywords.Store{
 		Text:      keywords.NewText(fs, "text/kw/core/grant"),
 		Example:   "admin:test* guest:*",
 	},
+	{
+		Section: "DEFAULT",
+		Option:  "totp_sec",
+		Kind:    naming.NewKinds(naming.KindUsr),
+		Example: "system/sec/alice-totp",
+		Text:    keywords.NewText(fs, "text/kw/core/totp_sec"),
+	},
 	{
 		Section:   "DEFAULT",
 		Option:    "rollback",
@@ -481,6 +518,15 @@ var keywordStore = keywords.Store{
 		Kind:      naming.NewKinds(naming.KindSec),
 		Text:      keywords.NewText(fs, "text/kw/core/validity"),
 	},
+	{
+		Section:   "DEFAULT",
+		Option:    "renew",
+		Converter: converters.Duration,
+		Default:   "30d",
+		Example:   "60d",
+		Kind:      naming.NewKinds(naming.KindSec),
+		Text:      keywords.NewText(fs, "text/kw/core/renew"),
+	},
 	{
 		Section:  "DEFAULT",
 		Option:   "ca",
@@ -639,7 +685,7 @@ func driverIDFromRID(t Configurer, section string) (driver.ID, error) {
 
 func keywordLookup(store keywords.Store, k key.T, kind naming.Kind, sectionType string) keywords.Keyword {
 	switch k.Section {
-	case "data", "env":
+	case "data", "env", "labels":
 		return keywords.Keyword{
 			Option:   "*", // trick IsZero()
 			Scopable: true,
@@ -2,6 +2,7 @@ package object
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/opensvc/om3/core/actioncontext"
 	"github.com/opensvc/om3/core/xconfig"
@@ -15,5 +16,10 @@ func (t *core) ValidateConfig(ctx context.Context) (xconfig.ValidateAlerts, erro
 		return xconfig.ValidateAlerts{}, err
 	}
 	defer unlock()
-	return t.config.Validate()
+	alerts, err := t.config.Validate()
+	if t.templatesErr != nil {
+		alerts = append(alerts, t.config.NewValidateAlertExtends(t.templatesErr.Error()))
+		err = fmt.Errorf("")
+	}
+	return alerts, err
 }
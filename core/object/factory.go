@@ -28,6 +28,16 @@ func WithConfigData(b any) funcopt.O {
 	})
 }
 
+// WithConfigAuthor sets the name recorded as the author of the configuration
+// revisions committed by the object. The default is the local user name.
+func WithConfigAuthor(s string) funcopt.O {
+	return funcopt.F(func(t any) error {
+		o := t.(*core)
+		o.configAuthor = s
+		return nil
+	})
+}
+
 // WithVolatile makes sure not data is ever written by the object.
 func WithVolatile(s bool) funcopt.O {
 	return funcopt.F(func(t any) error {
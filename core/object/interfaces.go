@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/opensvc/om3/core/keyop"
+	"github.com/opensvc/om3/core/naming"
 	"github.com/opensvc/om3/core/rawconfig"
 	"github.com/opensvc/om3/core/schedule"
 	"github.com/opensvc/om3/core/xconfig"
@@ -15,6 +16,7 @@ type (
 	Configurer interface {
 		ConfigFile() string
 		Config() *xconfig.T
+		Templates() naming.Paths
 		EditConfig() error
 		RecoverAndEditConfig() error
 		DiscardAndEditConfig() error
@@ -2,6 +2,7 @@ package object
 
 import (
 	"os"
+	"time"
 
 	"github.com/opensvc/om3/util/key"
 )
@@ -19,6 +20,13 @@ type (
 		core
 		customEncode encodeFunc
 		customDecode decodeFunc
+
+		// dataKeyCache is the last unwrapped data key, so the key
+		// provider is not solicited for each value.
+		dataKeyCache struct {
+			wrapped string
+			key     []byte
+		}
 	}
 
 	// Keystore is the base interface of sec, cfg and usr objects
@@ -36,12 +44,17 @@ type (
 		EditKey(name string) error
 		InstallKey(name string) error
 		InstallKeyTo(string, string, *os.FileMode, *os.FileMode, string, string) error
+		InstallVolumes() error
 	}
 
 	// SecureKeystore is implemented by encrypting Keystore object kinds (usr, sec).
 	SecureKeystore interface {
 		GenCert() error
+		RotateCert() error
 		PKCS() ([]byte, error)
+		Rekey() error
+		CertNotAfter() (time.Time, error)
+		CertRenewBefore() time.Duration
 	}
 )
 
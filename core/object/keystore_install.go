@@ -274,6 +274,14 @@ func (t *keystore) InstallKeyTo(keyName string, dst string, mode *os.FileMode, d
 	return nil
 }
 
+// InstallVolumes installs the keys in the started volumes projecting them,
+// and signals the volumes consumers if the installed data changed. The
+// commits do the same, but the nodes receiving the configuration change
+// from a peer must call this function.
+func (t *keystore) InstallVolumes() error {
+	return t.postInstall("")
+}
+
 func (t *keystore) postInstall(k string) error {
 	changedVolumes := make(map[naming.Path]interface{})
 	type resvoler interface {
@@ -0,0 +1,152 @@
+package object
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/opensvc/om3/core/keyop"
+	"github.com/opensvc/om3/core/keyprovider"
+	"github.com/opensvc/om3/util/key"
+)
+
+const (
+	// encPrefix is the prefix of the values encrypted with the object data
+	// key. The values prefixed by "crypt:" are encrypted with the cluster
+	// secret, by older agents.
+	encPrefix = "enc:"
+)
+
+var (
+	keyDataKey     = key.New("DEFAULT", "data_key")
+	keyKeyProvider = key.New("DEFAULT", "key_provider")
+)
+
+// keyProviderConfig returns the configuration declaring the key
+// providers.
+func (t *keystore) keyProviderConfig() (keyprovider.Config, error) {
+	n, err := t.Node()
+	if err != nil {
+		return nil, err
+	}
+	return n.MergedConfig(), nil
+}
+
+// dataKey returns the object data key, unwrapped by the key provider. A
+// nil key is returned if the object has no data key yet.
+func (t *keystore) dataKey() ([]byte, error) {
+	wrapped := t.config.GetString(keyDataKey)
+	if wrapped == "" {
+		return nil, nil
+	}
+	if wrapped == t.dataKeyCache.wrapped {
+		return t.dataKeyCache.key, nil
+	}
+	config, err := t.keyProviderConfig()
+	if err != nil {
+		return nil, err
+	}
+	b, err := keyprovider.UnwrapKey(wrapped, config)
+	if err != nil {
+		return nil, fmt.Errorf("data key: %w", err)
+	}
+	t.dataKeyCache.wrapped = wrapped
+	t.dataKeyCache.key = b
+	return b, nil
+}
+
+// newDataKey sets a new data key, wrapped by the object key provider.
+// The change is not committed.
+func (t *keystore) newDataKey() ([]byte, error) {
+	config, err := t.keyProviderConfig()
+	if err != nil {
+		return nil, err
+	}
+	b, err := keyprovider.NewDataKey()
+	if err != nil {
+		return nil, err
+	}
+	provider := t.config.GetString(keyKeyProvider)
+	wrapped, err := keyprovider.WrapKey(provider, config, b)
+	if err != nil {
+		return nil, fmt.Errorf("data key: %w", err)
+	}
+	op := keyop.T{
+		Key:   keyDataKey,
+		Op:    keyop.Set,
+		Value: wrapped,
+	}
+	if err := t.config.Set(op); err != nil {
+		return nil, err
+	}
+	t.dataKeyCache.wrapped = wrapped
+	t.dataKeyCache.key = b
+	return b, nil
+}
+
+// secureEncode encrypts b with the object data key. The data key is
+// created on first use.
+func (t *keystore) secureEncode(b []byte) (string, error) {
+	dataKey, err := t.dataKey()
+	if err != nil {
+		return "", err
+	}
+	if dataKey == nil {
+		if dataKey, err = t.newDataKey(); err != nil {
+			return "", err
+		}
+	}
+	sealed, err := keyprovider.Seal(dataKey, b)
+	if err != nil {
+		return "", err
+	}
+	return encPrefix + base64.URLEncoding.EncodeToString(sealed), nil
+}
+
+// secureDecode decrypts a value encrypted with the object data key, or
+// with the cluster secret.
+func (t *keystore) secureDecode(s string) ([]byte, error) {
+	if !strings.HasPrefix(s, encPrefix) {
+		return secDecode(s)
+	}
+	sealed, err := base64.URLEncoding.DecodeString(s[len(encPrefix):])
+	if err != nil {
+		return nil, err
+	}
+	dataKey, err := t.dataKey()
+	if err != nil {
+		return nil, err
+	}
+	if dataKey == nil {
+		return nil, fmt.Errorf("encrypted value but no data key")
+	}
+	return keyprovider.Open(dataKey, sealed)
+}
+
+// Rekey replaces the data key by a new data key wrapped by the object key
+// provider, and encrypts all the values with the new data key. The values
+// encrypted with the cluster secret are also converted.
+func (t *keystore) Rekey() error {
+	names, err := t.AllKeys()
+	if err != nil {
+		return err
+	}
+	values := make(map[string][]byte)
+	for _, name := range names {
+		b, err := t.decode(name)
+		if err != nil {
+			return fmt.Errorf("decode key %s: %w", name, err)
+		}
+		values[name] = b
+	}
+	if _, err := t.newDataKey(); err != nil {
+		return err
+	}
+	for _, name := range names {
+		if err := t.addKey(name, values[name]); err != nil {
+			return err
+		}
+	}
+	t.log.Infof("rekeyed %d keys with the %s key provider", len(names), t.config.GetString(keyKeyProvider))
+	return t.config.Commit()
+}
@@ -0,0 +1,79 @@
+package object_test
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/core/keyop"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/omcrypto"
+	"github.com/opensvc/om3/testhelper"
+	"github.com/opensvc/om3/util/key"
+)
+
+func TestSecEncryption(t *testing.T) {
+	env := testhelper.Setup(t)
+	omcrypto.SetClusterName("test")
+	omcrypto.SetClusterSecret(strings.Repeat("s", 32))
+
+	keyFile := filepath.Join(env.Root, "master.key")
+	require.NoError(t, os.WriteFile(keyFile, []byte(strings.Repeat("k", 64)), 0600))
+	n, err := object.NewNode()
+	require.NoError(t, err)
+	require.NoError(t, n.Config().SetKeys(
+		*keyop.Parse("keyprovider#f1.type=file"),
+		*keyop.Parse("keyprovider#f1.file=" + keyFile),
+	))
+
+	p, err := naming.ParsePath("test/sec/s1")
+	require.NoError(t, err)
+	s, err := object.NewSec(p)
+	require.NoError(t, err)
+
+	// a value encrypted with the cluster secret by an older agent
+	b, err := omcrypto.NewMessage([]byte("legacy")).Encrypt()
+	require.NoError(t, err)
+	require.NoError(t, s.Config().SetKeys(*keyop.Parse("data.legacy=crypt:" + base64.URLEncoding.EncodeToString(b))))
+
+	require.NoError(t, s.AddKey("k1", []byte("v1")))
+	assert.True(t, strings.HasPrefix(s.Config().GetString(key.New("data", "k1")), "enc:"))
+	assert.True(t, strings.HasPrefix(s.Config().GetString(key.New("DEFAULT", "data_key")), "cluster:"))
+
+	decode := func(t *testing.T, name, expected string) {
+		t.Helper()
+		s, err := object.NewSec(p)
+		require.NoError(t, err)
+		b, err := s.DecodeKey(name)
+		require.NoError(t, err)
+		assert.Equal(t, expected, string(b))
+	}
+	decode(t, "k1", "v1")
+	decode(t, "legacy", "legacy")
+
+	t.Run("rekey with another key provider", func(t *testing.T) {
+		s, err := object.NewSec(p)
+		require.NoError(t, err)
+		wrapped := s.Config().GetString(key.New("DEFAULT", "data_key"))
+		require.NoError(t, s.Config().SetKeys(*keyop.Parse("key_provider=f1")))
+		require.NoError(t, s.Rekey())
+
+		s, err = object.NewSec(p)
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(s.Config().GetString(key.New("DEFAULT", "data_key")), "f1:"))
+		assert.NotEqual(t, wrapped, s.Config().GetString(key.New("DEFAULT", "data_key")))
+		assert.True(t, strings.HasPrefix(s.Config().GetString(key.New("data", "legacy")), "enc:"))
+		decode(t, "k1", "v1")
+		decode(t, "legacy", "legacy")
+
+		require.NoError(t, os.Remove(keyFile))
+		_, err = s.DecodeKey("k1")
+		assert.Error(t, err, "decode without the key file")
+	})
+}
@@ -1,22 +1,30 @@
 package object
 
 import (
+	"context"
 	"fmt"
 	"path/filepath"
 	"time"
 
 	"github.com/opensvc/om3/core/check"
+	"github.com/opensvc/om3/core/client"
 	"github.com/opensvc/om3/core/naming"
 	"github.com/opensvc/om3/core/rawconfig"
+	"github.com/opensvc/om3/daemon/api"
 	"github.com/opensvc/om3/util/exe"
 	"github.com/opensvc/om3/util/hostname"
+	"github.com/opensvc/om3/util/key"
 
+	_ "github.com/opensvc/om3/drivers/chkdrbd"
 	_ "github.com/opensvc/om3/drivers/chkfsidf"
 	_ "github.com/opensvc/om3/drivers/chkfsudf"
+	_ "github.com/opensvc/om3/drivers/chkmd"
+	_ "github.com/opensvc/om3/drivers/chkzpool"
 )
 
 // Checks finds and runs the check drivers.
-// Results are aggregated and sent to the collector.
+// Results are aggregated, evaluated against the objects and node
+// thresholds, posted to the daemon and sent to the collector.
 func (t Node) Checks() (check.ResultSet, error) {
 	rootPath := filepath.Join(rawconfig.Paths.Drivers, "check", "chk*")
 	customCheckPaths := exe.FindExe(rootPath)
@@ -28,17 +36,98 @@ func (t Node) Checks() (check.ResultSet, error) {
 	if err != nil {
 		return *check.NewResultSet(), err
 	}
+	// the node is passed along with the objects so the checkers can read
+	// their settings from the node configuration.
 	runner := check.NewRunner(
 		check.RunnerWithCustomCheckPaths(customCheckPaths...),
 		check.RunnerWithObjects(objs...),
+		check.RunnerWithObjects(&t),
 	)
 	rs := runner.Do()
+	thresholds, err := t.checkThresholds(objs)
+	if err != nil {
+		return *rs, err
+	}
+	rs.ApplyThresholds(thresholds)
+	if err := t.postChecks(rs); err != nil {
+		// daemon can be down
+		t.log.Debugf("post checks error: %s", err)
+	}
 	if err := t.pushChecks(rs); err != nil {
 		return *rs, err
 	}
 	return *rs, nil
 }
 
+// checkThresholds returns the objects check_thresholds followed by the
+// node checks.thresholds, so the object thresholds are evaluated first.
+func (t Node) checkThresholds(objs []interface{}) (check.Thresholds, error) {
+	thresholds := make(check.Thresholds, 0)
+	for _, obj := range objs {
+		o, ok := obj.(Configurer)
+		if !ok {
+			continue
+		}
+		l := o.Config().GetStrings(key.New("DEFAULT", "check_thresholds"))
+		if objThresholds, err := check.ParseThresholds(fmt.Sprint(obj), l); err != nil {
+			return thresholds, fmt.Errorf("%s: %w", obj, err)
+		} else {
+			thresholds = append(thresholds, objThresholds...)
+		}
+	}
+	l := t.MergedConfig().GetStrings(key.Parse("checks.thresholds"))
+	if nodeThresholds, err := check.ParseThresholds("", l); err != nil {
+		return thresholds, fmt.Errorf("node: %w", err)
+	} else {
+		thresholds = append(thresholds, nodeThresholds...)
+	}
+	return thresholds, nil
+}
+
+// postChecks sends the evaluated check results to the local daemon, which
+// publishes the thresholds breaches and degrades the instances status if
+// checks.degrade is set.
+func (t Node) postChecks(rs *check.ResultSet) error {
+	body := api.PostNodeChecks{
+		Degrade: t.MergedConfig().GetBool(key.Parse("checks.degrade")),
+		Results: make([]api.CheckResult, len(rs.Data)),
+	}
+	for i, e := range rs.Data {
+		body.Results[i] = api.CheckResult{
+			Driver:   e.DriverName,
+			Hint:     e.Hint,
+			Instance: e.Instance,
+			Path:     e.Path,
+			Status:   e.Status,
+			Type:     e.DriverGroup,
+			Unit:     e.Unit,
+			Value:    e.Value,
+		}
+	}
+	c, err := client.New()
+	if err != nil {
+		return err
+	}
+	resp, err := c.PostNodeChecksWithResponse(context.Background(), body)
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode() {
+	case 200:
+		return nil
+	case 400:
+		return fmt.Errorf("%s", resp.JSON400)
+	case 401:
+		return fmt.Errorf("%s", resp.JSON401)
+	case 403:
+		return fmt.Errorf("%s", resp.JSON403)
+	case 500:
+		return fmt.Errorf("%s", resp.JSON500)
+	default:
+		return fmt.Errorf("unexpected response: %s", string(resp.Body))
+	}
+}
+
 func (t Node) pushChecks(rs *check.ResultSet) error {
 	client, err := t.CollectorFeedClient()
 	if err != nil {
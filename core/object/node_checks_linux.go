@@ -0,0 +1,9 @@
+//go:build linux
+
+package object
+
+import (
+	_ "github.com/opensvc/om3/drivers/chkmem"
+	_ "github.com/opensvc/om3/drivers/chkmpath"
+	_ "github.com/opensvc/om3/drivers/chkvg"
+)
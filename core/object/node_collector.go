@@ -13,7 +13,31 @@ import (
 func (t Node) CollectorFeedClient() (*collector.Client, error) {
 	s := t.mergedConfig.GetString(key.Parse("node.dbopensvc"))
 	secret := t.config.GetString(key.Parse("node.uuid"))
-	return collector.NewFeedClient(s, secret)
+	client, err := collector.NewFeedClient(s, secret)
+	if err != nil {
+		return nil, err
+	}
+	client.SetSpool(t.CollectorSpool())
+	return client, nil
+}
+
+// CollectorSpool returns the spool where the feed calls are queued while
+// the collector is unreachable, with the limits set by the node.dbspool_max_size
+// and node.dbspool_max_age keywords.
+func (t Node) CollectorSpool() *collector.Spool {
+	var (
+		maxSize int64
+		maxAge  time.Duration
+	)
+	if i := t.mergedConfig.GetSize(key.Parse("node.dbspool_max_size")); i != nil {
+		maxSize = *i
+	}
+	if d := t.mergedConfig.GetDuration(key.Parse("node.dbspool_max_age")); d != nil {
+		maxAge = *d
+	}
+	spool := collector.NewSpool(collector.SpoolDir())
+	spool.SetLimits(maxSize, maxAge)
+	return spool
 }
 
 func (t Node) CollectorInitClient() (*collector.Client, error) {
@@ -1,13 +1,78 @@
 package object
 
-import "github.com/opensvc/om3/util/compliance"
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/rawconfig"
+	"github.com/opensvc/om3/util/compliance"
+	"github.com/opensvc/om3/util/key"
+)
+
+var (
+	// complianceCfgPath is the cfg object whose keys are local compliance
+	// definition documents.
+	complianceCfgPath = naming.Path{Namespace: "system", Kind: naming.KindCfg, Name: "compliance"}
+)
 
 func (t Node) NewCompliance() (*compliance.T, error) {
+	comp := compliance.New()
+	if t.complianceIsLocal() {
+		local, err := newComplianceLocal()
+		if err != nil {
+			return nil, err
+		}
+		comp.SetLocal(local, t.Labels())
+		return comp, nil
+	}
 	client, err := t.CollectorComplianceClient()
 	if err != nil {
 		return nil, err
 	}
-	comp := compliance.New()
 	comp.SetCollectorClient(client)
 	return comp, nil
 }
+
+// complianceIsLocal returns true if the compliance rulesets and modulesets
+// are the local ones instead of the collector ones.
+func (t Node) complianceIsLocal() bool {
+	switch t.mergedConfig.GetString(key.Parse("compliance.source")) {
+	case "local":
+		return true
+	case "collector":
+		return false
+	default:
+		return t.mergedConfig.GetString(key.Parse("node.dbopensvc")) == ""
+	}
+}
+
+// newComplianceLocal returns the local compliance definitions loaded from
+// the <etc>/compliance/ files and the system/cfg/compliance keys.
+func newComplianceLocal() (*compliance.Local, error) {
+	local := compliance.NewLocal()
+	if err := local.LoadDir(filepath.Join(rawconfig.Paths.Etc, "compliance")); err != nil {
+		return nil, err
+	}
+	if !complianceCfgPath.Exists() {
+		return local, nil
+	}
+	o, err := NewCfg(complianceCfgPath, WithVolatile(true))
+	if err != nil {
+		return nil, err
+	}
+	names, err := o.AllKeys()
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		b, err := o.DecodeKey(name)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s: %w", complianceCfgPath, name, err)
+		}
+		if err := local.Load(b, fmt.Sprintf("%s:%s", complianceCfgPath, name)); err != nil {
+			return nil, err
+		}
+	}
+	return local, nil
+}
@@ -319,6 +319,20 @@ var nodeCommonKeywords = []keywords.Keyword{
 		Default:   "true",
 		Text:      keywords.NewText(fs, "text/kw/node/node.dblog"),
 	},
+	{
+		Section:   "node",
+		Option:    "dbspool_max_size",
+		Converter: converters.Size,
+		Default:   "50m",
+		Text:      keywords.NewText(fs, "text/kw/node/node.dbspool_max_size"),
+	},
+	{
+		Section:   "node",
+		Option:    "dbspool_max_age",
+		Converter: converters.Duration,
+		Default:   "7d",
+		Text:      keywords.NewText(fs, "text/kw/node/node.dbspool_max_age"),
+	},
 	{
 		Section: "node",
 		Option:  "branch",
@@ -382,12 +396,33 @@ var nodeCommonKeywords = []keywords.Keyword{
 		Default: "~00:00-06:00",
 		Text:    keywords.NewText(fs, "text/kw/node/sysreport.schedule"),
 	},
+	{
+		Section:   "sysreport",
+		Option:    "snapshot_keep",
+		Converter: converters.Int,
+		Default:   "100",
+		Text:      keywords.NewText(fs, "text/kw/node/sysreport.snapshot_keep"),
+	},
+	{
+		Section:   "sysreport",
+		Option:    "snapshot_max_age",
+		Converter: converters.Duration,
+		Default:   "90d",
+		Text:      keywords.NewText(fs, "text/kw/node/sysreport.snapshot_max_age"),
+	},
 	{
 		Section: "compliance",
 		Option:  "schedule",
 		Default: "02:00-06:00",
 		Text:    keywords.NewText(fs, "text/kw/node/compliance.schedule"),
 	},
+	{
+		Section:    "compliance",
+		Option:     "source",
+		Candidates: []string{"auto", "collector", "local"},
+		Default:    "auto",
+		Text:       keywords.NewText(fs, "text/kw/node/compliance.source"),
+	},
 	{
 		Section:   "compliance",
 		Option:    "auto_update",
@@ -401,6 +436,20 @@ var nodeCommonKeywords = []keywords.Keyword{
 		Default: "~00:00-06:00",
 		Text:    keywords.NewText(fs, "text/kw/node/checks.schedule"),
 	},
+	{
+		Section:   "checks",
+		Option:    "thresholds",
+		Converter: converters.List,
+		Example:   "fs_u>=90%,98% mem_u:mem.avail<=10%,5%",
+		Text:      keywords.NewText(fs, "text/kw/node/checks.thresholds"),
+	},
+	{
+		Section:   "checks",
+		Option:    "degrade",
+		Converter: converters.Bool,
+		Default:   "false",
+		Text:      keywords.NewText(fs, "text/kw/node/checks.degrade"),
+	},
 	{
 		Section: "packages",
 		Option:  "schedule",
@@ -419,6 +468,31 @@ var nodeCommonKeywords = []keywords.Keyword{
 		Default: "~00:00-06:00",
 		Text:    keywords.NewText(fs, "text/kw/node/asset.schedule"),
 	},
+	{
+		Section: "asset",
+		Option:  "push_url",
+		Example: "https://cmdb.example.com/api/hosts",
+		Text:    keywords.NewText(fs, "text/kw/node/asset.push_url"),
+	},
+	{
+		Section: "asset",
+		Option:  "push_template",
+		Example: "/etc/opensvc/asset.tmpl",
+		Text:    keywords.NewText(fs, "text/kw/node/asset.push_template"),
+	},
+	{
+		Section:   "asset",
+		Option:    "push_headers",
+		Converter: converters.Shlex,
+		Example:   "\"Authorization: Bearer xxx\"",
+		Text:      keywords.NewText(fs, "text/kw/node/asset.push_headers"),
+	},
+	{
+		Section:   "asset",
+		Option:    "push_insecure",
+		Converter: converters.Bool,
+		Text:      keywords.NewText(fs, "text/kw/node/asset.push_insecure"),
+	},
 	{
 		Section: "disks",
 		Option:  "schedule",
@@ -732,7 +806,7 @@ var nodeCommonKeywords = []keywords.Keyword{
 		Section:    "pool",
 		Option:     "type",
 		Default:    "directory",
-		Candidates: []string{"directory", "loop", "vg", "zpool", "freenas", "share", "shm", "symmetrix", "virtual", "dorado", "hcs", "drbd"},
+		Candidates: []string{"directory", "loop", "vg", "thinlvm", "zpool", "btrfs", "freenas", "share", "shm", "symmetrix", "virtual", "dorado", "hcs", "drbd"},
 		Text:       keywords.NewText(fs, "text/kw/node/pool.type"),
 	},
 	{
@@ -852,6 +926,37 @@ var nodeCommonKeywords = []keywords.Keyword{
 		Required: true,
 		Text:     keywords.NewText(fs, "text/kw/node/pool.vg.name"),
 	},
+	{
+		Section: "pool",
+		Types:   []string{"vg"},
+		Option:  "snap_size",
+		Default: "20%ORIGIN",
+		Example: "10g",
+		Text:    keywords.NewText(fs, "text/kw/node/pool.vg.snap_size"),
+	},
+	{
+		Section:  "pool",
+		Types:    []string{"thinlvm"},
+		Option:   "vg",
+		Required: true,
+		Text:     keywords.NewText(fs, "text/kw/node/pool.thinlvm.vg"),
+	},
+	{
+		Section:  "pool",
+		Types:    []string{"thinlvm"},
+		Option:   "thinpool",
+		Required: true,
+		Text:     keywords.NewText(fs, "text/kw/node/pool.thinlvm.thinpool"),
+	},
+	{
+		Section:   "pool",
+		Types:     []string{"thinlvm"},
+		Option:    "max_overcommit",
+		Converter: converters.Float64,
+		Default:   "2",
+		Example:   "1.5",
+		Text:      keywords.NewText(fs, "text/kw/node/pool.thinlvm.max_overcommit"),
+	},
 	{
 		Section: "pool",
 		Types:   []string{"drbd"},
@@ -891,6 +996,14 @@ var nodeCommonKeywords = []keywords.Keyword{
 		Default: "{var}/pool/directory",
 		Text:    keywords.NewText(fs, "text/kw/node/pool.directory.path"),
 	},
+	{
+		Section:  "pool",
+		Option:   "path",
+		Types:    []string{"btrfs"},
+		Required: true,
+		Example:  "/srv/btrfs",
+		Text:     keywords.NewText(fs, "text/kw/node/pool.btrfs.path"),
+	},
 	{
 		Section: "pool",
 		Option:  "template",
@@ -932,7 +1045,7 @@ var nodeCommonKeywords = []keywords.Keyword{
 	{
 		Section: "pool",
 		Option:  "fs_type",
-		Types:   []string{"freenas", "dorado", "hcs", "symmetrix", "drbd", "loop", "vg"},
+		Types:   []string{"freenas", "dorado", "hcs", "symmetrix", "drbd", "loop", "vg", "thinlvm"},
 		Default: "xfs",
 		Text:    keywords.NewText(fs, "text/kw/node/pool.fs_type"),
 	},
@@ -1382,6 +1495,53 @@ var nodeCommonKeywords = []keywords.Keyword{
 		Example:   "2000",
 		Text:      keywords.NewText(fs, "text/kw/node/array.nexenta.port"),
 	},
+	{
+		Section:    "keyprovider",
+		Option:     "type",
+		Candidates: []string{"file", "passphrase", "vault"},
+		Required:   true,
+		Text:       keywords.NewText(fs, "text/kw/node/keyprovider.type"),
+	},
+	{
+		Section:  "keyprovider",
+		Types:    []string{"file"},
+		Option:   "file",
+		Required: true,
+		Scopable: true,
+		Example:  "/etc/opensvc/master.key",
+		Text:     keywords.NewText(fs, "text/kw/node/keyprovider.file.file"),
+	},
+	{
+		Section: "keyprovider",
+		Types:   []string{"passphrase"},
+		Option:  "passphrase_env",
+		Default: "OSVC_KEY_PASSPHRASE",
+		Text:    keywords.NewText(fs, "text/kw/node/keyprovider.passphrase.passphrase_env"),
+	},
+	{
+		Section:  "keyprovider",
+		Types:    []string{"vault"},
+		Option:   "url",
+		Required: true,
+		Example:  "https://127.0.0.1:8200",
+		Text:     keywords.NewText(fs, "text/kw/node/keyprovider.vault.url"),
+	},
+	{
+		Section:  "keyprovider",
+		Types:    []string{"vault"},
+		Option:   "key",
+		Required: true,
+		Example:  "opensvc",
+		Text:     keywords.NewText(fs, "text/kw/node/keyprovider.vault.key"),
+	},
+	{
+		Section:  "keyprovider",
+		Types:    []string{"vault"},
+		Option:   "token_file",
+		Scopable: true,
+		Example:  "/etc/opensvc/vault.token",
+		Text:     keywords.NewText(fs, "text/kw/node/keyprovider.vault.token_file"),
+	},
 }
 
 var nodeKeywordStore = keywords.Store(append(nodePrivateKeywords, nodeCommonKeywords...))
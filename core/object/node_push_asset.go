@@ -3,6 +3,7 @@ package object
 import (
 	"errors"
 	"fmt"
+	"os"
 
 	"github.com/opensvc/om3/util/asset"
 	"github.com/opensvc/om3/util/hostname"
@@ -70,23 +71,33 @@ func (t Node) assetValueClusterID() (data asset.Value) {
 	return
 }
 
-// PushAsset assembles the asset inventory data.
+// PushAsset assembles the asset inventory data and sends it to the
+// collector, and to the asset.push_url webhook if set.
 // Each entry value comes from:
 // * overrides (in config)
 // * probes
 // * default (code)
 func (t Node) PushAsset() (asset.Data, error) {
-	data, err := t.getAsset()
+	data, err := t.Asset()
 	if err != nil {
 		return data, err
 	}
-	if err := t.pushAsset(data); err != nil {
-		return data, err
+	hook := t.assetWebhook()
+	if hook.URL == "" || t.mergedConfig.GetString(key.Parse("node.dbopensvc")) != "" {
+		if err := t.pushAsset(data); err != nil {
+			return data, err
+		}
+	}
+	if hook.URL != "" {
+		if err := t.pushAssetWebhook(hook, data); err != nil {
+			return data, err
+		}
 	}
 	return data, nil
 }
 
-func (t Node) getAsset() (asset.Data, error) {
+// Asset assembles the asset inventory data, without sending it.
+func (t Node) Asset() (asset.Data, error) {
 	data := asset.NewData()
 
 	// from core
@@ -146,6 +157,30 @@ func (t Node) getAsset() (asset.Data, error) {
 	return data, nil
 }
 
+func (t Node) assetWebhook() asset.Webhook {
+	return asset.Webhook{
+		URL:      t.mergedConfig.GetString(key.Parse("asset.push_url")),
+		Template: t.mergedConfig.GetString(key.Parse("asset.push_template")),
+		Headers:  t.mergedConfig.GetStrings(key.Parse("asset.push_headers")),
+		Insecure: t.mergedConfig.GetBool(key.Parse("asset.push_insecure")),
+	}
+}
+
+func (t Node) pushAssetWebhook(hook asset.Webhook, data asset.Data) error {
+	if hook.Template != "" {
+		b, err := os.ReadFile(hook.Template)
+		if err != nil {
+			return fmt.Errorf("asset webhook template: %w", err)
+		}
+		hook.Template = string(b)
+	}
+	if err := hook.Push(data); err != nil {
+		return err
+	}
+	t.Log().Infof("asset pushed to %s", hook.URL)
+	return nil
+}
+
 func (t Node) pushAsset(data asset.Data) error {
 	hn := hostname.Hostname()
 	hba := func() []interface{} {
@@ -1,12 +1,20 @@
 package object
 
-import "github.com/opensvc/om3/util/sysreport"
+import (
+	"time"
 
-// Sysreport sends an archive of modified files the agent is configured
-// to track, and the list of files deleted since the last call.
+	"github.com/opensvc/om3/util/key"
+	"github.com/opensvc/om3/util/sysreport"
+)
+
+// Sysreport stores a local snapshot of the files and command outputs the
+// agent is configured to track. If a collector is configured, it also sends
+// an archive of the modified files, and the list of files deleted since the
+// last call.
 //
 // The collector is in charge of versioning this information and of
-// reporting on changes.
+// reporting on changes. Without collector, the local snapshots can be
+// compared with SysreportDiff.
 func (t Node) Sysreport() error {
 	sr, err := t.newSysreport()
 	if err != nil {
@@ -25,11 +33,37 @@ func (t Node) ForceSysreport() error {
 }
 
 func (t Node) newSysreport() (*sysreport.T, error) {
+	sr := sysreport.New()
+	var maxAge time.Duration
+	if d := t.mergedConfig.GetDuration(key.Parse("sysreport.snapshot_max_age")); d != nil {
+		maxAge = *d
+	}
+	sr.SetSnapshotRetention(t.mergedConfig.GetInt(key.Parse("sysreport.snapshot_keep")), maxAge)
+	if t.mergedConfig.GetString(key.Parse("node.dbopensvc")) == "" {
+		return sr, nil
+	}
 	client, err := t.CollectorFeedClient()
 	if err != nil {
 		return nil, err
 	}
-	sr := sysreport.New()
 	sr.SetCollectorClient(client)
 	return sr, nil
 }
+
+// SysreportSnapshots returns the local sysreport snapshots, the oldest
+// first.
+func (t Node) SysreportSnapshots() (sysreport.Snapshots, error) {
+	return sysreport.NewSnapshotStore(sysreport.SnapshotDir()).List()
+}
+
+// SysreportDiff returns the file and command output changes between two
+// local sysreport snapshots. See sysreport.SnapshotStore.Range for the
+// selection of the snapshots by rev and since.
+func (t Node) SysreportDiff(rev string, since time.Time) (sysreport.Changes, error) {
+	store := sysreport.NewSnapshotStore(sysreport.SnapshotDir())
+	a, b, err := store.Range(rev, since)
+	if err != nil {
+		return nil, err
+	}
+	return store.Diff(a, b)
+}
@@ -0,0 +1,209 @@
+package object
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/opensvc/om3/core/driver"
+	"github.com/opensvc/om3/core/keywords"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/resource"
+	"github.com/opensvc/om3/util/stringslice"
+)
+
+const (
+	// SchemaNode is the name of the node configuration schema. The other
+	// schemas are named after the object kind, the cluster configuration
+	// schema being the ccfg kind schema.
+	SchemaNode = "node"
+)
+
+var (
+	// SchemaNames is the list of the supported configuration schema names.
+	SchemaNames = []string{
+		SchemaNode,
+		string(naming.KindCcfg),
+		string(naming.KindSvc),
+		string(naming.KindVol),
+		string(naming.KindCfg),
+		string(naming.KindSec),
+		string(naming.KindUsr),
+	}
+)
+
+// Schema returns the json schema of the node configuration, or of the
+// configuration of the objects of a kind. The schema validates the json
+// and yaml structured documents, and the flat json representation of
+// the ini configurations.
+//
+// The keywords documentation is set as the properties description. The
+// resource sections are described as one schema per driver type.
+func Schema(name string) (*keywords.Schema, error) {
+	var s *keywords.Schema
+	switch name {
+	case SchemaNode:
+		s = storeSchema(nodeKeywordStore, naming.KindInvalid)
+		s.Title = "node configuration"
+	case string(naming.KindCcfg):
+		s = storeSchema(ccfgKeywordStore, naming.KindCcfg)
+		s.Title = "cluster configuration"
+	case string(naming.KindSvc), string(naming.KindVol), string(naming.KindCfg), string(naming.KindSec), string(naming.KindUsr):
+		s = objectSchema(naming.Kind(name))
+		s.Title = name + " object configuration"
+	default:
+		return nil, fmt.Errorf("unsupported schema: %s (must be one of %s)", name, SchemaNames)
+	}
+	s.Schema = keywords.SchemaDialect
+	s.ID = "https://www.opensvc.com/schemas/om3/" + name + ".json"
+	s.Properties["labels"] = keywords.NewSchemaMap("The labels, used by the selectors.")
+	s.Factorize()
+	return s, nil
+}
+
+// metadataSchema returns the schema of the metadata section, defining the
+// object path in the declarative definitions.
+func metadataSchema() *keywords.Schema {
+	s := keywords.NewSchemaObject()
+	s.Description = "The object path, used by the declarative definitions. This section is not installed."
+	s.Properties["name"] = &keywords.Schema{Type: "string", Description: "The object name."}
+	s.Properties["namespace"] = &keywords.Schema{Type: []string{"string", "null"}, Description: "The object namespace."}
+	s.Properties["kind"] = &keywords.Schema{Type: "string", Enum: naming.KindStrings, Description: "The object kind."}
+	return s
+}
+
+// objectSchema returns the schema of the configuration of the kind objects.
+func objectSchema(kind naming.Kind) *keywords.Schema {
+	s := keywords.NewSchemaObject()
+	store := append(keywords.Store{}, keywordStore...)
+	sort.Sort(store)
+
+	// The keywords without section are accepted in every section.
+	common := make([]keywords.Keyword, 0)
+	sections := make(map[string][]keywords.Keyword)
+	for _, kw := range store {
+		if !kw.Kind.Has(kind) {
+			continue
+		}
+		if kw.Section == "" {
+			common = append(common, kw)
+		} else {
+			sections[kw.Section] = append(sections[kw.Section], kw)
+		}
+	}
+	for section, kws := range sections {
+		schema := keywords.SectionSchema("", append(kws, common...)...)
+		if section == "DEFAULT" {
+			s.Properties[section] = schema
+		} else {
+			s.PatternProperties[sectionPattern(section)] = schema
+		}
+	}
+	s.Properties["metadata"] = metadataSchema()
+	s.Properties["env"] = keywords.NewSchemaMap("The variables exported to the resources scripts environment.")
+	switch kind {
+	case naming.KindCfg, naming.KindSec, naming.KindUsr:
+		s.Properties["data"] = keywords.NewSchemaMap("The key values.")
+	case naming.KindSvc, naming.KindVol:
+		for group, schema := range resourceSchemas(kind, common) {
+			s.PatternProperties[sectionPattern(group.String())] = schema
+		}
+	}
+	return s
+}
+
+// resourceSchemas returns the schemas of the resource sections, indexed by
+// driver group. The section schema of a driver group is one of the schemas
+// of its drivers, selected by the type keyword value.
+func resourceSchemas(kind naming.Kind, common []keywords.Keyword) map[driver.Group]*keywords.Schema {
+	m := make(map[driver.Group]*keywords.Schema)
+	ids := driver.List()
+	sort.Sort(ids)
+	for _, did := range ids {
+		allocator, ok := driver.GetStrict(did).(func() resource.Driver)
+		if !ok {
+			continue
+		}
+		kws := make([]keywords.Keyword, 0)
+		for _, kw := range allocator().Manifest().Keywords() {
+			if kw.Kind.Has(kind) {
+				kws = append(kws, kw)
+			}
+		}
+		if len(kws) == 0 {
+			continue
+		}
+		store := keywords.Store(kws)
+		sort.Sort(store)
+		schema := keywords.SectionSchema(did.String()+" resource", store...)
+		for _, kw := range common {
+			if kw.Option != "type" || did.Name == "" {
+				schema.AddKeyword(kw)
+			}
+		}
+		if did.Name != "" {
+			schema.Properties["type"] = &keywords.Schema{Const: did.Name}
+			if driver.DefaultDriver[did.Group] != did.Name {
+				schema.Required = append(schema.Required, "type")
+			}
+		}
+		if _, ok := m[did.Group]; !ok {
+			m[did.Group] = &keywords.Schema{}
+		}
+		m[did.Group].OneOf = append(m[did.Group].OneOf, schema)
+	}
+	return m
+}
+
+// storeSchema returns the schema of a node or cluster configuration. The
+// sections with a type keyword are described as one schema per type
+// candidate.
+func storeSchema(store keywords.Store, kind naming.Kind) *keywords.Schema {
+	s := keywords.NewSchemaObject()
+	store = append(keywords.Store{}, store...)
+	sort.Sort(store)
+	sections := make(map[string][]keywords.Keyword)
+	for _, kw := range store {
+		if kw.Section != "" && kw.Kind.Has(kind) {
+			sections[kw.Section] = append(sections[kw.Section], kw)
+		}
+	}
+	for section, kws := range sections {
+		var typeKeyword keywords.Keyword
+		for _, kw := range kws {
+			if kw.Option == "type" {
+				typeKeyword = kw
+			}
+		}
+		if len(typeKeyword.Candidates) == 0 {
+			s.Properties[section] = keywords.SectionSchema("", kws...)
+			s.PatternProperties[sectionPattern(section)] = &keywords.Schema{Ref: "#/properties/" + section}
+			continue
+		}
+		schema := &keywords.Schema{}
+		for _, t := range typeKeyword.Candidates {
+			typeSchema := keywords.SectionSchema(section + " " + t)
+			for _, kw := range kws {
+				switch {
+				case kw.Option == "type":
+					typeSchema.Properties["type"] = &keywords.Schema{Const: t, Description: kw.SchemaDescription()}
+					if kw.Default != t {
+						typeSchema.Required = append(typeSchema.Required, "type")
+					}
+				case len(kw.Types) == 0 || stringslice.Has(t, kw.Types):
+					typeSchema.AddKeyword(kw)
+				}
+			}
+			sort.Strings(typeSchema.Required)
+			schema.OneOf = append(schema.OneOf, typeSchema)
+		}
+		s.PatternProperties[sectionPattern(section)] = schema
+	}
+	return s
+}
+
+// sectionPattern returns the pattern of the indexed section names of a
+// section family, like "^hb#.+$" for the heartbeats.
+func sectionPattern(section string) string {
+	return "^" + regexp.QuoteMeta(section) + "#.+$"
+}
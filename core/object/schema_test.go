@@ -0,0 +1,65 @@
+package object_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/opensvc/om3/core/driverdb"
+	"github.com/opensvc/om3/core/keywords"
+	"github.com/opensvc/om3/core/object"
+)
+
+func TestSchema(t *testing.T) {
+	for _, name := range object.SchemaNames {
+		t.Run(name, func(t *testing.T) {
+			s, err := object.Schema(name)
+			require.NoError(t, err)
+			assert.Equal(t, keywords.SchemaDialect, s.Schema)
+			_, err = json.Marshal(s)
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("resource sections are one of the driver schemas", func(t *testing.T) {
+		s, err := object.Schema("svc")
+		require.NoError(t, err)
+		require.Contains(t, s.Properties, "DEFAULT")
+		assert.Contains(t, s.Properties["DEFAULT"].Properties, "nodes")
+		assert.NotContains(t, s.Properties, "data")
+		app, ok := s.PatternProperties["^app#.+$"]
+		require.True(t, ok)
+		required := make(map[any][]string)
+		for _, branch := range app.OneOf {
+			required[branch.Properties["type"].Const] = branch.Required
+		}
+		assert.NotContains(t, required["forking"], "type", "the default driver type is optional")
+		assert.Contains(t, required["simple"], "type")
+	})
+
+	t.Run("typed node sections are one of the type schemas", func(t *testing.T) {
+		s, err := object.Schema("node")
+		require.NoError(t, err)
+		hb, ok := s.PatternProperties["^hb#.+$"]
+		require.True(t, ok)
+		types := make([]any, 0)
+		for _, branch := range hb.OneOf {
+			types = append(types, branch.Properties["type"].Const)
+		}
+		assert.Contains(t, types, "unicast")
+		assert.Contains(t, types, "relay")
+	})
+
+	t.Run("data section", func(t *testing.T) {
+		s, err := object.Schema("cfg")
+		require.NoError(t, err)
+		assert.Contains(t, s.Properties, "data")
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		_, err := object.Schema("foo")
+		assert.Error(t, err)
+	})
+}
@@ -39,8 +39,8 @@ type (
 // NewSec allocates a sec kind object.
 func NewSec(p any, opts ...funcopt.O) (*sec, error) {
 	s := &sec{}
-	s.customEncode = secEncode
-	s.customDecode = secDecode
+	s.customEncode = s.secureEncode
+	s.customDecode = s.secureDecode
 	if err := s.init(s, p, opts...); err != nil {
 		return s, err
 	}
@@ -52,15 +52,7 @@ func (t *sec) KeywordLookup(k key.T, sectionType string) keywords.Keyword {
 	return keywordLookup(keywordStore, k, t.path.Kind, sectionType)
 }
 
-func secEncode(b []byte) (string, error) {
-	m := omcrypto.NewMessage(b)
-	b, err := m.Encrypt()
-	if err != nil {
-		return "", err
-	}
-	return "crypt:" + base64.URLEncoding.Strict().EncodeToString(b), nil
-}
-
+// secDecode decrypts a value encrypted with the cluster secret.
 func secDecode(s string) ([]byte, error) {
 	if !strings.HasPrefix(s, "crypt:") {
 		return []byte{}, fmt.Errorf("unsupported value (no crypt prefix)")
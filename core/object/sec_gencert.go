@@ -110,6 +110,29 @@ func (t *sec) CertInfoBits() int {
 	return int(*sz)
 }
 
+// CertNotAfter returns the expiry date of the certificate stored in the
+// certificate key.
+func (t *sec) CertNotAfter() (time.Time, error) {
+	b, err := t.decode("certificate")
+	if err != nil {
+		return time.Time{}, err
+	}
+	cert, err := certFromPEM(b)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return cert.NotAfter, nil
+}
+
+// CertRenewBefore returns the duration before the certificate expiry when
+// the daemon renews the certificate. A zero duration disables the renewal.
+func (t *sec) CertRenewBefore() time.Duration {
+	if v := t.config.GetDuration(key.Parse("renew")); v != nil {
+		return *v
+	}
+	return 0
+}
+
 func (t *sec) CertInfoNotAfter() (time.Time, error) {
 	if v, err := t.config.GetDurationStrict(key.Parse("validity")); err != nil {
 		return time.Now(), err
@@ -245,7 +268,7 @@ func (t *sec) getCACert() (*x509.Certificate, []byte, error) {
 
 func certFromPEM(b []byte) (*x509.Certificate, error) {
 	block, _ := pem.Decode(b)
-	if block.Type != "CERTIFICATE" {
+	if block == nil || block.Type != "CERTIFICATE" {
 		return nil, fmt.Errorf("certFromPEM: the PEM block type is not CERTIFICATE")
 	}
 	cert, err := x509.ParseCertificate(block.Bytes)
@@ -272,7 +295,7 @@ func (t *sec) getCAPriv() (*rsa.PrivateKey, error) {
 
 func privFromPEM(b []byte) (*rsa.PrivateKey, error) {
 	block, _ := pem.Decode(b)
-	if block.Type != "PRIVATE KEY" {
+	if block == nil || block.Type != "PRIVATE KEY" {
 		return nil, fmt.Errorf("privFromPEM: the PEM block type is not PRIVATE KEY")
 	}
 	priv, err := x509.ParsePKCS8PrivateKey(block.Bytes)
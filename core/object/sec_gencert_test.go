@@ -0,0 +1,44 @@
+package object_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/core/keyop"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/omcrypto"
+	"github.com/opensvc/om3/testhelper"
+)
+
+func TestSecCertExpiry(t *testing.T) {
+	testhelper.Setup(t)
+	omcrypto.SetClusterName("test")
+	omcrypto.SetClusterSecret(strings.Repeat("s", 32))
+
+	p, err := naming.ParsePath("test/sec/cert")
+	require.NoError(t, err)
+	s, err := object.NewSec(p)
+	require.NoError(t, err)
+	require.NoError(t, s.Config().SetKeys(
+		*keyop.Parse("cn=test"),
+		*keyop.Parse("bits=2048"),
+		*keyop.Parse("validity=10d"),
+	))
+
+	_, err = s.CertNotAfter()
+	assert.Error(t, err, "no certificate yet")
+	assert.Equal(t, 30*24*time.Hour, s.CertRenewBefore(), "default renew duration")
+
+	require.NoError(t, s.GenCert())
+	notAfter, err := s.CertNotAfter()
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(10*24*time.Hour), notAfter, time.Minute)
+
+	require.NoError(t, s.Config().SetKeys(*keyop.Parse("renew=0")))
+	assert.Equal(t, time.Duration(0), s.CertRenewBefore(), "renewal disabled")
+}
@@ -0,0 +1,40 @@
+package object
+
+import (
+	"fmt"
+
+	"github.com/opensvc/om3/core/naming"
+)
+
+// RotateCert generates a new private key and a new certificate, signed by
+// the ca if set, or self-signed.
+func (t *sec) RotateCert() error {
+	if _, err := t.genPriv(); err != nil {
+		return err
+	}
+	return t.GenCert()
+}
+
+// ArchiveCert copies the certificate and certificate_chain keys to the sec
+// object p, created if it does not exist. The private key is not copied, so
+// the archive can only be used to keep trusting the certificate after its
+// rotation.
+func (t *sec) ArchiveCert(p naming.Path) error {
+	if p.Kind != naming.KindSec {
+		return fmt.Errorf("%s is not a sec object", p)
+	}
+	archive, err := NewSec(p, WithVolatile(false))
+	if err != nil {
+		return err
+	}
+	for _, name := range []string{"certificate", "certificate_chain"} {
+		b, err := t.decode(name)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		if err := archive.addKey(name, b); err != nil {
+			return err
+		}
+	}
+	return archive.config.Commit()
+}
@@ -0,0 +1,78 @@
+package object_test
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/core/keyop"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/omcrypto"
+	"github.com/opensvc/om3/testhelper"
+)
+
+func TestSecRotateCert(t *testing.T) {
+	testhelper.Setup(t)
+	omcrypto.SetClusterName("test")
+	omcrypto.SetClusterSecret(strings.Repeat("s", 32))
+
+	type certSec interface {
+		object.Sec
+		ArchiveCert(naming.Path) error
+	}
+	newSec := func(s string, kops ...string) certSec {
+		t.Helper()
+		p, err := naming.ParsePath(s)
+		require.NoError(t, err)
+		o, err := object.NewSec(p)
+		require.NoError(t, err)
+		for _, kop := range append([]string{"bits=2048"}, kops...) {
+			require.NoError(t, o.Config().SetKeys(*keyop.Parse(kop)))
+		}
+		require.NoError(t, o.GenCert())
+		return o
+	}
+	verify := func(cert, ca object.Sec) error {
+		t.Helper()
+		roots := x509.NewCertPool()
+		b, err := ca.DecodeKey("certificate_chain")
+		require.NoError(t, err)
+		require.True(t, roots.AppendCertsFromPEM(b))
+		b, err = cert.DecodeKey("certificate")
+		require.NoError(t, err)
+		block, _ := pem.Decode(b)
+		require.NotNil(t, block)
+		c, err := x509.ParseCertificate(block.Bytes)
+		require.NoError(t, err)
+		_, err = c.Verify(x509.VerifyOptions{Roots: roots, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageAny}})
+		return err
+	}
+
+	ca := newSec("test/sec/ca", "cn=ca")
+	cert := newSec("test/sec/cert", "cn=node", "ca=test/sec/ca")
+	require.NoError(t, verify(cert, ca))
+	caPrivateKey, err := ca.DecodeKey("private_key")
+	require.NoError(t, err)
+
+	archivePath := naming.Path{Namespace: "test", Kind: naming.KindSec, Name: "ca-old"}
+	require.NoError(t, ca.ArchiveCert(archivePath))
+	archive, err := object.NewSec(archivePath)
+	require.NoError(t, err)
+	assert.False(t, archive.HasKey("private_key"), "the archive does not hold the private key")
+
+	require.NoError(t, ca.RotateCert())
+	b, err := ca.DecodeKey("private_key")
+	require.NoError(t, err)
+	assert.NotEqual(t, caPrivateKey, b, "the ca private key is renewed")
+
+	assert.NoError(t, verify(cert, archive), "the current cert is trusted by the archived ca")
+	assert.Error(t, verify(cert, ca), "the current cert is not trusted by the new ca")
+
+	require.NoError(t, cert.GenCert())
+	assert.NoError(t, verify(cert, ca), "the renewed cert is trusted by the new ca")
+}
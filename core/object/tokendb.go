@@ -0,0 +1,242 @@
+package object
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/util/hostname"
+)
+
+type (
+	// TokenDB records the api tokens issued by the daemons, and their
+	// revocations.
+	//
+	// Each node records the tokens it issues and the revocations it
+	// receives in its own system/cfg/tokens-<nodename> object, so the nodes
+	// never write the same configuration file, and the records are
+	// replicated to all nodes by the config sync.
+	TokenDB struct {
+		mu      sync.Mutex
+		modTime time.Time
+		count   int
+		revoked map[string]bool
+	}
+
+	// TokenRecord describes an api token issued by a daemon.
+	TokenRecord struct {
+		ID        string     `json:"id"`
+		Type      string     `json:"type"`
+		Owner     string     `json:"owner"`
+		Grants    []string   `json:"grants"`
+		IssuedAt  time.Time  `json:"issued_at"`
+		IssuedBy  string     `json:"issued_by"`
+		ExpiredAt time.Time  `json:"expired_at"`
+		RevokedAt *time.Time `json:"revoked_at,omitempty"`
+
+		// Parent is the id of the refresh token used to issue the token.
+		Parent string `json:"parent,omitempty"`
+	}
+)
+
+const (
+	// TokenTypeAccess is the type of the tokens accepted by the api handlers.
+	TokenTypeAccess = "access"
+
+	// TokenTypeRefresh is the type of the long-lived tokens only accepted
+	// to issue new access tokens.
+	TokenTypeRefresh = "refresh"
+
+	tokenStorePrefix = "tokens-"
+)
+
+var (
+	ErrTokenNotFound = errors.New("token not found")
+
+	// tokenStoreMu serializes the writes to the local node token store.
+	tokenStoreMu sync.Mutex
+)
+
+// tokenStorePath returns the path of the cfg object recording the tokens
+// issued and revoked by the node.
+func tokenStorePath(nodename string) naming.Path {
+	return naming.Path{
+		Namespace: "system",
+		Kind:      naming.KindCfg,
+		Name:      tokenStorePrefix + strings.ReplaceAll(nodename, ".", "-"),
+	}
+}
+
+// tokenStoreFiles returns the configuration files of the token stores of
+// all nodes.
+func tokenStoreFiles() ([]string, error) {
+	return filepath.Glob(tokenStorePath("*").ConfigFile())
+}
+
+// AddToken records a token issued by the local node. The expired records
+// are purged from the local node token store.
+func (t *TokenDB) AddToken(r TokenRecord) error {
+	return t.saveTokens(r)
+}
+
+// Tokens returns the records of the tokens issued by all nodes, with their
+// revocation date if revoked on any node, sorted by issue date.
+func (t *TokenDB) Tokens() ([]TokenRecord, error) {
+	files, err := tokenStoreFiles()
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[string]TokenRecord)
+	for _, f := range files {
+		p := tokenStorePath(strings.TrimPrefix(strings.TrimSuffix(filepath.Base(f), ".conf"), tokenStorePrefix))
+		records, err := loadTokens(p)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range records {
+			current, ok := m[r.ID]
+			switch {
+			case !ok:
+				m[r.ID] = r
+			case current.RevokedAt == nil && r.RevokedAt != nil:
+				current.RevokedAt = r.RevokedAt
+				m[r.ID] = current
+			}
+		}
+	}
+	l := make([]TokenRecord, 0, len(m))
+	for _, r := range m {
+		l = append(l, r)
+	}
+	sort.Slice(l, func(i, j int) bool {
+		return l[i].IssuedAt.Before(l[j].IssuedAt)
+	})
+	return l, nil
+}
+
+// RevokeToken records the revocation of the token id, and of the tokens
+// issued with it if it is a refresh token. The revocation is recorded in
+// the local node token store. It returns the revoked records.
+func (t *TokenDB) RevokeToken(id string) ([]TokenRecord, error) {
+	records, err := t.Tokens()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	revoked := make([]TokenRecord, 0)
+	for _, r := range records {
+		if r.ID != id && r.Parent != id {
+			continue
+		}
+		if r.RevokedAt == nil {
+			r.RevokedAt = &now
+		}
+		revoked = append(revoked, r)
+	}
+	if len(revoked) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrTokenNotFound, id)
+	}
+	if err := t.saveTokens(revoked...); err != nil {
+		return nil, err
+	}
+	return revoked, nil
+}
+
+// IsTokenRevoked returns true if the token id is revoked on any node. The
+// revoked tokens are reloaded when the token stores change.
+func (t *TokenDB) IsTokenRevoked(id string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	files, err := tokenStoreFiles()
+	if err != nil {
+		return t.revoked[id]
+	}
+	var modTime time.Time
+	for _, f := range files {
+		if info, err := os.Stat(f); err == nil && info.ModTime().After(modTime) {
+			modTime = info.ModTime()
+		}
+	}
+	if t.revoked == nil || len(files) != t.count || !modTime.Equal(t.modTime) {
+		if records, err := t.Tokens(); err == nil {
+			t.revoked = make(map[string]bool)
+			for _, r := range records {
+				if r.RevokedAt != nil {
+					t.revoked[r.ID] = true
+				}
+			}
+			t.modTime = modTime
+			t.count = len(files)
+		}
+	}
+	return t.revoked[id]
+}
+
+// saveTokens writes the records in the local node token store, and purges
+// the expired records.
+func (t *TokenDB) saveTokens(records ...TokenRecord) error {
+	tokenStoreMu.Lock()
+	defer tokenStoreMu.Unlock()
+	p := tokenStorePath(hostname.Hostname())
+	store, err := NewCfg(p, WithVolatile(false))
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	existing, err := loadTokens(p)
+	if err != nil {
+		return err
+	}
+	for _, r := range existing {
+		if r.ExpiredAt.Before(now) {
+			if err := store.config.PrepareUnset(keyFromName(r.ID)); err != nil {
+				return err
+			}
+		}
+	}
+	for _, r := range records {
+		b, err := json.Marshal(r)
+		if err != nil {
+			return err
+		}
+		if err := store.addKey(r.ID, b); err != nil {
+			return err
+		}
+	}
+	return store.config.Commit()
+}
+
+// loadTokens returns the records of the token store p.
+func loadTokens(p naming.Path) ([]TokenRecord, error) {
+	if !p.Exists() {
+		return nil, nil
+	}
+	store, err := NewCfg(p, WithVolatile(true))
+	if err != nil {
+		return nil, err
+	}
+	names, err := store.AllKeys()
+	if err != nil {
+		return nil, err
+	}
+	records := make([]TokenRecord, 0, len(names))
+	for _, name := range names {
+		b, err := store.DecodeKey(name)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s: %w", p, name, err)
+		}
+		var r TokenRecord
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, fmt.Errorf("%s: %s: %w", p, name, err)
+		}
+		records = append(records, r)
+	}
+	return records, nil
+}
@@ -0,0 +1,68 @@
+package object_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/omcrypto"
+	"github.com/opensvc/om3/testhelper"
+)
+
+func TestTokenDB(t *testing.T) {
+	testhelper.Setup(t)
+	omcrypto.SetClusterName("test")
+	omcrypto.SetClusterSecret(strings.Repeat("s", 32))
+
+	now := time.Now()
+	db := &object.TokenDB{}
+	add := func(id, tokenType, parent string, expiredAt time.Time) {
+		t.Helper()
+		require.NoError(t, db.AddToken(object.TokenRecord{
+			ID:        id,
+			Type:      tokenType,
+			Owner:     "alice",
+			Grants:    []string{"root"},
+			IssuedAt:  now,
+			IssuedBy:  "node1",
+			ExpiredAt: expiredAt,
+			Parent:    parent,
+		}))
+	}
+	ids := func() []string {
+		t.Helper()
+		records, err := db.Tokens()
+		require.NoError(t, err)
+		l := make([]string, len(records))
+		for i, r := range records {
+			l[i] = r.ID
+		}
+		return l
+	}
+
+	add("expired", object.TokenTypeAccess, "", now.Add(-time.Minute))
+	assert.ElementsMatch(t, []string{"expired"}, ids())
+
+	add("refresh1", object.TokenTypeRefresh, "", now.Add(time.Hour))
+	add("access1", object.TokenTypeAccess, "refresh1", now.Add(time.Minute))
+	add("access2", object.TokenTypeAccess, "", now.Add(time.Minute))
+	assert.ElementsMatch(t, []string{"refresh1", "access1", "access2"}, ids(),
+		"the expired records are purged")
+
+	assert.False(t, db.IsTokenRevoked("access1"))
+
+	revoked, err := db.RevokeToken("refresh1")
+	require.NoError(t, err)
+	assert.Len(t, revoked, 2, "the tokens issued with the refresh token are revoked")
+	assert.True(t, db.IsTokenRevoked("refresh1"))
+	assert.True(t, db.IsTokenRevoked("access1"))
+	assert.False(t, db.IsTokenRevoked("access2"))
+
+	_, err = db.RevokeToken("unknown")
+	assert.True(t, errors.Is(err, object.ErrTokenNotFound))
+}
@@ -51,6 +51,10 @@ type (
 		Pool *string `json:"pool,omitempty"`
 		Size *int64  `json:"size,omitempty"`
 
+		// Sec specific
+		CertNotAfter   *time.Time `json:"cert_not_after,omitempty"`
+		CertExpireDays *int       `json:"cert_expire_days,omitempty"`
+
 		UpdatedAt time.Time `json:"updated_at"`
 	}
 )
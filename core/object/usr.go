@@ -23,6 +23,8 @@ type (
 	//
 	Usr interface {
 		Sec
+		EnrollTOTP(naming.Path) (string, error)
+		ValidateTOTP(code string) error
 	}
 
 	// UsrDB implements UserGrants to authenticate user and get its grants
@@ -32,8 +34,8 @@ type (
 // NewUsr allocates a usr kind object.
 func NewUsr(p any, opts ...funcopt.O) (*usr, error) {
 	s := &usr{}
-	s.customEncode = secEncode
-	s.customDecode = secDecode
+	s.customEncode = s.secureEncode
+	s.customDecode = s.secureDecode
 	if err := s.init(s, p, opts...); err != nil {
 		return s, err
 	}
@@ -61,3 +63,25 @@ func (_ *UsrDB) UserGrants(username, password string) ([]string, error) {
 	}
 	return user.Config().GetStrings(key.T{Section: "DEFAULT", Option: "grant"}), nil
 }
+
+// UserTOTPEnrolled returns true if username is enrolled to the TOTP second
+// factor.
+func (_ *UsrDB) UserTOTPEnrolled(username string) bool {
+	usrPath := naming.Path{Name: username, Namespace: "system", Kind: naming.KindUsr}
+	user, err := NewUsr(usrPath, WithVolatile(true))
+	if err != nil {
+		return false
+	}
+	return user.Config().GetString(keyTOTPSec) != ""
+}
+
+// ValidateUserTOTP returns an error if code is not the current TOTP code of
+// username.
+func (_ *UsrDB) ValidateUserTOTP(username, code string) error {
+	usrPath := naming.Path{Name: username, Namespace: "system", Kind: naming.KindUsr}
+	user, err := NewUsr(usrPath, WithVolatile(true))
+	if err != nil {
+		return err
+	}
+	return user.ValidateTOTP(code)
+}
@@ -0,0 +1,99 @@
+package object
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/opensvc/om3/core/keyop"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/rawconfig"
+	"github.com/opensvc/om3/util/key"
+	"github.com/opensvc/om3/util/totp"
+)
+
+const (
+	// totpSecretKey is the name of the key storing the user TOTP secret in
+	// the sec object referenced by the totp_sec keyword.
+	totpSecretKey = "totp_secret"
+)
+
+var (
+	keyTOTPSec = key.New("DEFAULT", "totp_sec")
+
+	// totpGuard refuses the replay of the accepted codes and locks out the
+	// users presenting too many wrong codes.
+	totpGuard = totp.NewGuard()
+)
+
+// TOTPSec returns the path of the sec object storing the user TOTP secret,
+// or a zero path if the user is not enrolled.
+func (t *usr) TOTPSec() (naming.Path, error) {
+	s := t.config.GetString(keyTOTPSec)
+	if s == "" {
+		return naming.Path{}, nil
+	}
+	p, err := naming.ParsePath(s)
+	if err != nil {
+		return naming.Path{}, fmt.Errorf("%s: %w", keyTOTPSec, err)
+	}
+	if p.Kind != naming.KindSec {
+		return naming.Path{}, fmt.Errorf("%s: %s is not a sec object", keyTOTPSec, p)
+	}
+	return p, nil
+}
+
+// EnrollTOTP generates a new TOTP secret, stores it in the sec object p,
+// and sets the totp_sec keyword. The default sec object is
+// <namespace>/sec/<name>-totp. The returned otpauth uri is to be added to
+// the user authenticator application.
+func (t *usr) EnrollTOTP(p naming.Path) (string, error) {
+	if p.IsZero() {
+		p = naming.Path{Namespace: t.path.Namespace, Kind: naming.KindSec, Name: t.path.Name + "-totp"}
+	} else if p.Kind != naming.KindSec {
+		return "", fmt.Errorf("%s is not a sec object", p)
+	}
+	secret, err := totp.NewSecret()
+	if err != nil {
+		return "", err
+	}
+	s, err := NewSec(p, WithVolatile(false))
+	if err != nil {
+		return "", err
+	}
+	if err := s.addKey(totpSecretKey, []byte(secret)); err != nil {
+		return "", err
+	}
+	if err := s.config.Commit(); err != nil {
+		return "", err
+	}
+	op := keyop.New(keyTOTPSec, keyop.Set, p.String(), 0)
+	if err := t.config.Set(*op); err != nil {
+		return "", err
+	}
+	if err := t.config.Commit(); err != nil {
+		return "", err
+	}
+	return totp.URI(rawconfig.GetClusterSection().Name, t.path.Name, secret), nil
+}
+
+// ValidateTOTP returns an error if the code is not the current TOTP code of
+// the enrolled user, if the code was already accepted, or if the user is
+// locked out after too many wrong codes.
+func (t *usr) ValidateTOTP(code string) error {
+	p, err := t.TOTPSec()
+	if err != nil {
+		return err
+	}
+	if p.IsZero() {
+		return fmt.Errorf("user is not enrolled to totp")
+	}
+	s, err := NewSec(p, WithVolatile(true))
+	if err != nil {
+		return err
+	}
+	secret, err := s.DecodeKey(totpSecretKey)
+	if err != nil {
+		return fmt.Errorf("read totp secret from %s: %w", p, err)
+	}
+	return totpGuard.Validate(t.path.String(), string(secret), code, time.Now())
+}
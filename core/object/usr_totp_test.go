@@ -0,0 +1,52 @@
+package object_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/omcrypto"
+	"github.com/opensvc/om3/testhelper"
+	"github.com/opensvc/om3/util/totp"
+)
+
+func TestUsrTOTP(t *testing.T) {
+	testhelper.Setup(t)
+	omcrypto.SetClusterName("test")
+	omcrypto.SetClusterSecret(strings.Repeat("s", 32))
+	db := &object.UsrDB{}
+
+	p := naming.Path{Namespace: "system", Kind: naming.KindUsr, Name: "alice"}
+	u, err := object.NewUsr(p)
+	require.NoError(t, err)
+	require.NoError(t, u.AddKey("password", []byte("pass")))
+	assert.False(t, db.UserTOTPEnrolled("alice"))
+
+	uri, err := u.EnrollTOTP(naming.Path{})
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(uri, "otpauth://totp/"), uri)
+	assert.True(t, db.UserTOTPEnrolled("alice"))
+
+	secPath := naming.Path{Namespace: "system", Kind: naming.KindSec, Name: "alice-totp"}
+	s, err := object.NewSec(secPath)
+	require.NoError(t, err)
+	secret, err := s.DecodeKey("totp_secret")
+	require.NoError(t, err)
+	assert.Contains(t, uri, "secret="+string(secret))
+
+	code, err := totp.Code(string(secret), time.Now())
+	require.NoError(t, err)
+	assert.NoError(t, db.ValidateUserTOTP("alice", code))
+	assert.ErrorIs(t, db.ValidateUserTOTP("alice", code), totp.ErrReplay)
+	wrong := "000000"
+	if code == wrong {
+		wrong = "111111"
+	}
+	assert.Error(t, db.ValidateUserTOTP("alice", wrong))
+	assert.Error(t, db.ValidateUserTOTP("bob", code), "unknown user")
+}
@@ -0,0 +1,336 @@
+package object
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/rs/zerolog"
+
+	"github.com/opensvc/om3/core/actioncontext"
+	"github.com/opensvc/om3/core/driver"
+	"github.com/opensvc/om3/core/keyop"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/pool"
+	"github.com/opensvc/om3/core/resourceid"
+	"github.com/opensvc/om3/util/command"
+	"github.com/opensvc/om3/util/key"
+)
+
+type (
+	// VolMigrater is implemented by the vol objects, and moves the volume
+	// data to a new volume of another pool.
+	//
+	// MigratePrepare allocates the new volume and copies the data while
+	// the volume is still in use. MigrateCommit, called once the volume
+	// consumers are stopped, copies the last changes, makes the vol object
+	// use the new volume and unprovisions the old one.
+	VolMigrater interface {
+		MigratePrepare(ctx context.Context, poolName string) error
+		MigrateCommit(ctx context.Context) error
+	}
+)
+
+const (
+	// migrateSuffix is appended to the vol name to form the name of the
+	// vol object holding the new volume during the migration, and to the
+	// mount points of this new volume.
+	migrateSuffix = "-migrate"
+)
+
+// MigratePath returns the path of the vol object holding the new volume
+// during the migration of the <p> volume.
+func MigratePath(p naming.Path) naming.Path {
+	return naming.Path{Namespace: p.Namespace, Kind: naming.KindVol, Name: p.Name + migrateSuffix}
+}
+
+func (t *vol) migrateVol() (*vol, error) {
+	return NewVol(MigratePath(t.path), WithLogger(t.log))
+}
+
+// migrateSections returns the disk and fs resource sections, which
+// describe the pool volume and are exchanged by the migration.
+func (t *vol) migrateSections() []string {
+	l := make([]string, 0)
+	for _, s := range t.config.SectionStrings() {
+		rid, err := resourceid.Parse(s)
+		if err != nil {
+			continue
+		}
+		switch rid.DriverGroup() {
+		case driver.GroupDisk, driver.GroupFS:
+			l = append(l, s)
+		}
+	}
+	return l
+}
+
+// migrateKeyOps returns the operations setting the disk and fs resource
+// keywords of the volume on another vol object. The mount points are
+// suffixed or restored by <mnt>.
+func (t *vol) migrateKeyOps(mnt func(string) string) []keyop.T {
+	kops := make([]keyop.T, 0)
+	for _, s := range t.migrateSections() {
+		for _, option := range t.config.Keys(s) {
+			k := key.New(s, option)
+			v := t.config.Get(k)
+			if v == "" {
+				// keys created empty by lookups
+				continue
+			}
+			if option == "mnt" {
+				v = mnt(v)
+			}
+			kops = append(kops, keyop.T{Key: k, Op: keyop.Set, Value: v})
+		}
+	}
+	return kops
+}
+
+// MigratePrepare provisions a new volume of the <poolName> pool with
+// the size and format of the volume, and copies the volume data while
+// the volume is still in use. Between pools able to send their volumes,
+// like zpools, the data is sent with the pool driver, so the commit only
+// sends the changes.
+//
+// The new volume is held by a temporary vol object named like the
+// volume with a "-migrate" suffix, so an interrupted migration can be
+// resumed or cleaned up with the usual vol commands.
+func (t *vol) MigratePrepare(ctx context.Context, poolName string) error {
+	src, err := t.pool()
+	if err != nil {
+		return err
+	}
+	tmp, err := t.migrateVol()
+	if err != nil {
+		return err
+	}
+	if src.Name() == poolName {
+		if tmp.path.Exists() {
+			if err := t.migrateForget(ctx, tmp); err != nil {
+				return err
+			}
+		}
+		return fmt.Errorf("the volume is already in the pool %s", poolName)
+	}
+	spec, err := t.spec()
+	if err != nil {
+		return err
+	}
+	if !spec.shared && len(spec.nodes) > 1 {
+		return fmt.Errorf("can not migrate a non-shared volume with nodes %s, each node has its own data", spec.nodes)
+	}
+	if tmp.path.Exists() {
+		name := tmp.config.GetString(key.New("DEFAULT", "pool"))
+		if name != poolName {
+			return fmt.Errorf("a migration to the pool %s is in progress, see %s", name, tmp.path)
+		}
+		t.log.Infof("resume the migration to the pool %s", poolName)
+	} else {
+		node, err := NewNode()
+		if err != nil {
+			return err
+		}
+		l := pool.NewLookup(node)
+		l.Name = poolName
+		l.Size = spec.size
+		l.Format = spec.format
+		l.Shared = spec.shared
+		l.Access = spec.access
+		l.Usage = true
+		dst, err := l.Do()
+		if err != nil {
+			return err
+		}
+		switch {
+		case dst.Type() == "drbd":
+			return fmt.Errorf("migrate to a drbd pool is not supported")
+		case dst.Type() == src.Type() && dst.Head() == src.Head():
+			return fmt.Errorf("the pools %s and %s share the same storage", src.Name(), dst.Name())
+		}
+		kws, err := pool.MigrationKeywords(dst, t.FQDN(), spec.size, spec.format, spec.access, spec.shared, spec.nodes)
+		if err != nil {
+			return err
+		}
+		kops := keyop.ParseOps(kws)
+		for i, op := range kops {
+			if op.Key.Option == "mnt" {
+				kops[i].Value = op.Value + migrateSuffix
+			}
+		}
+		t.log.Infof("create %s to hold the new volume in the pool %s", tmp.path, poolName)
+		if err := tmp.Set(ctx, kops...); err != nil {
+			return err
+		}
+		// the resources cache is now wrong. Allocate a new object.
+		if tmp, err = t.migrateVol(); err != nil {
+			return err
+		}
+	}
+	if err := tmp.Provision(actioncontext.WithLeader(ctx, true)); err != nil {
+		return err
+	}
+	dst, err := tmp.pool()
+	if err != nil {
+		return err
+	}
+	if o, ok := migrateSender(src, dst); ok {
+		t.log.Infof("send the volume data to the pool %s", dst.Name())
+		return o.SendVolume(t.FQDN(), dst)
+	}
+	if !spec.format {
+		// a block device copy is only consistent when done offline
+		return nil
+	}
+	if err := tmp.Start(ctx); err != nil {
+		return err
+	}
+	if err := t.Start(ctx); err != nil {
+		return err
+	}
+	return t.migrateCopy(tmp, spec.format)
+}
+
+// MigrateCommit copies the last changes to the new volume, makes the vol
+// object use the new volume and unprovisions the old volume. The volume
+// must not be in use.
+func (t *vol) MigrateCommit(ctx context.Context) error {
+	tmp, err := t.migrateVol()
+	if err != nil {
+		return err
+	}
+	if !tmp.path.Exists() {
+		return fmt.Errorf("no migration in progress, %s does not exist", tmp.path)
+	}
+	poolKey := key.New("DEFAULT", "pool")
+	srcPoolName := t.config.GetString(poolKey)
+	dstPoolName := tmp.config.GetString(poolKey)
+	if srcPoolName == dstPoolName {
+		return t.migrateForget(ctx, tmp)
+	}
+	if holders := t.HoldersExcept(ctx, naming.Path{}); len(holders) > 0 {
+		return fmt.Errorf("refuse to commit the migration of a volume in use by %s", holders)
+	}
+	spec, err := t.spec()
+	if err != nil {
+		return err
+	}
+	src, err := t.pool()
+	if err != nil {
+		return err
+	}
+	dst, err := tmp.pool()
+	if err != nil {
+		return err
+	}
+	sender, isSender := migrateSender(src, dst)
+	if isSender {
+		t.log.Infof("send the last changes to the pool %s", dstPoolName)
+		if err := sender.SendVolume(t.FQDN(), dst); err != nil {
+			return err
+		}
+	} else {
+		if err := tmp.Start(ctx); err != nil {
+			return err
+		}
+		if err := t.Start(ctx); err != nil {
+			return err
+		}
+		if err := t.migrateCopy(tmp, spec.format); err != nil {
+			return err
+		}
+	}
+	if err := t.Stop(ctx); err != nil {
+		return err
+	}
+	if err := tmp.Stop(ctx); err != nil {
+		return err
+	}
+	if isSender {
+		if err := sender.ForgetSendVolume(t.FQDN(), dst); err != nil {
+			return err
+		}
+	}
+
+	// Switch the vol object to the new volume first, so an interruption
+	// can not leave tmp as the only reference to the data.
+	restore := func(s string) string {
+		return strings.TrimSuffix(s, migrateSuffix)
+	}
+	suffix := func(s string) string {
+		return s + migrateSuffix
+	}
+	dstKops := append(tmp.migrateKeyOps(restore), keyop.T{Key: poolKey, Op: keyop.Set, Value: dstPoolName})
+	srcKops := append(t.migrateKeyOps(suffix), keyop.T{Key: poolKey, Op: keyop.Set, Value: srcPoolName})
+	t.log.Infof("switch to the new volume in the pool %s", dstPoolName)
+	if err := t.Update(ctx, t.migrateSections(), nil, dstKops); err != nil {
+		return err
+	}
+	if err := tmp.Update(ctx, tmp.migrateSections(), nil, srcKops); err != nil {
+		return err
+	}
+
+	// tmp now describes the old volume. Allocate a new object to refresh
+	// the resources cache before unprovisioning.
+	if tmp, err = t.migrateVol(); err != nil {
+		return err
+	}
+	t.log.Infof("unprovision the old volume in the pool %s", srcPoolName)
+	if err := tmp.Unprovision(actioncontext.WithLeader(ctx, true)); err != nil {
+		return err
+	}
+	return tmp.Delete(ctx)
+}
+
+// migrateForget deletes the <tmp> vol object left by a commit interrupted
+// after the vol object switched to the new volume. <tmp> references the
+// new volume too, so it must not be unprovisioned.
+func (t *vol) migrateForget(ctx context.Context, tmp *vol) error {
+	t.log.Warnf("the volume already uses the new volume, delete %s without unprovision: the old volume may need a manual cleanup", tmp.path)
+	return tmp.Delete(ctx)
+}
+
+// migrateSender returns the <src> pool as a pool.Sender if it can send
+// its volumes to the <dst> pool, which must use the same driver.
+func migrateSender(src, dst pool.Pooler) (pool.Sender, bool) {
+	if src.Type() != dst.Type() {
+		return nil, false
+	}
+	o, ok := src.(pool.Sender)
+	return o, ok
+}
+
+// migrateCopy copies the volume data to the new volume held by <tmp>.
+// Formatted volumes are copied with rsync, so successive copies only
+// transfer the changes. Block volumes are copied with dd.
+func (t *vol) migrateCopy(tmp *vol, format bool) error {
+	var cmd *command.T
+	if format {
+		src, dst := t.Head(), tmp.Head()
+		if src == "" || dst == "" {
+			return fmt.Errorf("can not determine the source and destination mount points")
+		}
+		cmd = command.New(
+			command.WithName("rsync"),
+			command.WithVarArgs("-aHAXx", "--numeric-ids", "--delete", src+"/", dst+"/"),
+			command.WithLogger(t.log),
+			command.WithCommandLogLevel(zerolog.InfoLevel),
+			command.WithStdoutLogLevel(zerolog.DebugLevel),
+			command.WithStderrLogLevel(zerolog.ErrorLevel),
+		)
+	} else {
+		src, dst := t.Device(), tmp.Device()
+		if src == nil || dst == nil {
+			return fmt.Errorf("can not determine the source and destination devices")
+		}
+		cmd = command.New(
+			command.WithName("dd"),
+			command.WithVarArgs("if="+src.Path(), "of="+dst.Path(), "bs=1M", "conv=fsync"),
+			command.WithLogger(t.log),
+			command.WithCommandLogLevel(zerolog.InfoLevel),
+			command.WithStdoutLogLevel(zerolog.DebugLevel),
+			command.WithStderrLogLevel(zerolog.DebugLevel),
+		)
+	}
+	return cmd.Run()
+}
@@ -0,0 +1,124 @@
+package object
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/core/clusternode"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/pool"
+	"github.com/opensvc/om3/core/rawconfig"
+	"github.com/opensvc/om3/testhelper"
+	"github.com/opensvc/om3/util/hostname"
+)
+
+// setupMigrate sets up a two nodes cluster, with the "dir1" and "dir2"
+// directory pools, and returns the path of a vol object using the "dir1"
+// pool.
+func setupMigrate(t *testing.T) naming.Path {
+	t.Helper()
+	env := testhelper.Setup(t)
+	clusternode.Set([]string{hostname.Hostname(), "node2"})
+	t.Cleanup(func() { clusternode.Set(nil) })
+	require.NoError(t, os.WriteFile(filepath.Join(rawconfig.Paths.Etc, "node.conf"), []byte(`
+[pool#dir1]
+type = directory
+path = `+filepath.Join(env.Root, "dir1")+`
+
+[pool#dir2]
+type = directory
+path = `+filepath.Join(env.Root, "dir2")+`
+`), 0644))
+	p := naming.Path{Namespace: "test", Kind: naming.KindVol, Name: "v1"}
+	writeVolConfig(t, p, "dir1", hostname.Hostname())
+	return p
+}
+
+func writeVolConfig(t *testing.T, p naming.Path, poolName, nodes string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(p.ConfigFile()), os.ModePerm))
+	require.NoError(t, os.WriteFile(p.ConfigFile(), []byte(`
+[DEFAULT]
+nodes = `+nodes+`
+pool = `+poolName+`
+size = 10m
+access = rwo
+
+[fs#0]
+type = flag
+
+[fs#1]
+type = directory
+path = `+filepath.Join(rawconfig.Paths.Root, poolName, p.Name)+`
+`), 0644))
+}
+
+func TestVolMigrateCommitWithoutPrepare(t *testing.T) {
+	p := setupMigrate(t)
+	o, err := NewVol(p)
+	require.NoError(t, err)
+	err = o.MigrateCommit(context.Background())
+	require.ErrorContains(t, err, "no migration in progress")
+}
+
+func TestVolMigrateCommitForget(t *testing.T) {
+	// A commit interrupted after the vol object switched to the new volume
+	// leaves a tmp vol object using the same pool. The replayed commit
+	// must delete it without unprovisioning the volume.
+	p := setupMigrate(t)
+	tmpPath := MigratePath(p)
+	writeVolConfig(t, tmpPath, "dir1", hostname.Hostname())
+	o, err := NewVol(p)
+	require.NoError(t, err)
+	require.NoError(t, o.MigrateCommit(context.Background()))
+	require.False(t, tmpPath.Exists())
+	require.True(t, p.Exists())
+}
+
+func TestVolMigratePrepareAlreadyInPool(t *testing.T) {
+	p := setupMigrate(t)
+	tmpPath := MigratePath(p)
+	writeVolConfig(t, tmpPath, "dir1", hostname.Hostname())
+	o, err := NewVol(p)
+	require.NoError(t, err)
+	err = o.MigratePrepare(context.Background(), "dir1")
+	require.ErrorContains(t, err, "already in the pool dir1")
+	require.False(t, tmpPath.Exists(), "the tmp vol left by an interrupted commit must be deleted")
+}
+
+func TestVolMigratePrepareOtherMigrationInProgress(t *testing.T) {
+	p := setupMigrate(t)
+	tmpPath := MigratePath(p)
+	writeVolConfig(t, tmpPath, "dir2", hostname.Hostname())
+	o, err := NewVol(p)
+	require.NoError(t, err)
+	err = o.MigratePrepare(context.Background(), "dir3")
+	require.ErrorContains(t, err, "a migration to the pool dir2 is in progress")
+	require.True(t, tmpPath.Exists())
+}
+
+func TestVolMigratePrepareNonSharedMultiNodes(t *testing.T) {
+	p := setupMigrate(t)
+	writeVolConfig(t, p, "dir1", hostname.Hostname()+" node2")
+	o, err := NewVol(p)
+	require.NoError(t, err)
+	err = o.MigratePrepare(context.Background(), "dir2")
+	require.ErrorContains(t, err, "can not migrate a non-shared volume")
+	require.False(t, MigratePath(p).Exists())
+}
+
+func TestVolMigrateSender(t *testing.T) {
+	setupMigrate(t)
+	node, err := NewNode()
+	require.NoError(t, err)
+	dir1 := pool.New("dir1", node.MergedConfig())
+	dir2 := pool.New("dir2", node.MergedConfig())
+	require.NotNil(t, dir1)
+	require.NotNil(t, dir2)
+	_, ok := migrateSender(dir1, dir2)
+	require.False(t, ok, "directory pools can not send their volumes")
+}
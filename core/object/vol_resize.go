@@ -0,0 +1,133 @@
+package object
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/opensvc/om3/core/driver"
+	"github.com/opensvc/om3/core/keyop"
+	"github.com/opensvc/om3/core/pool"
+	"github.com/opensvc/om3/core/resource"
+	"github.com/opensvc/om3/util/key"
+	"github.com/opensvc/om3/util/sizeconv"
+)
+
+type (
+	// VolResizer is implemented by the vol objects, and grows the volume
+	// through the pool, disk and filesystem layers.
+	VolResizer interface {
+		Resize(ctx context.Context, size string) (int64, error)
+	}
+)
+
+// resizeTarget returns the new volume size in bytes, from an absolute
+// "<size>" or a relative "+<size>" expression. A relative expression is
+// applied to the configured size.
+func (t *vol) resizeTarget(s string) (int64, error) {
+	current := t.config.GetSize(key.New("DEFAULT", "size"))
+	if current == nil {
+		return 0, fmt.Errorf("no size keyword in configuration")
+	}
+	var target int64
+	if strings.HasPrefix(s, "+") {
+		delta, err := sizeconv.FromSize(s[1:])
+		if err != nil {
+			return 0, err
+		}
+		target = *current + delta
+	} else {
+		i, err := sizeconv.FromSize(s)
+		if err != nil {
+			return 0, err
+		}
+		target = i
+	}
+	if target < *current {
+		return 0, fmt.Errorf("refuse to shrink the volume from %s to %s",
+			sizeconv.BSizeCompact(float64(*current)), sizeconv.BSizeCompact(float64(target)))
+	}
+	return target, nil
+}
+
+// resizers returns the disk resources then the fs resources of the
+// volume, in the order they must follow the growth of the pool volume.
+func (t *vol) resizers() ([]resource.Resizer, error) {
+	l := make([]resource.Resizer, 0)
+	for _, group := range []driver.Group{driver.GroupDisk, driver.GroupFS} {
+		for _, r := range t.ResourcesByDrivergroups([]driver.Group{group}) {
+			if r.Manifest().DriverID.Name == "scsireserv" {
+				continue
+			}
+			var i interface{} = r
+			o, ok := i.(resource.Resizer)
+			if !ok {
+				return nil, fmt.Errorf("%s: resize is not supported by the %s driver", r.RID(), r.Manifest().DriverID)
+			}
+			if err := o.CanResize(); err != nil {
+				return nil, err
+			}
+			l = append(l, o)
+		}
+	}
+	return l, nil
+}
+
+// Resize grows the volume to <size>, an absolute "<size>" or a relative
+// "+<size>" expression, and returns the new size in bytes. The pool
+// volume is grown first, then the disk and fs resources follow, and the
+// size keywords are updated last. All the steps are no-op when already
+// done, so an interrupted resize can be replayed with the same absolute
+// size.
+func (t *vol) Resize(ctx context.Context, size string) (int64, error) {
+	target, err := t.resizeTarget(size)
+	if err != nil {
+		return 0, err
+	}
+	p, err := t.pool()
+	if err != nil {
+		return 0, err
+	}
+	o, ok := p.(pool.Resizer)
+	if !ok {
+		return 0, fmt.Errorf("pool %s (type %s) does not support volume resize", p.Name(), p.Type())
+	}
+	resizers, err := t.resizers()
+	if err != nil {
+		return 0, err
+	}
+	t.log.Infof("resize to %s", sizeconv.BSizeCompact(float64(target)))
+	if err := o.ResizeVolume(t.FQDN(), target); err != nil {
+		return 0, fmt.Errorf("pool %s: %w", p.Name(), err)
+	}
+	for _, r := range resizers {
+		if err := r.Resize(ctx, target); err != nil {
+			return 0, err
+		}
+	}
+	if err := t.Set(ctx, t.resizeKeyOps(target)...); err != nil {
+		return 0, err
+	}
+	return target, nil
+}
+
+// resizeKeyOps returns the operations setting the size keywords to the
+// new size. The resource size keywords not expressed as a size, like
+// 100%FREE, are left untouched.
+func (t *vol) resizeKeyOps(size int64) []keyop.T {
+	value := sizeconv.ExactBSizeCompact(float64(size))
+	kops := []keyop.T{
+		{Key: key.New("DEFAULT", "size"), Op: keyop.Set, Value: value},
+	}
+	for _, r := range t.ResourcesByDrivergroups([]driver.Group{driver.GroupDisk, driver.GroupFS}) {
+		k := key.New(r.RID(), "size")
+		if !t.config.HasKey(k) {
+			continue
+		}
+		if _, err := sizeconv.FromSize(t.config.Get(k)); err != nil {
+			continue
+		}
+		kops = append(kops, keyop.T{Key: k, Op: keyop.Set, Value: value})
+	}
+	return kops
+}
@@ -0,0 +1,57 @@
+package object
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/testhelper"
+)
+
+func TestVolResizeTarget(t *testing.T) {
+	testhelper.Setup(t)
+	p := naming.Path{Namespace: "test", Kind: naming.KindVol, Name: "v1"}
+	require.NoError(t, os.MkdirAll(filepath.Dir(p.ConfigFile()), os.ModePerm))
+	require.NoError(t, os.WriteFile(p.ConfigFile(), []byte(`
+[DEFAULT]
+size = 1g
+`), 0644))
+	o, err := NewVol(p)
+	require.NoError(t, err)
+
+	cases := map[string]struct {
+		size     string
+		expected int64
+		hasError bool
+	}{
+		"relative":           {size: "+512m", expected: 1536 * 1024 * 1024},
+		"relative zero":      {size: "+0", expected: 1024 * 1024 * 1024},
+		"absolute":           {size: "2g", expected: 2 * 1024 * 1024 * 1024},
+		"absolute unchanged": {size: "1g", expected: 1024 * 1024 * 1024},
+		"shrink":             {size: "512m", hasError: true},
+		"invalid":            {size: "+abc", hasError: true},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			target, err := o.resizeTarget(tc.size)
+			if tc.hasError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, target)
+		})
+	}
+}
+
+func TestVolResizeTargetNoSize(t *testing.T) {
+	testhelper.Setup(t)
+	p := naming.Path{Namespace: "test", Kind: naming.KindVol, Name: "v2"}
+	o, err := NewVol(p, WithVolatile(true))
+	require.NoError(t, err)
+	_, err = o.resizeTarget("+1g")
+	require.Error(t, err)
+}
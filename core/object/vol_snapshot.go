@@ -0,0 +1,175 @@
+package object
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opensvc/om3/core/driver"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/pool"
+	"github.com/opensvc/om3/core/status"
+	"github.com/opensvc/om3/core/volaccess"
+	"github.com/opensvc/om3/util/key"
+)
+
+type (
+	// VolSnapshotter is implemented by the vol objects, and relays the
+	// snapshot and clone requests to the pool hosting the volume.
+	VolSnapshotter interface {
+		CreateSnapshot(name string) error
+		ListSnapshots() (pool.Snapshots, error)
+		DeleteSnapshot(name string) error
+		RollbackSnapshot(ctx context.Context, name string) error
+		Clone(ctx context.Context, dst naming.Path) error
+	}
+
+	// volSpec holds the volume properties a new pool volume must have to
+	// replace or copy the volume.
+	volSpec struct {
+		size   int64
+		access volaccess.T
+		shared bool
+		nodes  []string
+		format bool
+	}
+)
+
+// pool returns the pool hosting the volume, as set in the vol
+// configuration by the pool at volume creation.
+func (t *vol) pool() (pool.Pooler, error) {
+	name := t.config.GetString(key.New("DEFAULT", "pool"))
+	if name == "" {
+		return nil, fmt.Errorf("%s: no pool keyword in configuration", t.path)
+	}
+	node, err := NewNode()
+	if err != nil {
+		return nil, err
+	}
+	p := pool.New(name, node.MergedConfig())
+	if p == nil {
+		return nil, fmt.Errorf("%s: pool %s not found", t.path, name)
+	}
+	return p, nil
+}
+
+func (t *vol) snapshotter() (pool.Snapshotter, error) {
+	p, err := t.pool()
+	if err != nil {
+		return nil, err
+	}
+	o, ok := p.(pool.Snapshotter)
+	if !ok {
+		return nil, fmt.Errorf("pool %s (type %s) does not support volume snapshots", p.Name(), p.Type())
+	}
+	return o, nil
+}
+
+// spec returns the volume properties, as set in the vol configuration
+// by the pool at volume creation.
+func (t *vol) spec() (volSpec, error) {
+	var spec volSpec
+	size := t.config.GetSize(key.New("DEFAULT", "size"))
+	if size == nil {
+		return spec, fmt.Errorf("%s: no size keyword in configuration", t.path)
+	}
+	spec.size = *size
+	acs, err := volaccess.Parse(t.config.GetString(key.New("DEFAULT", "access")))
+	if err != nil {
+		return spec, err
+	}
+	spec.access = acs
+	if k := key.New("DEFAULT", "shared"); t.config.HasKey(k) {
+		spec.shared = t.config.GetBool(k)
+	}
+	if spec.nodes, err = t.Nodes(); err != nil {
+		return spec, err
+	}
+	spec.format = len(t.ResourcesByDrivergroups([]driver.Group{driver.GroupFS})) > 0
+	return spec, nil
+}
+
+// CreateSnapshot takes a point-in-time snapshot of the volume data.
+func (t *vol) CreateSnapshot(name string) error {
+	if err := pool.ValidateSnapshotName(name); err != nil {
+		return err
+	}
+	o, err := t.snapshotter()
+	if err != nil {
+		return err
+	}
+	t.log.Infof("create snapshot %s", name)
+	return o.CreateSnapshot(t.FQDN(), name)
+}
+
+// ListSnapshots returns the volume snapshots.
+func (t *vol) ListSnapshots() (pool.Snapshots, error) {
+	o, err := t.snapshotter()
+	if err != nil {
+		return nil, err
+	}
+	return o.ListSnapshots(t.FQDN())
+}
+
+// DeleteSnapshot destroys the volume snapshot named <name>.
+func (t *vol) DeleteSnapshot(name string) error {
+	if err := pool.ValidateSnapshotName(name); err != nil {
+		return err
+	}
+	o, err := t.snapshotter()
+	if err != nil {
+		return err
+	}
+	t.log.Infof("delete snapshot %s", name)
+	return o.DeleteSnapshot(t.FQDN(), name)
+}
+
+// RollbackSnapshot restores the volume data from the snapshot named
+// <name>. The volume must be stopped and not held by any object.
+func (t *vol) RollbackSnapshot(ctx context.Context, name string) error {
+	if err := pool.ValidateSnapshotName(name); err != nil {
+		return err
+	}
+	o, err := t.snapshotter()
+	if err != nil {
+		return err
+	}
+	if holders := t.HoldersExcept(ctx, naming.Path{}); len(holders) > 0 {
+		return fmt.Errorf("%s: refuse to rollback a volume in use by %s", t.path, holders)
+	}
+	if data, err := t.FreshStatus(ctx); err != nil {
+		return err
+	} else {
+		switch data.Avail {
+		case status.Up, status.Warn:
+			return fmt.Errorf("%s: refuse to rollback a volume in %s state, stop it first", t.path, data.Avail)
+		}
+	}
+	t.log.Infof("rollback to snapshot %s", name)
+	return o.RollbackSnapshot(t.FQDN(), name)
+}
+
+// Clone creates the <dst> vol object, backed by a new volume of the same
+// pool initialized with this volume data. The clone inherits the size,
+// access mode and nodes of this volume.
+func (t *vol) Clone(ctx context.Context, dst naming.Path) error {
+	if dst.Kind != naming.KindVol {
+		return fmt.Errorf("%s: clone target must be a vol object", dst)
+	}
+	if dst.Exists() {
+		return fmt.Errorf("%s: clone target already exists", dst)
+	}
+	p, err := t.pool()
+	if err != nil {
+		return err
+	}
+	spec, err := t.spec()
+	if err != nil {
+		return err
+	}
+	v, err := NewVol(dst, WithLogger(t.log))
+	if err != nil {
+		return err
+	}
+	t.log.Infof("clone to %s", dst)
+	return pool.CloneVolume(p, t.FQDN(), v, spec.size, spec.format, spec.access, spec.shared, spec.nodes)
+}
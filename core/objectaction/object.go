@@ -26,6 +26,7 @@ import (
 	"github.com/opensvc/om3/core/object"
 	"github.com/opensvc/om3/core/objectselector"
 	"github.com/opensvc/om3/core/output"
+	"github.com/opensvc/om3/core/plan"
 	"github.com/opensvc/om3/core/rawconfig"
 	"github.com/opensvc/om3/core/topology"
 	"github.com/opensvc/om3/daemon/api"
@@ -146,6 +147,16 @@ func WithAsyncTargetOptions(o any) funcopt.O {
 	})
 }
 
+// WithAsyncDryRun asks the daemon for the plan of the orchestration
+// defined by WithAsyncTarget, instead of queuing the orchestration.
+func WithAsyncDryRun(v bool) funcopt.O {
+	return funcopt.F(func(i any) error {
+		t := i.(*T)
+		t.DryRun = v
+		return nil
+	})
+}
+
 // WithAsyncTime is the maximum duration to wait for an async action
 // It needs WithAsyncWait(true)
 func WithAsyncTime(d time.Duration) funcopt.O {
@@ -406,6 +417,7 @@ func (t T) DoAsync() error {
 		result struct {
 			Path            string    `json:"path"`
 			OrchestrationID uuid.UUID `json:"orchestration_id,omitempty"`
+			Plan            *plan.T   `json:"plan,omitempty"`
 			Error           error     `json:"error,omitempty"`
 		}
 		results []result
@@ -425,16 +437,20 @@ func (t T) DoAsync() error {
 		defer cancel()
 	}
 	rs := make(results, 0)
-	if t.Wait {
+	if t.Wait && !t.DryRun {
 		waitC = make(chan error, len(paths))
 	}
+	var dryRun *bool
+	if t.DryRun {
+		dryRun = &t.DryRun
+	}
 
 	for _, p := range paths {
 		var (
 			err error
 			b   []byte
 		)
-		if t.Wait {
+		if t.Wait && !t.DryRun {
 			t.waitExpectation(ctx, c, t.Target, p, waitC)
 		}
 		switch target {
@@ -544,7 +560,7 @@ func (t T) DoAsync() error {
 				}
 			}
 		case instance.MonitorGlobalExpectStarted:
-			if resp, e := c.PostObjectActionStartWithResponse(ctx, p.Namespace, p.Kind, p.Name); e != nil {
+			if resp, e := c.PostObjectActionStartWithResponse(ctx, p.Namespace, p.Kind, p.Name, &api.PostObjectActionStartParams{DryRun: dryRun}); e != nil {
 				err = e
 			} else {
 				switch resp.StatusCode() {
@@ -565,7 +581,7 @@ func (t T) DoAsync() error {
 				}
 			}
 		case instance.MonitorGlobalExpectStopped:
-			if resp, e := c.PostObjectActionStopWithResponse(ctx, p.Namespace, p.Kind, p.Name); e != nil {
+			if resp, e := c.PostObjectActionStopWithResponse(ctx, p.Namespace, p.Kind, p.Name, &api.PostObjectActionStopParams{DryRun: dryRun}); e != nil {
 				err = e
 			} else {
 				switch resp.StatusCode() {
@@ -628,7 +644,7 @@ func (t T) DoAsync() error {
 				}
 			}
 		case instance.MonitorGlobalExpectPlaced:
-			if resp, e := c.PostObjectActionGivebackWithResponse(ctx, p.Namespace, p.Kind, p.Name); e != nil {
+			if resp, e := c.PostObjectActionGivebackWithResponse(ctx, p.Namespace, p.Kind, p.Name, &api.PostObjectActionGivebackParams{DryRun: dryRun}); e != nil {
 				err = e
 			} else {
 				switch resp.StatusCode() {
@@ -655,7 +671,7 @@ func (t T) DoAsync() error {
 			} else {
 				params.Destination = options.Destination
 			}
-			if resp, e := c.PostObjectActionSwitchWithResponse(ctx, p.Namespace, p.Kind, p.Name, params); e != nil {
+			if resp, e := c.PostObjectActionSwitchWithResponse(ctx, p.Namespace, p.Kind, p.Name, &api.PostObjectActionSwitchParams{DryRun: dryRun}, params); e != nil {
 				err = e
 			} else {
 				switch resp.StatusCode() {
@@ -682,6 +698,21 @@ func (t T) DoAsync() error {
 				Error: err,
 				Path:  p.String(),
 			}
+		} else if t.DryRun {
+			var orchestrationPlan struct {
+				Plan *plan.T `json:"plan"`
+			}
+			if err := json.Unmarshal(b, &orchestrationPlan); err == nil {
+				r = result{
+					Plan: orchestrationPlan.Plan,
+					Path: p.String(),
+				}
+			} else {
+				r = result{
+					Error: err,
+					Path:  p.String(),
+				}
+			}
 		} else {
 			toWait++
 			var orchestrationQueued api.OrchestrationQueued
@@ -704,6 +735,8 @@ func (t T) DoAsync() error {
 		for _, r := range rs {
 			if r.Error != nil {
 				s += fmt.Sprintf("%s %s %s\n", r.OrchestrationID, r.Path, rawconfig.Colorize.Error(r.Error))
+			} else if r.Plan != nil {
+				s += r.Plan.Render()
 			} else {
 				s += fmt.Sprintf("%s %s\n", r.OrchestrationID, r.Path)
 			}
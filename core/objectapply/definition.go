@@ -0,0 +1,154 @@
+package objectapply
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/xconfig"
+)
+
+type (
+	// Definition is an object configuration loaded from a definition file.
+	Definition struct {
+		Path naming.Path
+		File string
+
+		config *xconfig.T
+	}
+
+	Definitions []Definition
+)
+
+// Load returns the object definitions found in the files. A directory
+// is walked recursively for the files with a .conf, .ini, .json, .yaml or
+// .yml extension.
+//
+// An ini file defines one object. Its path is read from the metadata
+// section name, kind and namespace keys, or defaults to the file name
+// without extension.
+//
+// A json or yaml file defines either one object with a metadata section,
+// or many objects as a map of object configurations indexed by path.
+func Load(filenames ...string) (Definitions, error) {
+	defs := make(Definitions, 0)
+	for _, filename := range filenames {
+		info, err := os.Stat(filename)
+		if err != nil {
+			return defs, err
+		}
+		if !info.IsDir() {
+			if l, err := LoadFile(filename); err != nil {
+				return defs, err
+			} else {
+				defs = append(defs, l...)
+			}
+			continue
+		}
+		err = filepath.WalkDir(filename, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				return nil
+			}
+			switch filepath.Ext(path) {
+			case ".conf", ".ini", ".json", ".yaml", ".yml":
+			default:
+				return nil
+			}
+			if l, err := LoadFile(path); err != nil {
+				return err
+			} else {
+				defs = append(defs, l...)
+			}
+			return nil
+		})
+		if err != nil {
+			return defs, err
+		}
+	}
+	seen := make(map[naming.Path]string)
+	for _, def := range defs {
+		if other, ok := seen[def.Path]; ok {
+			return defs, fmt.Errorf("%s: defined in both %s and %s", def.Path, other, def.File)
+		}
+		seen[def.Path] = def.File
+	}
+	sort.Slice(defs, func(i, j int) bool {
+		return defs[i].Path.String() < defs[j].Path.String()
+	})
+	return defs, nil
+}
+
+// LoadFile returns the object definitions of a file. The files with a
+// .json, .yaml or .yml extension are structured documents, the other
+// files are ini configurations unless their content is detected as a
+// structured document.
+func LoadFile(filename string) (Definitions, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	switch filepath.Ext(filename) {
+	case ".json", ".yaml", ".yml":
+	default:
+		if !xconfig.IsDocument(b) {
+			return loadOne(filename, b)
+		}
+	}
+	keys, docs, err := xconfig.SubDocuments(b)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filename, err)
+	}
+	for _, k := range keys {
+		if k == "metadata" {
+			return loadOne(filename, b)
+		}
+	}
+	defs := make(Definitions, 0)
+	for i, s := range keys {
+		p, err := naming.ParsePath(s)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", filename, err)
+		}
+		c, err := xconfig.NewObject("", docs[i])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s: %w", filename, p, err)
+		}
+		defs = append(defs, Definition{Path: p, File: filename, config: c})
+	}
+	return defs, nil
+}
+
+func loadOne(filename string, b []byte) (Definitions, error) {
+	c, err := xconfig.NewObject("", b)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filename, err)
+	}
+	p, err := pathFromConfig(c, filename)
+	if err != nil {
+		return nil, err
+	}
+	return Definitions{{Path: p, File: filename, config: c}}, nil
+}
+
+// pathFromConfig returns the object path defined by the metadata section
+// of the configuration, or by the file name if the metadata section has
+// no name key.
+func pathFromConfig(c *xconfig.T, filename string) (naming.Path, error) {
+	md := c.SectionMap("metadata")
+	name := md["name"]
+	if name == "" {
+		name = strings.TrimSuffix(filepath.Base(filename), filepath.Ext(filename))
+	}
+	p, err := naming.NewPathFromStrings(md["namespace"], md["kind"], name)
+	if err != nil {
+		return p, fmt.Errorf("%s: %w", filename, err)
+	}
+	return p, nil
+}
@@ -0,0 +1,334 @@
+// Package objectapply computes and executes the configuration changes
+// needed to have the installed objects match a set of object definitions.
+//
+// The plan compares each definition to the installed configuration of the
+// object. The objects absent from the definitions are deleted only if
+// pruning is requested and they are labeled as managed by the same owner.
+package objectapply
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/opensvc/om3/core/confighistory"
+	"github.com/opensvc/om3/core/keyop"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/rawconfig"
+	"github.com/opensvc/om3/core/xconfig"
+	"github.com/opensvc/om3/util/key"
+	"github.com/opensvc/om3/util/render/tree"
+)
+
+type (
+	// Action is the change a plan step applies to an object.
+	Action string
+
+	// Step is the change of an object configuration.
+	Step struct {
+		Path   naming.Path            `json:"path"`
+		Action Action                 `json:"action"`
+		File   string                 `json:"file,omitempty"`
+		Diff   string                 `json:"diff,omitempty"`
+		Alerts xconfig.ValidateAlerts `json:"alerts,omitempty"`
+
+		// data is the configuration file content to install.
+		data []byte
+	}
+
+	// T is the plan of an apply.
+	T struct {
+		ManagedBy string `json:"managed_by"`
+		Steps     []Step `json:"steps"`
+	}
+
+	// Source gives access to the installed object configurations.
+	Source interface {
+		// Paths returns the paths of the installed objects.
+		Paths() (naming.Paths, error)
+
+		// ConfigData returns the configuration file content of an
+		// installed object, or nil if the object is not installed.
+		ConfigData(p naming.Path) ([]byte, error)
+	}
+
+	// Store is a Source able to commit the plan steps.
+	Store interface {
+		Source
+		Create(p naming.Path, b []byte) error
+		Update(p naming.Path, b []byte) error
+		Delete(p naming.Path) error
+	}
+)
+
+const (
+	ActionCreate Action = "create"
+	ActionDelete Action = "delete"
+	ActionNone   Action = "none"
+	ActionUpdate Action = "update"
+
+	// DefaultManagedBy is the default value of the managed by label set
+	// on the applied objects.
+	DefaultManagedBy = "apply"
+)
+
+var (
+	// ManagedByKey is the label identifying the owner of the applied
+	// objects. Only the objects with the same owner are pruned.
+	ManagedByKey = key.New("labels", "managed_by")
+
+	idKey = key.New("DEFAULT", "id")
+)
+
+func (t Action) String() string {
+	return string(t)
+}
+
+// phase returns the rank of the action family in a plan. The deletes come
+// last, so the objects referencing a deleted object are updated before.
+func (t Action) phase() int {
+	switch t {
+	case ActionDelete:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// kindRank returns the rank of the object kind in a plan, so the
+// configuration objects referenced by the services are created first.
+func kindRank(kind naming.Kind) int {
+	switch kind {
+	case naming.KindNscfg:
+		return 0
+	case naming.KindCfg, naming.KindSec, naming.KindUsr:
+		return 1
+	case naming.KindVol:
+		return 2
+	default:
+		return 3
+	}
+}
+
+// Plan returns the steps needed to have the objects installed in src
+// match the definitions. The applied objects are labeled as managed by
+// managedBy. If prune is set, the objects labeled as managed by managedBy
+// and absent from the definitions are deleted.
+func (defs Definitions) Plan(src Source, managedBy string, prune bool) (T, error) {
+	t := T{
+		ManagedBy: managedBy,
+		Steps:     make([]Step, 0),
+	}
+	if managedBy == "" {
+		return t, fmt.Errorf("the managed by label value is empty")
+	}
+	defined := make(map[naming.Path]any)
+	for _, def := range defs {
+		defined[def.Path] = nil
+		step, err := def.step(src, managedBy)
+		if err != nil {
+			return t, err
+		}
+		t.Steps = append(t.Steps, step)
+	}
+	if prune {
+		paths, err := src.Paths()
+		if err != nil {
+			return t, err
+		}
+		for _, p := range paths {
+			if _, ok := defined[p]; ok || p.Kind == naming.KindCcfg {
+				continue
+			}
+			b, err := src.ConfigData(p)
+			if err != nil {
+				return t, fmt.Errorf("%s: %w", p, err)
+			} else if b == nil {
+				continue
+			}
+			c, err := xconfig.NewObject("", b)
+			if err != nil {
+				return t, fmt.Errorf("%s: %w", p, err)
+			}
+			if c.Get(ManagedByKey) != managedBy {
+				continue
+			}
+			t.Steps = append(t.Steps, Step{
+				Path:   p,
+				Action: ActionDelete,
+				Diff:   confighistory.Diff(p.String(), b, "/dev/null", nil),
+			})
+		}
+	}
+	t.order()
+	return t, nil
+}
+
+// step returns the change needed to have the object installed in src
+// match the definition.
+func (def Definition) step(src Source, managedBy string) (Step, error) {
+	step := Step{
+		Path: def.Path,
+		File: def.File,
+	}
+	installed, err := src.ConfigData(def.Path)
+	if err != nil {
+		return step, fmt.Errorf("%s: %w", def.Path, err)
+	}
+	c := def.config
+	if err := c.PrepareDeleteSections("metadata"); err != nil {
+		return step, fmt.Errorf("%s: %w", def.Path, err)
+	}
+	ops := []keyop.T{{Key: ManagedByKey, Op: keyop.Set, Value: managedBy}}
+	var installedConfig *xconfig.T
+	if installed != nil {
+		if installedConfig, err = xconfig.NewObject("", installed); err != nil {
+			return step, fmt.Errorf("%s: installed config: %w", def.Path, err)
+		}
+	}
+	if c.Get(idKey) == "" {
+		// Preserve the installed object id, unless the definition
+		// forces one.
+		id := uuid.New().String()
+		if installedConfig != nil && installedConfig.Get(idKey) != "" {
+			id = installedConfig.Get(idKey)
+		}
+		ops = append(ops, keyop.T{Key: idKey, Op: keyop.Set, Value: id})
+	}
+	if err := c.PrepareSetKeys(ops...); err != nil {
+		return step, fmt.Errorf("%s: %w", def.Path, err)
+	}
+	if step.data, err = c.Bytes(); err != nil {
+		return step, fmt.Errorf("%s: %w", def.Path, err)
+	}
+	switch {
+	case installedConfig == nil:
+		step.Action = ActionCreate
+		step.Diff = confighistory.Diff("/dev/null", nil, def.Path.String(), step.data)
+	case installedConfig.Equal(c):
+		step.Action = ActionNone
+	default:
+		step.Action = ActionUpdate
+		step.Diff = confighistory.Diff(def.Path.String(), installed, def.File, step.data)
+	}
+	return step, nil
+}
+
+// order sorts the steps: creates and updates first, ordered so the
+// configuration objects come before the services, then the deletes in the
+// reverse order.
+func (t *T) order() {
+	sort.SliceStable(t.Steps, func(i, j int) bool {
+		a, b := t.Steps[i], t.Steps[j]
+		if pa, pb := a.Action.phase(), b.Action.phase(); pa != pb {
+			return pa < pb
+		}
+		ra, rb := kindRank(a.Path.Kind), kindRank(b.Path.Kind)
+		if a.Action == ActionDelete {
+			ra, rb = rb, ra
+		}
+		if ra != rb {
+			return ra < rb
+		}
+		return a.Path.String() < b.Path.String()
+	})
+}
+
+// HasChanges returns true if at least one step changes an object.
+func (t T) HasChanges() bool {
+	for _, step := range t.Steps {
+		if step.Action != ActionNone {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate validates the configurations to install. The validation alerts
+// are stored in the steps, and an error is returned if a configuration has
+// validation errors.
+func (t *T) Validate() error {
+	var invalid []string
+	for i, step := range t.Steps {
+		switch step.Action {
+		case ActionCreate, ActionUpdate:
+		default:
+			continue
+		}
+		o, err := object.New(step.Path, object.WithVolatile(true), object.WithConfigData(step.data))
+		if err != nil {
+			return fmt.Errorf("%s: %w", step.Path, err)
+		}
+		configurer, ok := o.(object.Configurer)
+		if !ok {
+			return fmt.Errorf("%s is not a configurer", step.Path)
+		}
+		alerts, err := configurer.Config().Validate()
+		t.Steps[i].Alerts = alerts
+		if err != nil || alerts.HasError() {
+			invalid = append(invalid, step.Path.String())
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("invalid configuration: %s", strings.Join(invalid, ", "))
+	}
+	return nil
+}
+
+// Apply commits the plan steps to the store, in order. It stops on the
+// first error.
+func (t T) Apply(store Store) error {
+	for _, step := range t.Steps {
+		var err error
+		switch step.Action {
+		case ActionCreate:
+			err = store.Create(step.Path, step.data)
+		case ActionUpdate:
+			err = store.Update(step.Path, step.data)
+		case ActionDelete:
+			err = store.Delete(step.Path)
+		}
+		if err != nil {
+			return fmt.Errorf("%s %s: %w", step.Action, step.Path, err)
+		}
+	}
+	return nil
+}
+
+// Render returns a human friendly string representation of the plan.
+func (t T) Render() string {
+	tr := tree.New()
+	tr.AddColumn().AddText("apply").SetColor(rawconfig.Color.Bold)
+	tr.AddColumn().AddText("managed by " + t.ManagedBy).SetColor(rawconfig.Color.Secondary)
+	if len(t.Steps) == 0 {
+		tr.AddNode().AddColumn().AddText("nothing to do")
+	}
+	for _, step := range t.Steps {
+		n := tr.AddNode()
+		n.AddColumn().AddText(step.Path.String())
+		switch step.Action {
+		case ActionCreate:
+			n.AddColumn().AddText(step.Action.String()).SetColor(rawconfig.Color.Optimal)
+		case ActionDelete:
+			n.AddColumn().AddText(step.Action.String()).SetColor(rawconfig.Color.Error)
+		case ActionUpdate:
+			n.AddColumn().AddText(step.Action.String()).SetColor(rawconfig.Color.Warning)
+		default:
+			n.AddColumn().AddText(step.Action.String()).SetColor(rawconfig.Color.Secondary)
+		}
+		n.AddColumn().AddText(step.File).SetColor(rawconfig.Color.Secondary)
+	}
+	s := tr.Render()
+	for _, step := range t.Steps {
+		if step.Action == ActionUpdate {
+			s += "\n" + step.Diff
+		}
+		if len(step.Alerts) > 0 {
+			s += "\n" + step.Path.String() + " validation alerts:\n" + step.Alerts.Render()
+		}
+	}
+	return s
+}
@@ -0,0 +1,149 @@
+package objectapply
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/xconfig"
+	"github.com/opensvc/om3/util/key"
+)
+
+type (
+	testSource map[naming.Path][]byte
+)
+
+func (t testSource) Paths() (naming.Paths, error) {
+	l := make(naming.Paths, 0)
+	for p := range t {
+		l = append(l, p)
+	}
+	return l, nil
+}
+
+func (t testSource) ConfigData(p naming.Path) ([]byte, error) {
+	return t[p], nil
+}
+
+func mustParsePath(s string) naming.Path {
+	p, err := naming.ParsePath(s)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+func writeDefinitions(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		filename := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(filename), 0700))
+		require.NoError(t, os.WriteFile(filename, []byte(content), 0600))
+	}
+	return dir
+}
+
+func TestLoad(t *testing.T) {
+	dir := writeDefinitions(t, map[string]string{
+		"svc1.conf":        "[DEFAULT]\nnodes = *\n",
+		"web.ini":          "[metadata]\nnamespace = ns1\nname = web\n\n[DEFAULT]\nnodes = *\n",
+		"sub/db.json":      `{"metadata": {"name": "db", "kind": "svc", "namespace": "ns1"}, "DEFAULT": {"nodes": "*"}}`,
+		"sub/cfgs.yaml":    "ns1/cfg/c1:\n  data:\n    k1: v1\nns1/sec/s1:\n  DEFAULT:\n    orchestrate: \"no\"\n",
+		"sub/README.md":    "not a definition",
+		"sub/deep/vol.yml": "metadata:\n  name: v1\n  kind: vol\nDEFAULT:\n  size: 1g\n",
+	})
+	defs, err := Load(dir)
+	require.NoError(t, err)
+	var paths []string
+	for _, def := range defs {
+		paths = append(paths, def.Path.String())
+	}
+	assert.Equal(t, []string{"ns1/cfg/c1", "ns1/sec/s1", "ns1/svc/db", "ns1/svc/web", "svc1", "vol/v1"}, paths)
+	assert.Equal(t, "v1", defs[0].config.Get(key.New("data", "k1")))
+
+	t.Run("duplicate definitions", func(t *testing.T) {
+		_, err := Load(dir, filepath.Join(dir, "svc1.conf"))
+		assert.ErrorContains(t, err, "defined in both")
+	})
+}
+
+func TestPlan(t *testing.T) {
+	dir := writeDefinitions(t, map[string]string{
+		"new.conf":       "[DEFAULT]\nnodes = *\n",
+		"same.conf":      "# comments are ignored\n[DEFAULT]\nnodes = *\n",
+		"changed.conf":   "[DEFAULT]\nnodes = n1 n2\n",
+		"forced-id.conf": "[DEFAULT]\nid = 1234\nnodes = *\n",
+	})
+	src := testSource{
+		mustParsePath("same"):      []byte("[DEFAULT]\nnodes = *\nid = abc\n\n[labels]\nmanaged_by = ci\n"),
+		mustParsePath("changed"):   []byte("[DEFAULT]\nnodes = n1\nid = def\n\n[labels]\nmanaged_by = ci\n"),
+		mustParsePath("forced-id"): []byte("[DEFAULT]\nnodes = *\nid = ghi\n\n[labels]\nmanaged_by = ci\n"),
+		mustParsePath("orphan"):    []byte("[DEFAULT]\nnodes = *\nid = jkl\n\n[labels]\nmanaged_by = ci\n"),
+		mustParsePath("other"):     []byte("[DEFAULT]\nnodes = *\nid = mno\n\n[labels]\nmanaged_by = other\n"),
+		mustParsePath("manual"):    []byte("[DEFAULT]\nnodes = *\nid = pqr\n"),
+		mustParsePath("cfg/c1"):    []byte("[DEFAULT]\nid = stu\n\n[labels]\nmanaged_by = ci\n"),
+	}
+	actions := func(plan T) map[string]Action {
+		m := make(map[string]Action)
+		for _, step := range plan.Steps {
+			m[step.Path.String()] = step.Action
+		}
+		return m
+	}
+
+	t.Run("without prune", func(t *testing.T) {
+		defs, err := Load(dir)
+		require.NoError(t, err)
+		plan, err := defs.Plan(src, "ci", false)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]Action{
+			"changed":   ActionUpdate,
+			"forced-id": ActionUpdate,
+			"new":       ActionCreate,
+			"same":      ActionNone,
+		}, actions(plan))
+		assert.True(t, plan.HasChanges())
+		for _, step := range plan.Steps {
+			c, err := xconfig.NewObject("", step.data)
+			require.NoError(t, err)
+			assert.Equal(t, "ci", c.Get(ManagedByKey), "%s is labeled", step.Path)
+			switch step.Path.Name {
+			case "changed":
+				assert.Equal(t, "def", c.Get(idKey), "the installed id is preserved")
+				assert.Contains(t, step.Diff, "+nodes = n1 n2")
+			case "forced-id":
+				assert.Equal(t, "1234", c.Get(idKey), "the defined id is preserved")
+			case "new":
+				assert.NotEmpty(t, c.Get(idKey), "an id is generated")
+			}
+		}
+	})
+
+	t.Run("with prune", func(t *testing.T) {
+		defs, err := Load(dir)
+		require.NoError(t, err)
+		plan, err := defs.Plan(src, "ci", true)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]Action{
+			"changed":   ActionUpdate,
+			"forced-id": ActionUpdate,
+			"new":       ActionCreate,
+			"same":      ActionNone,
+			"orphan":    ActionDelete,
+			"cfg/c1":    ActionDelete,
+		}, actions(plan))
+		last := plan.Steps[len(plan.Steps)-1]
+		assert.Equal(t, "cfg/c1", last.Path.String(), "the configuration objects are deleted last")
+		assert.NotEmpty(t, plan.Render())
+	})
+
+	t.Run("empty managed by", func(t *testing.T) {
+		_, err := Definitions{}.Plan(src, "", true)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,192 @@
+package objectapply
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/objectselector"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/util/key"
+)
+
+type (
+	// LocalStore is a Store of the objects installed on the local node.
+	// The changes are committed to the local configuration files, and
+	// replicated to the peer nodes by the daemon.
+	LocalStore struct{}
+
+	// RemoteStore is a Store of the objects installed in the cluster,
+	// accessed through the api of a daemon.
+	RemoteStore struct {
+		client *client.T
+	}
+)
+
+// NewRemoteStore returns a Store accessing the objects through the
+// client daemon api.
+func NewRemoteStore(c *client.T) *RemoteStore {
+	return &RemoteStore{client: c}
+}
+
+func (t LocalStore) Paths() (naming.Paths, error) {
+	return naming.InstalledPaths()
+}
+
+func (t LocalStore) ConfigData(p naming.Path) ([]byte, error) {
+	b, err := os.ReadFile(p.ConfigFile())
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	return b, err
+}
+
+func (t LocalStore) Create(p naming.Path, b []byte) error {
+	if p.Exists() {
+		return fmt.Errorf("%s already exists", p)
+	}
+	o, err := t.commit(p, b)
+	if err != nil {
+		return err
+	}
+	// Freeze if orchestrate==ha and freeze capable, so the daemon
+	// doesn't decide to start the instance too soon.
+	orchestrate := o.Config().GetString(key.Parse("orchestrate"))
+	if oa, ok := o.(object.Actor); ok && orchestrate == "ha" {
+		if err := oa.Freeze(context.Background()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t LocalStore) Update(p naming.Path, b []byte) error {
+	_, err := t.commit(p, b)
+	return err
+}
+
+func (t LocalStore) Delete(p naming.Path) error {
+	o, err := object.NewConfigurer(p)
+	if err != nil {
+		return err
+	}
+	return o.Delete(context.Background())
+}
+
+func (t LocalStore) commit(p naming.Path, b []byte) (object.Configurer, error) {
+	o, err := object.New(p, object.WithConfigData(b))
+	if err != nil {
+		return nil, err
+	}
+	configurer, ok := o.(object.Configurer)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a configurer", o)
+	}
+	// The plan is validated before apply, use the non-validating commit.
+	return configurer, configurer.Config().RecommitInvalid()
+}
+
+func (t RemoteStore) Paths() (naming.Paths, error) {
+	return objectselector.New("**", objectselector.WithClient(t.client)).Expand()
+}
+
+// ConfigData returns the configuration file content of the object, read
+// from the api server node, or from a node hosting an instance if the
+// api server node does not.
+func (t RemoteStore) ConfigData(p naming.Path) ([]byte, error) {
+	b, err := t.configData(p, t.client)
+	if b != nil || err != nil {
+		return b, err
+	}
+	resp, err := t.client.GetObjectWithResponse(context.Background(), p.Namespace, p.Kind, p.Name)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode() {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("get object %s data from %s: %s", p, t.client.URL(), resp.Status())
+	}
+	for nodename := range resp.JSON200.Data.Instances {
+		c, err := client.New(client.WithURL(nodename))
+		if err != nil {
+			return nil, err
+		}
+		if b, err := t.configData(p, c); err != nil || b != nil {
+			return b, err
+		}
+	}
+	return nil, nil
+}
+
+func (t RemoteStore) configData(p naming.Path, c *client.T) ([]byte, error) {
+	resp, err := c.GetObjectConfigFileWithResponse(context.Background(), p.Namespace, p.Kind, p.Name)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		return resp.JSON200.Data, nil
+	case http.StatusNotFound:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("get object %s file from %s: %s", p, c.URL(), resp.Status())
+	}
+}
+
+func (t RemoteStore) Create(p naming.Path, b []byte) error {
+	body := api.PostObjectConfigFileJSONRequestBody{
+		Data:  b,
+		Mtime: time.Now(),
+	}
+	resp, err := t.client.PostObjectConfigFileWithResponse(context.Background(), p.Namespace, p.Kind, p.Name, body)
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode() {
+	case http.StatusNoContent:
+		return nil
+	default:
+		return fmt.Errorf("%s: %s", resp.Status(), string(resp.Body))
+	}
+}
+
+func (t RemoteStore) Update(p naming.Path, b []byte) error {
+	body := api.PutObjectConfigFileJSONRequestBody{
+		Data:  b,
+		Mtime: time.Now(),
+	}
+	resp, err := t.client.PutObjectConfigFileWithResponse(context.Background(), p.Namespace, p.Kind, p.Name, body)
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode() {
+	case http.StatusNoContent:
+		return nil
+	default:
+		return fmt.Errorf("%s: %s", resp.Status(), string(resp.Body))
+	}
+}
+
+// Delete submits a deleted global expect orchestration. It does not wait
+// for the orchestration to complete.
+func (t RemoteStore) Delete(p naming.Path) error {
+	resp, err := t.client.PostObjectActionDeleteWithResponse(context.Background(), p.Namespace, p.Kind, p.Name)
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		return nil
+	default:
+		return fmt.Errorf("%s: %s", resp.Status(), string(resp.Body))
+	}
+}
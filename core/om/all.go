@@ -114,6 +114,7 @@ func init() {
 		cmdObjectComplianceShow,
 		cmdObjectComplianceList,
 		newCmdObjectComplianceEnv(kind),
+		newCmdObjectComplianceHistory(kind),
 		newCmdObjectComplianceAuto(kind),
 		newCmdObjectComplianceCheck(kind),
 		newCmdObjectComplianceFix(kind),
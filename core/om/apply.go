@@ -0,0 +1,5 @@
+package om
+
+func init() {
+	root.AddCommand(newCmdApply())
+}
@@ -4,6 +4,7 @@ func init() {
 	kind := "ccfg"
 
 	cmdObject := newCmdCcfg()
+	cmdObjectCA := newCmdClusterCA()
 	cmdObjectEdit := newCmdObjectEdit(kind)
 	cmdObjectSet := newCmdObjectSet(kind)
 	cmdObjectPrint := newCmdObjectPrint(kind)
@@ -14,6 +15,7 @@ func init() {
 		cmdObject,
 	)
 	cmdObject.AddCommand(
+		cmdObjectCA,
 		cmdObjectEdit,
 		cmdObjectSet,
 		cmdObjectPrint,
@@ -34,6 +36,9 @@ func init() {
 		newCmdObjectUnset(kind),
 		newCmdObjectUpdate(kind),
 	)
+	cmdObjectCA.AddCommand(
+		newCmdClusterCARotate(),
+	)
 	cmdObjectEdit.AddCommand(
 		newCmdObjectEditConfig(kind),
 	)
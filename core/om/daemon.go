@@ -19,6 +19,11 @@ var (
 		Use:   "relay",
 		Short: "relay subsystem commands",
 	}
+
+	cmdDaemonToken = &cobra.Command{
+		Use:   "token",
+		Short: "api tokens commands",
+	}
 )
 
 func init() {
@@ -38,6 +43,7 @@ func init() {
 		newCmdDaemonStats(),
 		newCmdDaemonStatus(),
 		newCmdDaemonStop(),
+		cmdDaemonToken,
 	)
 	cmdDaemonDNS.AddCommand(
 		newCmdDaemonDNSDump(),
@@ -45,4 +51,8 @@ func init() {
 	cmdDaemonRelay.AddCommand(
 		newCmdDaemonRelayStatus(),
 	)
+	cmdDaemonToken.AddCommand(
+		newCmdDaemonTokenLs(),
+		newCmdDaemonTokenRevoke(),
+	)
 }
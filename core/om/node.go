@@ -15,6 +15,10 @@ var (
 		Short:   "scan and list what the node is capable of",
 		Aliases: []string{"capa", "caps", "cap"},
 	}
+	cmdNodeCert = &cobra.Command{
+		Use:   "cert",
+		Short: "listener certificate management commands",
+	}
 	cmdNodeCollector = &cobra.Command{
 		Use:     "collector",
 		Short:   "node collector data management commands",
@@ -62,8 +66,9 @@ var (
 		Use:   "relay",
 		Short: "relay subsystem commands",
 	}
-	cmdNodeEdit     = newCmdNodeEdit()
-	cmdNodeValidate = newCmdNodeValidate()
+	cmdNodeEdit      = newCmdNodeEdit()
+	cmdNodeSysreport = newCmdNodeSysreport()
+	cmdNodeValidate  = newCmdNodeValidate()
 )
 
 func init() {
@@ -73,6 +78,10 @@ func init() {
 		newCmdNodeCapabilitiesList(),
 		newCmdNodeCapabilitiesScan(),
 	)
+	cmdNode.AddCommand(cmdNodeCert)
+	cmdNodeCert.AddCommand(
+		newCmdNodeCertRenew(),
+	)
 	cmdNode.AddCommand(cmdNodeCollector)
 	cmdNodeCollector.AddCommand(cmdNodeCollectorTag)
 	cmdNodeCollectorTag.AddCommand(
@@ -93,6 +102,7 @@ func init() {
 		newCmdNodeComplianceCheck(),
 		newCmdNodeComplianceFix(),
 		newCmdNodeComplianceFixable(),
+		newCmdNodeComplianceHistory(),
 	)
 	cmdNodeComplianceAttach.AddCommand(
 		newCmdNodeComplianceAttachModuleset(),
@@ -121,6 +131,7 @@ func init() {
 		cmdNodeRelay,
 		cmdNodeValidate,
 		newCmdNodeAbort(),
+		newCmdNodeAsset(),
 		newCmdNodeChecks(),
 		newCmdNodeClear(),
 		newCmdNodeDoc(),
@@ -135,7 +146,7 @@ func init() {
 		newCmdNodeEval(),
 		newCmdNodeRegister(),
 		newCmdNodeSet(),
-		newCmdNodeSysreport(),
+		cmdNodeSysreport,
 		newCmdNodeUnfreeze(),
 		newCmdNodeUpdate(),
 		newCmdNodeUnset(),
@@ -151,6 +162,10 @@ func init() {
 		newCmdNodePushPatch(),
 		newCmdNodePushPkg(),
 	)
+	cmdNodeSysreport.AddCommand(
+		newCmdNodeSysreportDiff(),
+		newCmdNodeSysreportList(),
+	)
 	cmdNodeValidate.AddCommand(
 		newCmdNodeValidateConfig(),
 	)
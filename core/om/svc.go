@@ -11,6 +11,7 @@ func init() {
 	cmdObjectComplianceDetach := newCmdObjectComplianceDetach(kind)
 	cmdObjectComplianceShow := newCmdObjectComplianceShow(kind)
 	cmdObjectComplianceList := newCmdObjectComplianceList(kind)
+	cmdObjectConfig := newCmdObjectConfig(kind)
 	cmdObjectEdit := newCmdObjectEdit(kind)
 	cmdObjectInstance := newCmdObjectInstance(kind)
 	cmdObjectResource := newCmdObjectResource(kind)
@@ -27,6 +28,7 @@ func init() {
 	cmdObject.AddCommand(
 		cmdObjectCollector,
 		cmdObjectCompliance,
+		cmdObjectConfig,
 		cmdObjectEdit,
 		cmdObjectInstance,
 		cmdObjectPrint,
@@ -68,6 +70,11 @@ func init() {
 		newCmdObjectUnset(kind),
 		newCmdObjectUpdate(kind),
 	)
+	cmdObjectConfig.AddCommand(
+		newCmdObjectConfigDiff(kind),
+		newCmdObjectConfigHistory(kind),
+		newCmdObjectConfigRollback(kind),
+	)
 	cmdObjectEdit.AddCommand(
 		newCmdObjectEditConfig(kind),
 	)
@@ -117,6 +124,7 @@ func init() {
 		cmdObjectComplianceShow,
 		cmdObjectComplianceList,
 		newCmdObjectComplianceEnv(kind),
+		newCmdObjectComplianceHistory(kind),
 		newCmdObjectComplianceAuto(kind),
 		newCmdObjectComplianceCheck(kind),
 		newCmdObjectComplianceFix(kind),
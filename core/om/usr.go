@@ -4,9 +4,11 @@ func init() {
 	kind := "usr"
 
 	cmdObject := newCmdUsr()
+	cmdObjectConfig := newCmdObjectConfig(kind)
 	cmdObjectEdit := newCmdObjectEdit(kind)
 	cmdObjectInstance := newCmdObjectInstance(kind)
 	cmdObjectSet := newCmdObjectSet(kind)
+	cmdObjectTOTP := newCmdUsrTOTP()
 	cmdObjectPrint := newCmdObjectPrint(kind)
 	cmdObjectPrintConfig := newCmdObjectPrintConfig(kind)
 	cmdObjectValidate := newCmdObjectValidate(kind)
@@ -15,10 +17,12 @@ func init() {
 		cmdObject,
 	)
 	cmdObject.AddCommand(
+		cmdObjectConfig,
 		cmdObjectEdit,
 		cmdObjectInstance,
 		cmdObjectPrint,
 		cmdObjectSet,
+		cmdObjectTOTP,
 		cmdObjectValidate,
 		newCmdKeystoreAdd(kind),
 		newCmdKeystoreChange(kind),
@@ -39,6 +43,12 @@ func init() {
 		newCmdObjectUpdate(kind),
 		newCmdSecGenCert(kind),
 		newCmdSecPKCS(kind),
+		newCmdSecRekey(kind),
+	)
+	cmdObjectConfig.AddCommand(
+		newCmdObjectConfigDiff(kind),
+		newCmdObjectConfigHistory(kind),
+		newCmdObjectConfigRollback(kind),
 	)
 	cmdObjectEdit.AddCommand(
 		newCmdObjectEditConfig(kind),
@@ -53,6 +63,9 @@ func init() {
 	cmdObjectPrintConfig.AddCommand(
 		newCmdObjectPrintConfigMtime(kind),
 	)
+	cmdObjectTOTP.AddCommand(
+		newCmdUsrTOTPEnroll(kind),
+	)
 	cmdObjectValidate.AddCommand(
 		newCmdObjectValidateConfig(kind),
 	)
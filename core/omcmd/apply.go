@@ -0,0 +1,60 @@
+package commands
+
+import (
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/clientcontext"
+	"github.com/opensvc/om3/core/objectapply"
+	"github.com/opensvc/om3/core/output"
+	"github.com/opensvc/om3/core/rawconfig"
+)
+
+type (
+	CmdApply struct {
+		OptsGlobal
+		DryRun    bool
+		Files     []string
+		ManagedBy string
+		Prune     bool
+	}
+)
+
+func (t *CmdApply) store() (objectapply.Store, error) {
+	if t.Local || !clientcontext.IsSet() {
+		return objectapply.LocalStore{}, nil
+	}
+	c, err := client.New(client.WithURL(t.Server))
+	if err != nil {
+		return nil, err
+	}
+	return objectapply.NewRemoteStore(c), nil
+}
+
+func (t *CmdApply) Run() error {
+	defs, err := objectapply.Load(t.Files...)
+	if err != nil {
+		return err
+	}
+	store, err := t.store()
+	if err != nil {
+		return err
+	}
+	plan, err := defs.Plan(store, t.ManagedBy, t.Prune)
+	if err != nil {
+		return err
+	}
+	validateErr := plan.Validate()
+	output.Renderer{
+		Output:        t.Output,
+		Color:         t.Color,
+		Data:          plan,
+		HumanRenderer: plan.Render,
+		Colorize:      rawconfig.Colorize,
+	}.Print()
+	if validateErr != nil {
+		return validateErr
+	}
+	if t.DryRun {
+		return nil
+	}
+	return plan.Apply(store)
+}
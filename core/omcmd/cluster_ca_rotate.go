@@ -0,0 +1,160 @@
+package commands
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"regexp"
+	"time"
+
+	"github.com/opensvc/om3/core/keyop"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/util/key"
+)
+
+type (
+	CmdClusterCARotate struct {
+		Finish bool
+	}
+)
+
+var (
+	// clusterCAArchiveRegexp matches the name of the sec objects holding
+	// the archived CA certificates.
+	clusterCAArchiveRegexp = regexp.MustCompile(`^ca-[0-9]{14}$`)
+
+	clusterCAPath   = naming.Path{Namespace: "system", Kind: naming.KindSec, Name: "ca"}
+	clusterCertPath = naming.Path{Namespace: "system", Kind: naming.KindSec, Name: "cert"}
+)
+
+// Run replaces the cluster CA private key and certificate.
+//
+// The current CA certificate is first archived in a new sec object added
+// to the cluster.ca trusted list, so the peer listeners and the x509 clients
+// keep trusting the certificates signed by the previous CA until they
+// receive their renewed certificates through the config sync.
+func (t *CmdClusterCARotate) Run() error {
+	if t.Finish {
+		return t.finish()
+	}
+	if !clusterCAPath.Exists() {
+		return fmt.Errorf("%s does not exist", clusterCAPath)
+	}
+	ca, err := object.NewSec(clusterCAPath, object.WithVolatile(false))
+	if err != nil {
+		return err
+	}
+	archivePath := naming.Path{
+		Namespace: clusterCAPath.Namespace,
+		Kind:      naming.KindSec,
+		Name:      clusterCAPath.Name + "-" + time.Now().Format("20060102150405"),
+	}
+	_, _ = fmt.Fprintf(os.Stdout, "Archive %s certificate to %s\n", clusterCAPath, archivePath)
+	if err := ca.ArchiveCert(archivePath); err != nil {
+		return fmt.Errorf("archive %s certificate to %s: %w", clusterCAPath, archivePath, err)
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "Trust %s during the transition\n", archivePath)
+	ccfg, err := object.NewCluster(object.WithVolatile(false))
+	if err != nil {
+		return err
+	}
+	op := keyop.New(key.New("cluster", "ca"), keyop.Append, archivePath.String(), 0)
+	if err := ccfg.Set(context.Background(), *op); err != nil {
+		return fmt.Errorf("add %s to the trusted ca list: %w", archivePath, err)
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "Rotate %s private key and certificate\n", clusterCAPath)
+	if err := ca.RotateCert(); err != nil {
+		return fmt.Errorf("rotate %s: %w", clusterCAPath, err)
+	}
+
+	return (&CmdNodeCertRenew{}).Run()
+}
+
+// finish ends the CA rotation transition: the archived CA certificates are
+// removed from the cluster.ca trusted list, and their sec objects deleted.
+//
+// The listener certificate must be signed by the current CA, or the nodes
+// would refuse each other once the previous CA is not trusted anymore.
+func (t *CmdClusterCARotate) finish() error {
+	if err := verifyClusterCert(); err != nil {
+		return err
+	}
+	ccfg, err := object.NewCluster(object.WithVolatile(false))
+	if err != nil {
+		return err
+	}
+	k := key.New("cluster", "ca")
+	var ops []keyop.T
+	var archives naming.Paths
+	for _, s := range ccfg.Config().GetStrings(k) {
+		p, err := naming.ParsePath(s)
+		if err != nil {
+			continue
+		}
+		if p.Namespace != clusterCAPath.Namespace || p.Kind != naming.KindSec || !clusterCAArchiveRegexp.MatchString(p.Name) {
+			continue
+		}
+		ops = append(ops, *keyop.New(k, keyop.Remove, s, 0))
+		archives = append(archives, p)
+	}
+	if len(archives) == 0 {
+		_, _ = fmt.Fprintf(os.Stdout, "No archived ca in the trusted ca list\n")
+		return nil
+	}
+	ctx := context.Background()
+	_, _ = fmt.Fprintf(os.Stdout, "Stop trusting %s\n", archives)
+	if err := ccfg.Set(ctx, ops...); err != nil {
+		return fmt.Errorf("remove %s from the trusted ca list: %w", archives, err)
+	}
+	for _, p := range archives {
+		if !p.Exists() {
+			continue
+		}
+		_, _ = fmt.Fprintf(os.Stdout, "Delete %s\n", p)
+		o, err := object.NewSec(p, object.WithVolatile(false))
+		if err != nil {
+			return err
+		}
+		if err := o.Delete(ctx); err != nil {
+			return fmt.Errorf("delete %s: %w", p, err)
+		}
+	}
+	return nil
+}
+
+// verifyClusterCert returns an error if the listener certificate is not
+// signed by the current cluster CA.
+func verifyClusterCert() error {
+	decode := func(p naming.Path) (*x509.Certificate, error) {
+		o, err := object.NewSec(p, object.WithVolatile(true))
+		if err != nil {
+			return nil, err
+		}
+		b, err := o.DecodeKey("certificate")
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", p, err)
+		}
+		block, _ := pem.Decode(b)
+		if block == nil {
+			return nil, fmt.Errorf("%s: no pem certificate found", p)
+		}
+		return x509.ParseCertificate(block.Bytes)
+	}
+	caCert, err := decode(clusterCAPath)
+	if err != nil {
+		return err
+	}
+	cert, err := decode(clusterCertPath)
+	if err != nil {
+		return err
+	}
+	if err := cert.CheckSignatureFrom(caCert); err != nil {
+		return fmt.Errorf("%s is not signed by the current %s, renew it first: %w", clusterCertPath, clusterCAPath, err)
+	}
+	return nil
+}
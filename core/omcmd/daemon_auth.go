@@ -5,8 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"os"
+	"strings"
 	"time"
 
+	"golang.org/x/term"
+
 	"github.com/opensvc/om3/core/client"
 	"github.com/opensvc/om3/daemon/api"
 )
@@ -17,6 +21,10 @@ type (
 		Roles    []string
 		Duration time.Duration
 		Out      []string
+		TOTP     string
+
+		Refresh         bool
+		RefreshDuration time.Duration
 	}
 )
 
@@ -46,30 +54,80 @@ func (t *CmdDaemonAuth) Run() error {
 		// Don't set params.Role when --role isn't used
 		params.Role = &roles
 	}
+	if t.TOTP != "" {
+		params.Totp = &t.TOTP
+	}
+	if t.Refresh {
+		refreshDuration := t.RefreshDuration.String()
+		params.Refresh = &t.Refresh
+		params.RefreshDuration = &refreshDuration
+	}
 	resp, err := c.PostAuthTokenWithResponse(context.Background(), &params)
 	if err != nil {
 		return fmt.Errorf("%w: %w: %w", ErrCmdDaemonAuth, ErrClientRequest, err)
-	} else if resp.StatusCode() != http.StatusOK {
+	}
+	if resp.JSON401 != nil && resp.JSON401.Title == "TOTP code required" && params.Totp == nil && term.IsTerminal(int(os.Stdin.Fd())) {
+		// the user is enrolled to the totp second factor
+		code, err := promptTOTP()
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrCmdDaemonAuth, err)
+		}
+		params.Totp = &code
+		resp, err = c.PostAuthTokenWithResponse(context.Background(), &params)
+		if err != nil {
+			return fmt.Errorf("%w: %w: %w", ErrCmdDaemonAuth, ErrClientRequest, err)
+		}
+	}
+	if resp.StatusCode() != http.StatusOK {
 		return fmt.Errorf("%w: %w: got %d wanted %d", ErrCmdDaemonAuth, ErrClientStatusCode, resp.StatusCode(), http.StatusOK)
 	}
 	if len(t.Out) == 0 {
 		t.Out = []string{"token", "expire_at"}
 	}
 	for _, out := range t.Out {
+		var v any
 		switch out {
 		case "token":
-			if _, err := fmt.Printf("%s\n", resp.JSON200.Token); err != nil {
-				return fmt.Errorf("%w: %w: token: %w", ErrCmdDaemonAuth, ErrPrint, err)
-			}
+			v = resp.JSON200.Token
 		case "expired_at":
-			if _, err := fmt.Printf("%s\n", resp.JSON200.ExpiredAt); err != nil {
-				return fmt.Errorf("%w: %w: expired_at: %w", ErrCmdDaemonAuth, ErrPrint, err)
+			v = resp.JSON200.ExpiredAt
+		case "token_id":
+			v = ptrString(resp.JSON200.TokenId)
+		case "refresh_token":
+			v = ptrString(resp.JSON200.RefreshToken)
+		case "refresh_token_id":
+			v = ptrString(resp.JSON200.RefreshTokenId)
+		case "refresh_expired_at":
+			if resp.JSON200.RefreshExpiredAt != nil {
+				v = *resp.JSON200.RefreshExpiredAt
+			} else {
+				v = ""
 			}
 		}
+		if _, err := fmt.Printf("%s\n", v); err != nil {
+			return fmt.Errorf("%w: %w: %s: %w", ErrCmdDaemonAuth, ErrPrint, out, err)
+		}
 	}
 	return nil
 }
 
+func ptrString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func promptTOTP() (string, error) {
+	fmt.Fprintf(os.Stderr, "TOTP code: ")
+	b, err := term.ReadPassword(int(os.Stdin.Fd()))
+	fmt.Fprintln(os.Stderr, "")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(b)), nil
+}
+
 func (t *CmdDaemonAuth) checkParams() error {
 	if len(t.Out) == 0 {
 		return fmt.Errorf("%w: out is empty", ErrFlagInvalid)
@@ -78,6 +136,11 @@ func (t *CmdDaemonAuth) checkParams() error {
 		switch s {
 		case "token":
 		case "expired_at":
+		case "token_id":
+		case "refresh_token", "refresh_token_id", "refresh_expired_at":
+			if !t.Refresh {
+				return fmt.Errorf("%w: out contains %s but refresh is not set", ErrFlagInvalid, s)
+			}
 		default:
 			return fmt.Errorf("%w: out contains unexpected value: %s", ErrFlagInvalid, s)
 		}
@@ -2,6 +2,7 @@ package commands
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -11,6 +12,9 @@ import (
 	"github.com/opensvc/om3/core/client"
 	"github.com/opensvc/om3/core/clientcontext"
 	"github.com/opensvc/om3/core/nodeselector"
+	"github.com/opensvc/om3/core/output"
+	"github.com/opensvc/om3/core/plan"
+	"github.com/opensvc/om3/core/rawconfig"
 	"github.com/opensvc/om3/daemon/api"
 	"github.com/opensvc/om3/util/hostname"
 )
@@ -22,6 +26,9 @@ type (
 
 		// Timeout is the maximum duration for shutdown
 		Timeout time.Duration
+
+		// Plan shows the shutdown plan instead of shutting down
+		Plan bool
 	}
 )
 
@@ -35,6 +42,9 @@ func (t *CmdDaemonShutdown) Run() error {
 	if t.NodeSelector == "" {
 		return fmt.Errorf("--node must be specified")
 	}
+	if t.Plan {
+		return t.doPlan()
+	}
 	return t.doNodes()
 }
 
@@ -108,3 +118,62 @@ func (t *CmdDaemonShutdown) doRemote(ctx context.Context, c *client.T, nodename
 	}
 	return
 }
+
+func (t *CmdDaemonShutdown) doPlan() error {
+	c, err := client.New(client.WithURL(t.Server))
+	if err != nil {
+		return err
+	}
+	nodenames, err := nodeselector.New(t.NodeSelector, nodeselector.WithClient(c)).Expand()
+	if err != nil {
+		return err
+	}
+	var (
+		errs   error
+		plans  = make([]plan.T, 0)
+		dryRun = true
+		params = api.PostDaemonShutdownParams{DryRun: &dryRun}
+	)
+	for _, nodename := range nodenames {
+		resp, err := c.PostDaemonShutdownWithResponse(context.Background(), nodename, &params)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		switch resp.StatusCode() {
+		case http.StatusOK:
+			var data struct {
+				Plan plan.T `json:"plan"`
+			}
+			if err := json.Unmarshal(resp.Body, &data); err != nil {
+				errs = errors.Join(errs, fmt.Errorf("%s: %w", nodename, err))
+			} else {
+				plans = append(plans, data.Plan)
+			}
+		case 400:
+			errs = errors.Join(errs, fmt.Errorf("%s: %s", nodename, resp.JSON400))
+		case 401:
+			errs = errors.Join(errs, fmt.Errorf("%s: %s", nodename, resp.JSON401))
+		case 403:
+			errs = errors.Join(errs, fmt.Errorf("%s: %s", nodename, resp.JSON403))
+		case 500:
+			errs = errors.Join(errs, fmt.Errorf("%s: %s", nodename, resp.JSON500))
+		default:
+			errs = errors.Join(errs, fmt.Errorf("%s: unexpected status [%d]", nodename, resp.StatusCode()))
+		}
+	}
+	output.Renderer{
+		Output: t.Output,
+		Color:  t.Color,
+		Data:   plans,
+		HumanRenderer: func() string {
+			s := ""
+			for _, p := range plans {
+				s += p.Render()
+			}
+			return s
+		},
+		Colorize: rawconfig.Colorize,
+	}.Print()
+	return errs
+}
@@ -0,0 +1,56 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/output"
+	"github.com/opensvc/om3/core/rawconfig"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/util/unstructured"
+)
+
+type (
+	CmdDaemonTokenLs struct {
+		OptsGlobal
+	}
+)
+
+func (t *CmdDaemonTokenLs) Run() error {
+	c, err := client.New(client.WithURL(t.Server))
+	if err != nil {
+		return err
+	}
+	resp, err := c.GetAuthTokensWithResponse(context.Background())
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode() {
+	case 200:
+		renderAuthTokens(resp.JSON200.Items, t.Output, t.Color)
+	case 401:
+		return fmt.Errorf("%s", resp.JSON401)
+	case 403:
+		return fmt.Errorf("%s", resp.JSON403)
+	case 500:
+		return fmt.Errorf("%s", resp.JSON500)
+	default:
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode())
+	}
+	return nil
+}
+
+func renderAuthTokens(items api.AuthTokenItems, out, color string) {
+	lines := make(unstructured.List, len(items))
+	for i, item := range items {
+		lines[i] = item.Unstructured()
+	}
+	output.Renderer{
+		DefaultOutput: "tab=ID:id,TYPE:type,OWNER:owner,ISSUED_BY:issued_by,EXPIRED_AT:expired_at,REVOKED_AT:revoked_at,PARENT:parent",
+		Output:        out,
+		Color:         color,
+		Data:          lines,
+		Colorize:      rawconfig.Colorize,
+	}.Print()
+}
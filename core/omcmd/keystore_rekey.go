@@ -0,0 +1,43 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/objectaction"
+)
+
+type (
+	CmdSecRekey struct {
+		OptsGlobal
+	}
+
+	// rekeyer is implemented by the sec and usr objects.
+	rekeyer interface {
+		Rekey() error
+	}
+)
+
+func (t *CmdSecRekey) Run(selector, kind string) error {
+	mergedSelector := mergeSelector(selector, t.ObjectSelector, kind, "")
+	return objectaction.New(
+		objectaction.LocalFirst(),
+		objectaction.WithLocal(t.Local),
+		objectaction.WithColor(t.Color),
+		objectaction.WithOutput(t.Output),
+		objectaction.WithObjectSelector(mergedSelector),
+		objectaction.WithLocalFunc(func(ctx context.Context, p naming.Path) (interface{}, error) {
+			o, err := object.New(p)
+			if err != nil {
+				return nil, err
+			}
+			store, ok := o.(rekeyer)
+			if !ok {
+				return nil, fmt.Errorf("%s does not support rekey", o)
+			}
+			return nil, store.Rekey()
+		}),
+	).Do()
+}
@@ -0,0 +1,50 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/core/keyop"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/omcrypto"
+	"github.com/opensvc/om3/testhelper"
+	"github.com/opensvc/om3/util/key"
+)
+
+func TestSecRekeyUsr(t *testing.T) {
+	env := testhelper.Setup(t)
+	omcrypto.SetClusterName("test")
+	omcrypto.SetClusterSecret(strings.Repeat("s", 32))
+
+	keyFile := filepath.Join(env.Root, "master.key")
+	require.NoError(t, os.WriteFile(keyFile, []byte(strings.Repeat("k", 64)), 0600))
+	n, err := object.NewNode()
+	require.NoError(t, err)
+	require.NoError(t, n.Config().SetKeys(
+		*keyop.Parse("keyprovider#f1.type=file"),
+		*keyop.Parse("keyprovider#f1.file=" + keyFile),
+	))
+
+	p, err := naming.ParsePath("system/usr/u1")
+	require.NoError(t, err)
+	u, err := object.NewUsr(p)
+	require.NoError(t, err)
+	require.NoError(t, u.AddKey("password", []byte("p1")))
+	require.NoError(t, u.Config().SetKeys(*keyop.Parse("key_provider=f1")))
+
+	cmd := CmdSecRekey{OptsGlobal: OptsGlobal{Local: true, Output: "json", Color: "no"}}
+	require.NoError(t, cmd.Run(p.String(), naming.KindUsr.String()))
+
+	u, err = object.NewUsr(p)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(u.Config().GetString(key.New("DEFAULT", "data_key")), "f1:"))
+	b, err := u.DecodeKey("password")
+	require.NoError(t, err)
+	assert.Equal(t, "p1", string(b))
+}
@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/event"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/daemon/msgbus"
+	"github.com/opensvc/om3/util/xsession"
+)
+
+// sessionReader returns an event reader of the end of the api exec
+// sessions started for <p> on behalf of this command. The reader must be
+// opened before the request starting a session, not to miss its end.
+func sessionReader(c *client.T, p naming.Path, duration time.Duration) (event.ReadCloser, error) {
+	filters := []string{
+		fmt.Sprintf("ExecFailed,path=%s,requester_sid=%s", p, xsession.ID),
+		fmt.Sprintf("ExecSuccess,path=%s,requester_sid=%s", p, xsession.ID),
+	}
+	getEvents := c.NewGetEvents().SetFilters(filters)
+	if duration > 0 {
+		getEvents = getEvents.SetDuration(duration)
+	}
+	return getEvents.GetReader()
+}
+
+// waitSession waits for the end of the api exec session started after
+// <evReader> was opened, and returns its error.
+func waitSession(evReader event.ReadCloser) error {
+	for {
+		ev, err := evReader.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return fmt.Errorf("no more events, the end of the session is unknown")
+			}
+			return err
+		}
+		msg, err := msgbus.EventToMessage(*ev)
+		if err != nil {
+			return err
+		}
+		switch m := msg.(type) {
+		case *msgbus.ExecSuccess:
+			return nil
+		case *msgbus.ExecFailed:
+			return errors.New(m.ErrS)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/output"
+	"github.com/opensvc/om3/core/rawconfig"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/util/hostname"
+)
+
+type (
+	CmdNodeAsset struct {
+		OptsGlobal
+		NodeSelector string
+	}
+)
+
+func (t *CmdNodeAsset) remote() (api.AssetList, error) {
+	c, err := client.New(client.WithURL(t.Server))
+	if err != nil {
+		return api.AssetList{}, err
+	}
+	params := api.GetNodesAssetParams{
+		Node: &t.NodeSelector,
+	}
+	resp, err := c.GetNodesAssetWithResponse(context.Background(), &params)
+	if err != nil {
+		return api.AssetList{}, err
+	}
+	switch resp.StatusCode() {
+	case 200:
+		return *resp.JSON200, nil
+	case 400:
+		return api.AssetList{}, fmt.Errorf("%s", *resp.JSON400)
+	case 401:
+		return api.AssetList{}, fmt.Errorf("%s", *resp.JSON401)
+	case 403:
+		return api.AssetList{}, fmt.Errorf("%s", *resp.JSON403)
+	case 500:
+		return api.AssetList{}, fmt.Errorf("%s", *resp.JSON500)
+	default:
+		return api.AssetList{}, fmt.Errorf("unexpected statuscode: %s", resp.Status())
+	}
+}
+
+func (t *CmdNodeAsset) local() (api.AssetList, error) {
+	data := api.AssetList{
+		Kind:  "AssetList",
+		Items: make(api.AssetItems, 0),
+	}
+	n, err := object.NewNode()
+	if err != nil {
+		return data, err
+	}
+	d, err := n.Asset()
+	if err != nil {
+		return data, err
+	}
+	data.Items = append(data.Items, api.AssetItem{
+		Kind: "AssetItem",
+		Meta: api.NodeMeta{
+			Node: hostname.Hostname(),
+		},
+		Data: d,
+	})
+	return data, nil
+}
+
+func (t *CmdNodeAsset) Run() error {
+	var (
+		data api.AssetList
+		err  error
+	)
+	if t.Local || t.NodeSelector == "" {
+		data, err = t.local()
+	} else {
+		data, err = t.remote()
+	}
+	if err != nil {
+		return err
+	}
+	output.Renderer{
+		Output: t.Output,
+		Color:  t.Color,
+		Data:   data,
+		HumanRenderer: func() string {
+			l := make([]string, len(data.Items))
+			for i, item := range data.Items {
+				l[i] = item.Data.Render()
+			}
+			return strings.Join(l, "\n")
+		},
+		Colorize: rawconfig.Colorize,
+	}.Print()
+	return nil
+}
@@ -0,0 +1,30 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/opensvc/om3/core/object"
+)
+
+type (
+	CmdNodeCertRenew struct{}
+)
+
+// Run renews the listener certificate, signed by the current cluster CA.
+// The daemons install the new certificate when they receive the updated
+// sec object through the config sync, without restarting their listeners.
+func (t *CmdNodeCertRenew) Run() error {
+	if !clusterCertPath.Exists() {
+		return fmt.Errorf("%s does not exist", clusterCertPath)
+	}
+	cert, err := object.NewSec(clusterCertPath, object.WithVolatile(false))
+	if err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(os.Stdout, "Renew %s certificate\n", clusterCertPath)
+	if err := cert.GenCert(); err != nil {
+		return fmt.Errorf("renew %s: %w", clusterCertPath, err)
+	}
+	return nil
+}
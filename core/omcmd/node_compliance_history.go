@@ -0,0 +1,34 @@
+package commands
+
+import (
+	"github.com/opensvc/om3/core/nodeaction"
+	"github.com/opensvc/om3/core/object"
+)
+
+type (
+	CmdNodeComplianceHistory struct {
+		OptsGlobal
+		NodeSelector string
+		Last         int
+	}
+)
+
+func (t *CmdNodeComplianceHistory) Run() error {
+	return nodeaction.New(
+		nodeaction.WithLocal(t.Local),
+		nodeaction.WithFormat(t.Output),
+		nodeaction.WithColor(t.Color),
+		nodeaction.WithServer(t.Server),
+		nodeaction.WithLocalFunc(func() (interface{}, error) {
+			n, err := object.NewNode()
+			if err != nil {
+				return nil, err
+			}
+			comp, err := n.NewCompliance()
+			if err != nil {
+				return nil, err
+			}
+			return comp.History(t.Last)
+		}),
+	).Do()
+}
@@ -0,0 +1,152 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/nodeselector"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/output"
+	"github.com/opensvc/om3/core/rawconfig"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/util/hostname"
+	"github.com/opensvc/om3/util/sysreport"
+)
+
+type (
+	CmdNodeSysreportDiff struct {
+		OptsGlobal
+		NodeSelector string
+		Rev          string
+		Since        string
+	}
+)
+
+func (t *CmdNodeSysreportDiff) since() (time.Time, error) {
+	if t.Since == "" {
+		return time.Time{}, nil
+	}
+	return sysreport.ParseSince(t.Since)
+}
+
+func (t *CmdNodeSysreportDiff) extract(c *client.T, nodename string, since time.Time) (api.SysreportChangeList, error) {
+	params := api.GetNodeSysreportDiffParams{}
+	if t.Rev != "" {
+		params.Rev = &t.Rev
+	}
+	if !since.IsZero() {
+		params.Since = &since
+	}
+	resp, err := c.GetNodeSysreportDiffWithResponse(context.Background(), nodename, &params)
+	if err != nil {
+		return api.SysreportChangeList{}, err
+	}
+	switch resp.StatusCode() {
+	case 200:
+		return *resp.JSON200, nil
+	case 400:
+		return api.SysreportChangeList{}, fmt.Errorf("%s: %s", nodename, *resp.JSON400)
+	case 401:
+		return api.SysreportChangeList{}, fmt.Errorf("%s: %s", nodename, *resp.JSON401)
+	case 403:
+		return api.SysreportChangeList{}, fmt.Errorf("%s: %s", nodename, *resp.JSON403)
+	case 404:
+		return api.SysreportChangeList{}, fmt.Errorf("%s: %s", nodename, *resp.JSON404)
+	default:
+		return api.SysreportChangeList{}, fmt.Errorf("%s: unexpected statuscode: %s", nodename, resp.Status())
+	}
+}
+
+func (t *CmdNodeSysreportDiff) remote(since time.Time) (api.SysreportChangeList, error) {
+	var (
+		errs error
+		data api.SysreportChangeList
+	)
+	c, err := client.New(client.WithURL(t.Server))
+	if err != nil {
+		return data, err
+	}
+	nodenames, err := nodeselector.New(t.NodeSelector, nodeselector.WithClient(c)).Expand()
+	if err != nil {
+		return data, err
+	}
+	for i, nodename := range nodenames {
+		if d, err := t.extract(c, nodename, since); err != nil {
+			errs = errors.Join(errs, err)
+		} else if i == 0 {
+			data = d
+		} else {
+			data.Items = append(data.Items, d.Items...)
+		}
+	}
+	return data, errs
+}
+
+func (t *CmdNodeSysreportDiff) local(since time.Time) (api.SysreportChangeList, error) {
+	data := api.SysreportChangeList{
+		Kind:  "SysreportChangeList",
+		Items: make(api.SysreportChangeItems, 0),
+	}
+	n, err := object.NewNode()
+	if err != nil {
+		return data, err
+	}
+	changes, err := n.SysreportDiff(t.Rev, since)
+	if err != nil {
+		return data, err
+	}
+	localhost := hostname.Hostname()
+	for _, e := range changes {
+		data.Items = append(data.Items, api.SysreportChangeItem{
+			Kind: "SysreportChangeItem",
+			Meta: api.NodeMeta{
+				Node: localhost,
+			},
+			Data: api.SysreportChange{
+				Action: e.Action,
+				Diff:   e.Diff,
+				From:   e.From,
+				Name:   e.Name,
+				To:     e.To,
+				Type:   e.Type,
+			},
+		})
+	}
+	return data, nil
+}
+
+func (t *CmdNodeSysreportDiff) Run() error {
+	if t.Rev != "" && t.Since != "" {
+		return fmt.Errorf("--rev and --since are mutually exclusive")
+	}
+	since, err := t.since()
+	if err != nil {
+		return err
+	}
+	var data api.SysreportChangeList
+	if t.Local || t.NodeSelector == "" {
+		data, err = t.local(since)
+	} else {
+		data, err = t.remote(since)
+	}
+	output.Renderer{
+		Output: t.Output,
+		Color:  t.Color,
+		Data:   data,
+		HumanRenderer: func() string {
+			var s string
+			for _, e := range data.Items {
+				if t.NodeSelector != "" {
+					s += fmt.Sprintf("# %s: %s %s %s\n", e.Meta.Node, e.Data.Type, e.Data.Name, e.Data.Action)
+				}
+				s += e.Data.Diff
+			}
+			return s
+		},
+		Colorize: rawconfig.Colorize,
+	}.Print()
+	return err
+}
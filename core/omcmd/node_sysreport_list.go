@@ -0,0 +1,116 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/nodeselector"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/output"
+	"github.com/opensvc/om3/core/rawconfig"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/util/hostname"
+)
+
+type (
+	CmdNodeSysreportList struct {
+		OptsGlobal
+		NodeSelector string
+	}
+)
+
+func (t *CmdNodeSysreportList) extract(c *client.T, nodename string) (api.SysreportSnapshotList, error) {
+	resp, err := c.GetNodeSysreportSnapshotsWithResponse(context.Background(), nodename)
+	if err != nil {
+		return api.SysreportSnapshotList{}, err
+	}
+	switch resp.StatusCode() {
+	case 200:
+		return *resp.JSON200, nil
+	case 401:
+		return api.SysreportSnapshotList{}, fmt.Errorf("%s: %s", nodename, *resp.JSON401)
+	case 403:
+		return api.SysreportSnapshotList{}, fmt.Errorf("%s: %s", nodename, *resp.JSON403)
+	default:
+		return api.SysreportSnapshotList{}, fmt.Errorf("%s: unexpected statuscode: %s", nodename, resp.Status())
+	}
+}
+
+func (t *CmdNodeSysreportList) remote() (api.SysreportSnapshotList, error) {
+	var (
+		errs error
+		data api.SysreportSnapshotList
+	)
+	c, err := client.New(client.WithURL(t.Server))
+	if err != nil {
+		return data, err
+	}
+	nodenames, err := nodeselector.New(t.NodeSelector, nodeselector.WithClient(c)).Expand()
+	if err != nil {
+		return data, err
+	}
+	for i, nodename := range nodenames {
+		if d, err := t.extract(c, nodename); err != nil {
+			errs = errors.Join(errs, err)
+		} else if i == 0 {
+			data = d
+		} else {
+			data.Items = append(data.Items, d.Items...)
+		}
+	}
+	return data, errs
+}
+
+func (t *CmdNodeSysreportList) local() (api.SysreportSnapshotList, error) {
+	data := api.SysreportSnapshotList{
+		Kind:  "SysreportSnapshotList",
+		Items: make(api.SysreportSnapshotItems, 0),
+	}
+	n, err := object.NewNode()
+	if err != nil {
+		return data, err
+	}
+	l, err := n.SysreportSnapshots()
+	if err != nil {
+		return data, err
+	}
+	localhost := hostname.Hostname()
+	for _, e := range l {
+		data.Items = append(data.Items, api.SysreportSnapshotItem{
+			Kind: "SysreportSnapshotItem",
+			Meta: api.NodeMeta{
+				Node: localhost,
+			},
+			Data: api.SysreportSnapshot{
+				ID:        e.ID,
+				Commands:  len(e.Commands),
+				CreatedAt: e.CreatedAt,
+				Csum:      e.Checksum,
+				Files:     len(e.Files),
+			},
+		})
+	}
+	return data, nil
+}
+
+func (t *CmdNodeSysreportList) Run() error {
+	var (
+		data api.SysreportSnapshotList
+		err  error
+	)
+	if t.Local || t.NodeSelector == "" {
+		data, err = t.local()
+	} else {
+		data, err = t.remote()
+	}
+	output.Renderer{
+		DefaultOutput: "tab=NODE:meta.node,ID:data.id,CREATED_AT:data.created_at,FILES:data.files,COMMANDS:data.commands",
+		Output:        t.Output,
+		Color:         t.Color,
+		Data:          data,
+		Colorize:      rawconfig.Colorize,
+	}.Print()
+	return err
+}
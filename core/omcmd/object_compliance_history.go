@@ -0,0 +1,39 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/objectaction"
+)
+
+type (
+	CmdObjectComplianceHistory struct {
+		OptsGlobal
+		Last int
+	}
+)
+
+func (t *CmdObjectComplianceHistory) Run(selector, kind string) error {
+	mergedSelector := mergeSelector(selector, t.ObjectSelector, kind, "")
+	return objectaction.New(
+		objectaction.LocalFirst(),
+		objectaction.WithLocal(t.Local),
+		objectaction.WithColor(t.Color),
+		objectaction.WithOutput(t.Output),
+		objectaction.WithObjectSelector(mergedSelector),
+		objectaction.WithServer(t.Server),
+		objectaction.WithLocalFunc(func(ctx context.Context, p naming.Path) (interface{}, error) {
+			if o, err := object.NewSvc(p); err != nil {
+				return nil, err
+			} else {
+				comp, err := o.NewCompliance()
+				if err != nil {
+					return nil, err
+				}
+				return comp.History(t.Last)
+			}
+		}),
+	).Do()
+}
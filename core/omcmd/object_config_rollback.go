@@ -0,0 +1,88 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/clientcontext"
+	"github.com/opensvc/om3/core/confighistory"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/objectselector"
+	"github.com/opensvc/om3/daemon/api"
+)
+
+type (
+	CmdObjectConfigRollback struct {
+		OptsGlobal
+		Rev string
+	}
+)
+
+func (t *CmdObjectConfigRollback) doLocal(p naming.Path) error {
+	_, b, err := confighistory.New(p).Data(t.Rev)
+	if err != nil {
+		return err
+	}
+	o, err := object.New(p, object.WithConfigData(b))
+	if err != nil {
+		return err
+	}
+	configurer, ok := o.(object.Configurer)
+	if !ok {
+		return fmt.Errorf("%s is not a configurer", o)
+	}
+	if report, err := configurer.ValidateConfig(context.Background()); err != nil {
+		return fmt.Errorf("%w: %s", err, report)
+	}
+	return configurer.Config().RecommitInvalid()
+}
+
+func (t *CmdObjectConfigRollback) doRemote(p naming.Path, c *client.T) error {
+	params := api.PostObjectConfigRollbackParams{
+		Rev: t.Rev,
+	}
+	resp, err := c.PostObjectConfigRollbackWithResponse(context.Background(), p.Namespace, p.Kind, p.Name, &params)
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode() {
+	case http.StatusNoContent:
+		return nil
+	default:
+		return fmt.Errorf("rollback object %s config on %s: %s", p, c.URL(), resp.Status()+string(resp.Body))
+	}
+}
+
+func (t *CmdObjectConfigRollback) Run(selector, kind string) error {
+	mergedSelector := mergeSelector(selector, t.ObjectSelector, kind, "")
+	c, err := client.New(client.WithURL(t.Server))
+	if err != nil {
+		return err
+	}
+	paths, err := objectselector.New(
+		mergedSelector,
+		objectselector.WithClient(c),
+	).MustExpand()
+	if err != nil {
+		return err
+	}
+	wc := clientcontext.IsSet()
+	for _, p := range paths {
+		if !confighistory.IsSupported(p.Kind) {
+			return fmt.Errorf("%s: %s objects have no configuration history", p, p.Kind)
+		}
+		if (t.Local || !wc) && p.Exists() {
+			if err := t.doLocal(p); err != nil {
+				return fmt.Errorf("%s: %w", p, err)
+			}
+			continue
+		}
+		if err := t.doRemote(p, c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
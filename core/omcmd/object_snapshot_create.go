@@ -0,0 +1,76 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/clientcontext"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/objectselector"
+	"github.com/opensvc/om3/daemon/api"
+)
+
+type (
+	CmdObjectSnapshotCreate struct {
+		OptsGlobal
+		Name string
+	}
+)
+
+func (t *CmdObjectSnapshotCreate) doLocal(p naming.Path) error {
+	o, err := object.NewVol(p)
+	if err != nil {
+		return err
+	}
+	return o.CreateSnapshot(t.Name)
+}
+
+func (t *CmdObjectSnapshotCreate) doRemote(p naming.Path, c *client.T) error {
+	params := api.PostVolumeSnapshotParams{
+		Name: t.Name,
+	}
+	resp, err := c.PostVolumeSnapshotWithResponse(context.Background(), p.Namespace, p.Kind, p.Name, &params)
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode() {
+	case http.StatusNoContent:
+		return nil
+	default:
+		return fmt.Errorf("create volume %s snapshot %s on %s: %s", p, t.Name, c.URL(), resp.Status()+string(resp.Body))
+	}
+}
+
+func (t *CmdObjectSnapshotCreate) Run(selector, kind string) error {
+	mergedSelector := mergeSelector(selector, t.ObjectSelector, kind, "")
+	c, err := client.New(client.WithURL(t.Server))
+	if err != nil {
+		return err
+	}
+	paths, err := objectselector.New(
+		mergedSelector,
+		objectselector.WithClient(c),
+	).MustExpand()
+	if err != nil {
+		return err
+	}
+	wc := clientcontext.IsSet()
+	for _, p := range paths {
+		if p.Kind != naming.KindVol {
+			return fmt.Errorf("%s: %s objects have no snapshots", p, p.Kind)
+		}
+		if (t.Local || !wc) && p.Exists() {
+			if err := t.doLocal(p); err != nil {
+				return fmt.Errorf("%s: %w", p, err)
+			}
+			continue
+		}
+		if err := t.doRemote(p, c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
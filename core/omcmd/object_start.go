@@ -23,6 +23,7 @@ type (
 		Force           bool
 		DisableRollback bool
 		NodeSelector    string
+		Plan            bool
 	}
 )
 
@@ -40,6 +41,7 @@ func (t *CmdObjectStart) Run(selector, kind string) error {
 		objectaction.WithAsyncTime(t.Time),
 		objectaction.WithAsyncWait(t.Wait),
 		objectaction.WithAsyncWatch(t.Watch),
+		objectaction.WithAsyncDryRun(t.Plan),
 		objectaction.WithProgress(!t.Quiet && t.Log == ""),
 		objectaction.WithRemoteNodes(t.NodeSelector),
 		objectaction.WithRemoteFunc(func(ctx context.Context, p naming.Path, nodename string) (interface{}, error) {
@@ -22,6 +22,7 @@ type (
 		OptTo
 		Force        bool
 		NodeSelector string
+		Plan         bool
 	}
 )
 
@@ -39,6 +40,7 @@ func (t *CmdObjectStop) Run(selector, kind string) error {
 		objectaction.WithAsyncTime(t.Time),
 		objectaction.WithAsyncWait(t.Wait),
 		objectaction.WithAsyncWatch(t.Watch),
+		objectaction.WithAsyncDryRun(t.Plan),
 		objectaction.WithProgress(!t.Quiet && t.Log == ""),
 		objectaction.WithRemoteNodes(t.NodeSelector),
 		objectaction.WithRemoteFunc(func(ctx context.Context, p naming.Path, nodename string) (interface{}, error) {
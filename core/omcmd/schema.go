@@ -0,0 +1,27 @@
+package commands
+
+import (
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/output"
+	"github.com/opensvc/om3/core/rawconfig"
+)
+
+type (
+	CmdSchema struct {
+		OptsGlobal
+	}
+)
+
+func (t *CmdSchema) Run(name string) error {
+	schema, err := object.Schema(name)
+	if err != nil {
+		return err
+	}
+	output.Renderer{
+		Output:   t.Output,
+		Color:    t.Color,
+		Data:     schema,
+		Colorize: rawconfig.Colorize,
+	}.Print()
+	return nil
+}
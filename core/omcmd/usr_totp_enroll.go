@@ -0,0 +1,47 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/objectaction"
+)
+
+type (
+	CmdUsrTOTPEnroll struct {
+		OptsGlobal
+		Sec string
+	}
+)
+
+func (t *CmdUsrTOTPEnroll) Run(selector, kind string) error {
+	var secPath naming.Path
+	if t.Sec != "" {
+		p, err := naming.ParsePath(t.Sec)
+		if err != nil {
+			return err
+		}
+		secPath = p
+	}
+	mergedSelector := mergeSelector(selector, t.ObjectSelector, kind, "")
+	return objectaction.New(
+		objectaction.LocalFirst(),
+		objectaction.WithLocal(t.Local),
+		objectaction.WithColor(t.Color),
+		objectaction.WithOutput(t.Output),
+		objectaction.WithObjectSelector(mergedSelector),
+		objectaction.WithLocalFunc(func(ctx context.Context, p naming.Path) (interface{}, error) {
+			o, err := object.New(p)
+			if err != nil {
+				return nil, err
+			}
+			user, ok := o.(object.Usr)
+			if !ok {
+				return nil, fmt.Errorf("%s is not a usr", o)
+			}
+			return user.EnrollTOTP(secPath)
+		}),
+	).Do()
+}
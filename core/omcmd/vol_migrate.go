@@ -0,0 +1,131 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/objectselector"
+)
+
+type (
+	CmdVolMigrate struct {
+		OptsGlobal
+		Pool string
+		Step string
+		Time time.Duration
+	}
+)
+
+// quiesce stops the <paths> objects through the orchestration and waits
+// for them to reach the stopped state.
+func (t *CmdVolMigrate) quiesce(paths naming.Paths) error {
+	cmd := CmdObjectStop{
+		OptsGlobal: OptsGlobal{Color: t.Color, Output: t.Output, Server: t.Server, Quiet: t.Quiet, Log: t.Log},
+		OptsAsync:  OptsAsync{Wait: true, Time: t.Time},
+	}
+	return cmd.Run(paths.String(), "")
+}
+
+// resume starts the <paths> objects through the orchestration and waits
+// for them to reach the started state.
+func (t *CmdVolMigrate) resume(paths naming.Paths) error {
+	cmd := CmdObjectStart{
+		OptsGlobal: OptsGlobal{Color: t.Color, Output: t.Output, Server: t.Server, Quiet: t.Quiet, Log: t.Log},
+		OptsAsync:  OptsAsync{Wait: true, Time: t.Time},
+	}
+	return cmd.Run(paths.String(), "")
+}
+
+func (t *CmdVolMigrate) migrate(p naming.Path) error {
+	ctx := context.Background()
+	o, err := object.NewVol(p)
+	if err != nil {
+		return err
+	}
+	if err := o.MigratePrepare(ctx, t.Pool); err != nil {
+		return err
+	}
+	holders := o.HoldersExcept(ctx, naming.Path{})
+	if len(holders) > 0 {
+		if err := t.quiesce(holders); err != nil {
+			return fmt.Errorf("stop %s: %w", holders, err)
+		}
+	}
+
+	// the volume status changed with its consumers stop. Allocate a new
+	// object to drop the cached status.
+	o, err = object.NewVol(p)
+	if err != nil {
+		return err
+	}
+	errs := o.MigrateCommit(ctx)
+	if len(holders) > 0 {
+		if err := t.resume(holders); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("start %s: %w", holders, err))
+		}
+	}
+	return errs
+}
+
+// migrateStep runs only the <t.Step> migration step on the local instance.
+// The daemon api runs the steps this way, in the background.
+func (t *CmdVolMigrate) migrateStep(p naming.Path) error {
+	ctx := context.Background()
+	o, err := object.NewVol(p)
+	if err != nil {
+		return err
+	}
+	switch t.Step {
+	case "prepare":
+		return o.MigratePrepare(ctx, t.Pool)
+	default:
+		return o.MigrateCommit(ctx)
+	}
+}
+
+func (t *CmdVolMigrate) Run(selector, kind string) error {
+	switch {
+	case t.Step != "" && !t.Local:
+		return fmt.Errorf("--step requires --local")
+	case t.Local && t.Step != "prepare" && t.Step != "commit":
+		return fmt.Errorf("--local requires --step prepare or --step commit")
+	case t.Pool == "" && t.Step != "commit":
+		return fmt.Errorf("no target pool, use --pool")
+	}
+	mergedSelector := mergeSelector(selector, t.ObjectSelector, kind, "")
+	c, err := client.New(client.WithURL(t.Server))
+	if err != nil {
+		return err
+	}
+	paths, err := objectselector.New(
+		mergedSelector,
+		objectselector.WithClient(c),
+	).MustExpand()
+	if err != nil {
+		return err
+	}
+	for _, p := range paths {
+		if p.Kind != naming.KindVol {
+			return fmt.Errorf("%s: %s objects can not be migrated", p, p.Kind)
+		}
+		if !p.Exists() {
+			return fmt.Errorf("%s: no local instance, migrate from a node of the volume", p)
+		}
+		if t.Local {
+			if err := t.migrateStep(p); err != nil {
+				return fmt.Errorf("%s: migrate %s: %w", p, t.Step, err)
+			}
+			continue
+		}
+		if err := t.migrate(p); err != nil {
+			return fmt.Errorf("%s: %w", p, err)
+		}
+		fmt.Printf("%s: migrated to the pool %s\n", p, t.Pool)
+	}
+	return nil
+}
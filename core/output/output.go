@@ -21,6 +21,10 @@ const (
 	CSV
 	// YAML is the standard human readable, commentable, complex data representation
 	YAML
+	// JUnit is the JUnit XML test report format
+	JUnit
+	// SARIF is the static analysis results interchange format
+	SARIF
 )
 
 var toString = map[T]string{
@@ -32,6 +36,8 @@ var toString = map[T]string{
 	Table:    "table",
 	CSV:      "csv",
 	YAML:     "yaml",
+	JUnit:    "junit",
+	SARIF:    "sarif",
 }
 
 var toID = map[string]T{
@@ -44,6 +50,8 @@ var toID = map[string]T{
 	"table":     Table,
 	"csv":       CSV,
 	"yaml":      YAML,
+	"junit":     JUnit,
+	"sarif":     SARIF,
 }
 
 func (t T) String() string {
@@ -2,9 +2,11 @@ package output
 
 import (
 	"bytes"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"os"
+	"reflect"
 	"regexp"
 	"strings"
 
@@ -13,6 +15,7 @@ import (
 	tabwriter "github.com/juju/ansiterm"
 	"github.com/opensvc/om3/util/render"
 	"github.com/opensvc/om3/util/render/palette"
+	"github.com/opensvc/om3/util/testreport"
 	"github.com/opensvc/om3/util/unstructured"
 	"k8s.io/client-go/util/jsonpath"
 	"sigs.k8s.io/yaml"
@@ -41,6 +44,11 @@ type (
 	getItemser interface {
 		GetItems() any
 	}
+
+	csvRecorder interface {
+		CSVHeader() []string
+		CSVRecords() [][]string
+	}
 )
 
 var (
@@ -139,6 +147,38 @@ func (t Renderer) Sprint() (string, error) {
 			}
 			return s + sep, nil
 		}
+	case JUnit, SARIF:
+		suites, ok := testSuites(t.Data)
+		if !ok {
+			return "", fmt.Errorf("the %s output format is not supported by this command", format)
+		}
+		var (
+			b   []byte
+			err error
+		)
+		if formatID == JUnit {
+			b, err = testreport.JUnit(suites)
+		} else {
+			b, err = testreport.SARIF(suites)
+		}
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	case CSV:
+		r, ok := t.Data.(csvRecorder)
+		if !ok {
+			return "", fmt.Errorf("the %s output format is not supported by this command", format)
+		}
+		var buf bytes.Buffer
+		w := csv.NewWriter(&buf)
+		if err := w.Write(r.CSVHeader()); err != nil {
+			return "", err
+		}
+		if err := w.WriteAll(r.CSVRecords()); err != nil {
+			return "", err
+		}
+		return buf.String(), nil
 	case Tab:
 		s, err := t.renderTab(options)
 		if err != nil {
@@ -160,6 +200,29 @@ func (t Renderer) Sprint() (string, error) {
 	}
 }
 
+// testSuites returns the test suites of the data, if the data or all the
+// elements of the data slice implement testreport.Suiter. The elements
+// wrapping data not renderable as test suites, like the action results,
+// return no suite.
+func testSuites(data any) ([]testreport.Suite, bool) {
+	if i, ok := data.(testreport.Suiter); ok {
+		return i.TestSuites(), true
+	}
+	v := reflect.ValueOf(data)
+	if v.Kind() != reflect.Slice {
+		return nil, false
+	}
+	suites := make([]testreport.Suite, 0)
+	for i := 0; i < v.Len(); i++ {
+		e, ok := v.Index(i).Interface().(testreport.Suiter)
+		if !ok {
+			return nil, false
+		}
+		suites = append(suites, e.TestSuites()...)
+	}
+	return suites, len(suites) > 0
+}
+
 var jsonRegexp = regexp.MustCompile(`^\{\.?([^{}]+)\}$|^\.?([^{}]+)$`)
 
 // RelaxedJSONPathExpression attempts to be flexible with JSONPath expressions, it accepts:
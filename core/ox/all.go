@@ -108,6 +108,7 @@ func init() {
 		cmdObjectComplianceShow,
 		cmdObjectComplianceList,
 		newCmdObjectComplianceEnv(kind),
+		newCmdObjectComplianceHistory(kind),
 		newCmdObjectComplianceAuto(kind),
 		newCmdObjectComplianceCheck(kind),
 		newCmdObjectComplianceFix(kind),
@@ -0,0 +1,5 @@
+package ox
+
+func init() {
+	root.AddCommand(newCmdApply())
+}
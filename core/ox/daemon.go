@@ -19,6 +19,11 @@ var (
 		Use:   "relay",
 		Short: "relay subsystem commands",
 	}
+
+	cmdDaemonToken = &cobra.Command{
+		Use:   "token",
+		Short: "api tokens commands",
+	}
 )
 
 func init() {
@@ -35,6 +40,7 @@ func init() {
 		newCmdDaemonStats(),
 		newCmdDaemonStatus(),
 		newCmdDaemonStop(),
+		cmdDaemonToken,
 	)
 	cmdDaemonDNS.AddCommand(
 		newCmdDaemonDNSDump(),
@@ -42,4 +48,8 @@ func init() {
 	cmdDaemonRelay.AddCommand(
 		newCmdDaemonRelayStatus(),
 	)
+	cmdDaemonToken.AddCommand(
+		newCmdDaemonTokenLs(),
+		newCmdDaemonTokenRevoke(),
+	)
 }
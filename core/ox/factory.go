@@ -9,6 +9,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/opensvc/om3/core/monitor"
+	"github.com/opensvc/om3/core/object"
 	commands "github.com/opensvc/om3/core/oxcmd"
 )
 
@@ -25,6 +26,29 @@ func newCmdAll() *cobra.Command {
 	}
 }
 
+func newCmdApply() *cobra.Command {
+	var options commands.CmdApply
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "create, update or delete objects to match definitions files",
+		Long: "Create, update or delete objects to match definitions files.\n\n" +
+			"The plan is printed, and all the configurations are validated before any change is committed." +
+			" The applied objects are labeled with the --managed-by value, so --prune can delete the objects" +
+			" no longer defined without touching the objects managed by other means.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run()
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	addFlagApplyFiles(flags, &options.Files)
+	addFlagApplyManagedBy(flags, &options.ManagedBy)
+	addFlagApplyPrune(flags, &options.Prune)
+	addFlagDryRun(flags, &options.DryRun)
+	cmd.MarkFlagRequired("file")
+	return cmd
+}
+
 func newCmdCcfg() *cobra.Command {
 	return &cobra.Command{
 		Use:   "ccfg",
@@ -112,6 +136,54 @@ A volume can host cfg and sec keys projections.`,
 	}
 }
 
+func newCmdVolClone() *cobra.Command {
+	var options commands.CmdVolClone
+	cmd := &cobra.Command{
+		Use:   "clone [<src>] <dst>",
+		Short: "create a volume initialized with the data of another volume",
+		Long:  "Create the <dst> vol object, backed by a new volume of the <src> volume pool, initialized with the <src> volume data. The <src> volume can also be selected with --service.",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			switch len(args) {
+			case 2:
+				options.Source = args[0]
+				options.Target = args[1]
+			default:
+				options.Source = selectorFlag
+				if options.ObjectSelector != "" {
+					options.Source = options.ObjectSelector
+				}
+				options.Target = args[0]
+			}
+			return options.Run()
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	return cmd
+}
+
+func newCmdVolMigrate(kind string) *cobra.Command {
+	var options commands.CmdVolMigrate
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "move the volume data to a new volume of another pool",
+		Long: "Provision a volume with the same size and format in the target pool, copy the data while the volume is in use, " +
+			"stop the local consumers through the orchestration, copy the last changes, switch the vol object to the new volume, " +
+			"unprovision the old volume and start the consumers again. Between zpools, the data is copied with zfs send, and the last changes with an incremental send. " +
+			"Shared volumes must then be provisioned with --local on the other nodes.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(selectorFlag, kind)
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	addFlagTargetPool(flags, &options.Pool)
+	addFlagTime(flags, &options.Time)
+	cmd.MarkFlagRequired("pool")
+	return cmd
+}
+
 func newCmdUsr() *cobra.Command {
 	return &cobra.Command{
 		Use:   "usr",
@@ -228,7 +300,10 @@ func newCmdDaemonAuth() *cobra.Command {
 	addFlagsGlobal(flags, &options.OptsGlobal)
 	addFlagRoles(flags, &options.Roles)
 	flags.DurationVar(&options.Duration, "duration", 60*time.Second, "token duration.")
-	flags.StringSliceVar(&options.Out, "out", []string{"token"}, "the fields to display: [token,expired_at]")
+	flags.StringSliceVar(&options.Out, "out", []string{"token"}, "the fields to display: [token,expired_at,token_id,refresh_token,refresh_token_id,refresh_expired_at]")
+	flags.StringVar(&options.TOTP, "totp", "", "the current totp code, prompted if required and not set.")
+	flags.BoolVar(&options.Refresh, "refresh", false, "also create a refresh token, to use with POST /auth/refresh.")
+	flags.DurationVar(&options.RefreshDuration, "refresh-duration", 30*24*time.Hour, "refresh token duration.")
 	return cmd
 }
 
@@ -321,6 +396,7 @@ func newCmdDaemonShutdown() *cobra.Command {
 	addFlagsGlobal(flags, &options.OptsGlobal)
 	addFlagDuration(flags, &options.Timeout)
 	addFlagNodeSelector(flags, &options.NodeSelector)
+	addFlagPlan(flags, &options.Plan)
 	return cmd
 }
 
@@ -372,6 +448,36 @@ func newCmdDaemonStop() *cobra.Command {
 	return cmd
 }
 
+func newCmdDaemonTokenLs() *cobra.Command {
+	var options commands.CmdDaemonTokenLs
+	cmd := &cobra.Command{
+		Use:   "ls",
+		Short: "list the api tokens issued by the daemons",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run()
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	return cmd
+}
+
+func newCmdDaemonTokenRevoke() *cobra.Command {
+	var options commands.CmdDaemonTokenRevoke
+	cmd := &cobra.Command{
+		Use:   "revoke",
+		Short: "revoke an api token, and the tokens issued with it if it is a refresh token",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run()
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	flags.StringVar(&options.ID, "id", "", "the id of the token to revoke.")
+	cmd.MarkFlagRequired("id")
+	return cmd
+}
+
 func newCmdKeystoreAdd(kind string) *cobra.Command {
 	var options commands.CmdKeystoreAdd
 	cmd := &cobra.Command{
@@ -550,6 +656,22 @@ func newCmdNodeAbort() *cobra.Command {
 	return cmd
 }
 
+func newCmdNodeAsset() *cobra.Command {
+	var options commands.CmdNodeAsset
+	cmd := &cobra.Command{
+		Use:     "asset",
+		Short:   "run the node discovery and print the asset inventory",
+		Aliases: []string{"asse", "ass", "as"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run()
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	addFlagNodeSelector(flags, &options.NodeSelector)
+	return cmd
+}
+
 func newCmdNodeCapabilitiesList() *cobra.Command {
 	var options commands.CmdNodeCapabilitiesList
 	cmd := &cobra.Command{
@@ -884,6 +1006,22 @@ func newCmdNodeComplianceEnv() *cobra.Command {
 	return cmd
 }
 
+func newCmdNodeComplianceHistory() *cobra.Command {
+	var options commands.CmdNodeComplianceHistory
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "show the last compliance runs recorded on this node",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run()
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	addFlagNodeSelector(flags, &options.NodeSelector)
+	flags.IntVar(&options.Last, "last", 10, "the number of runs to show, all if 0.")
+	return cmd
+}
+
 func newCmdNodeComplianceListModules() *cobra.Command {
 	var options commands.CmdNodeComplianceListModules
 	cmd := &cobra.Command{
@@ -981,6 +1119,7 @@ func newCmdNodeDrain() *cobra.Command {
 	addFlagsGlobal(flags, &options.OptsGlobal)
 	addFlagsAsync(flags, &options.OptsAsync)
 	addFlagNodeSelector(flags, &options.NodeSelector)
+	addFlagPlan(flags, &options.Plan)
 	return cmd
 }
 
@@ -1336,7 +1475,7 @@ func newCmdNodeSysreport() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "sysreport",
 		Short:   "collect system data and push it to the collector",
-		Long:    "Push system report to the collector for archiving and diff analysis. The --force option resend all monitored files and outputs to the collector instead of only those that changed since the last sysreport.",
+		Long:    "Store a local snapshot of the monitored files and command outputs, and push the system report to the collector for archiving and diff analysis if a collector is configured. The --force option resend all monitored files and outputs to the collector instead of only those that changed since the last sysreport.",
 		Aliases: []string{"sysrepor", "sysrepo", "sysrep", "sysre", "sysr", "sys", "sy"},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return options.Run()
@@ -1349,6 +1488,40 @@ func newCmdNodeSysreport() *cobra.Command {
 	return cmd
 }
 
+func newCmdNodeSysreportDiff() *cobra.Command {
+	var options commands.CmdNodeSysreportDiff
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "show the changes between local sysreport snapshots",
+		Long:  "Show the file and command output changes between two local sysreport snapshots. Without --rev nor --since, the two latest snapshots are compared.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run()
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	addFlagNodeSelector(flags, &options.NodeSelector)
+	addFlagSysreportRev(flags, &options.Rev)
+	addFlagSince(flags, &options.Since)
+	return cmd
+}
+
+func newCmdNodeSysreportList() *cobra.Command {
+	var options commands.CmdNodeSysreportList
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "list the local sysreport snapshots",
+		Aliases: []string{"lis", "li", "ls", "l"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run()
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	addFlagNodeSelector(flags, &options.NodeSelector)
+	return cmd
+}
+
 func newCmdNodeUnfreeze() *cobra.Command {
 	var options commands.CmdNodeUnfreeze
 	cmd := &cobra.Command{
@@ -1841,6 +2014,21 @@ func newCmdObjectComplianceEnv(kind string) *cobra.Command {
 	return cmd
 }
 
+func newCmdObjectComplianceHistory(kind string) *cobra.Command {
+	var options commands.CmdObjectComplianceHistory
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "show the last compliance runs recorded for this object",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(selectorFlag, kind)
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	flags.IntVar(&options.Last, "last", 10, "the number of runs to show, all if 0.")
+	return cmd
+}
+
 func newCmdObjectComplianceListModules(kind string) *cobra.Command {
 	var options commands.CmdObjectComplianceListModules
 	cmd := &cobra.Command{
@@ -1920,6 +2108,61 @@ func newCmdObjectComplianceShowRuleset(kind string) *cobra.Command {
 	return cmd
 }
 
+func newCmdObjectConfig(kind string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "config",
+		Short: "object configuration history commands",
+	}
+}
+
+func newCmdObjectConfigDiff(kind string) *cobra.Command {
+	var options commands.CmdObjectConfigDiff
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "show the differences between configuration revisions",
+		Long:  "Show the differences between a configuration revision and the installed configuration, or between two configuration revisions with --rev <rev>..<rev>.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(selectorFlag, kind)
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	addFlagRevRange(flags, &options.Rev)
+	cmd.MarkFlagRequired("rev")
+	return cmd
+}
+
+func newCmdObjectConfigHistory(kind string) *cobra.Command {
+	var options commands.CmdObjectConfigHistory
+	cmd := &cobra.Command{
+		Use:     "history",
+		Short:   "list the configuration revisions",
+		Aliases: []string{"hist", "h"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(selectorFlag, kind)
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	return cmd
+}
+
+func newCmdObjectConfigRollback(kind string) *cobra.Command {
+	var options commands.CmdObjectConfigRollback
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "commit a configuration revision as the new configuration",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(selectorFlag, kind)
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	addFlagRev(flags, &options.Rev)
+	cmd.MarkFlagRequired("rev")
+	return cmd
+}
+
 func newCmdObjectCreate(kind string) *cobra.Command {
 	var options commands.CmdObjectCreate
 	cmd := &cobra.Command{
@@ -2046,6 +2289,7 @@ func newCmdObjectGiveback(kind string) *cobra.Command {
 	addFlagsGlobal(flags, &options.OptsGlobal)
 	addFlagsAsync(flags, &options.OptsAsync)
 	addFlagsLock(flags, &options.OptsLock)
+	addFlagPlan(flags, &options.Plan)
 	return cmd
 }
 
@@ -2296,6 +2540,23 @@ func newCmdObjectPushResInfo(kind string) *cobra.Command {
 	return cmd
 }
 
+func newCmdObjectResize(kind string) *cobra.Command {
+	var options commands.CmdObjectResize
+	cmd := &cobra.Command{
+		Use:   "resize",
+		Short: "grow the volume",
+		Long:  "Grow the pool volume, then the disk and filesystem layers, and update the size keywords. The filesystems are grown online, so the volume must be up on the node running the resize. Non-shared volumes must be resized on each node, and drbd volumes on the secondary nodes before the primary node.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(selectorFlag, kind)
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	addFlagResizeSize(flags, &options.Size)
+	cmd.MarkFlagRequired("size")
+	return cmd
+}
+
 func newCmdObjectRestart(kind string) *cobra.Command {
 	var options commands.CmdObjectRestart
 	cmd := &cobra.Command{
@@ -2491,6 +2752,78 @@ func newCmdObjectShutdown(kind string) *cobra.Command {
 	return cmd
 }
 
+func newCmdObjectSnapshot(kind string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "snapshot",
+		Short: "volume snapshot commands",
+	}
+}
+
+func newCmdObjectSnapshotCreate(kind string) *cobra.Command {
+	var options commands.CmdObjectSnapshotCreate
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "take a snapshot of the volume data",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(selectorFlag, kind)
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	addFlagSnapshotName(flags, &options.Name)
+	cmd.MarkFlagRequired("name")
+	return cmd
+}
+
+func newCmdObjectSnapshotDelete(kind string) *cobra.Command {
+	var options commands.CmdObjectSnapshotDelete
+	cmd := &cobra.Command{
+		Use:     "delete",
+		Short:   "delete a volume snapshot",
+		Aliases: []string{"del", "rm"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(selectorFlag, kind)
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	addFlagSnapshotName(flags, &options.Name)
+	cmd.MarkFlagRequired("name")
+	return cmd
+}
+
+func newCmdObjectSnapshotList(kind string) *cobra.Command {
+	var options commands.CmdObjectSnapshotList
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "list the volume snapshots",
+		Aliases: []string{"ls"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(selectorFlag, kind)
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	return cmd
+}
+
+func newCmdObjectSnapshotRollback(kind string) *cobra.Command {
+	var options commands.CmdObjectSnapshotRollback
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "restore the volume data from a snapshot",
+		Long:  "Restore the volume data from a snapshot. The volume must be stopped and not in use by another object.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(selectorFlag, kind)
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	addFlagSnapshotName(flags, &options.Name)
+	cmd.MarkFlagRequired("name")
+	return cmd
+}
+
 func newCmdObjectStart(kind string) *cobra.Command {
 	var options commands.CmdObjectStart
 	cmd := &cobra.Command{
@@ -2509,6 +2842,7 @@ func newCmdObjectStart(kind string) *cobra.Command {
 	addFlagForce(flags, &options.Force)
 	addFlagDisableRollback(flags, &options.DisableRollback)
 	addFlagNodeSelector(flags, &options.NodeSelector)
+	addFlagPlan(flags, &options.Plan)
 	return cmd
 }
 
@@ -2568,6 +2902,7 @@ func newCmdObjectStop(kind string) *cobra.Command {
 	addFlagsTo(flags, &options.OptTo)
 	addFlagForce(flags, &options.Force)
 	addFlagNodeSelector(flags, &options.NodeSelector)
+	addFlagPlan(flags, &options.Plan)
 	return cmd
 }
 
@@ -2586,6 +2921,7 @@ func newCmdObjectSwitch(kind string) *cobra.Command {
 	addFlagsAsync(flags, &options.OptsAsync)
 	addFlagsLock(flags, &options.OptsLock)
 	addFlagSwitchTo(flags, &options.To)
+	addFlagPlan(flags, &options.Plan)
 	return cmd
 }
 
@@ -2745,6 +3081,27 @@ func newCmdPoolVolumeLs() *cobra.Command {
 	return cmd
 }
 
+func newCmdSchema() *cobra.Command {
+	var options commands.CmdSchema
+	cmd := &cobra.Command{
+		Use:   "schema <name>",
+		Short: "print the json schema of a configuration",
+		Long: "Print the json schema of the node configuration, the cluster configuration (ccfg)" +
+			" or the configuration of the objects of a kind.\n\n" +
+			"The schema validates the json and yaml structured configuration documents, with the keywords" +
+			" documentation as properties description, so editors and pipelines can validate the" +
+			" configurations before they are installed.",
+		ValidArgs: object.SchemaNames,
+		Args:      cobra.ExactValidArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run(args[0])
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	return cmd
+}
+
 func newCmdSecGenCert(kind string) *cobra.Command {
 	var options commands.CmdSecGenCert
 	cmd := &cobra.Command{
@@ -10,6 +10,7 @@ import (
 
 	"github.com/spf13/pflag"
 
+	"github.com/opensvc/om3/core/objectapply"
 	commands "github.com/opensvc/om3/core/oxcmd"
 	"github.com/opensvc/om3/daemon/rbac"
 )
@@ -27,7 +28,7 @@ func addFlagsAsync(flagSet *pflag.FlagSet, p *commands.OptsAsync) {
 
 func addFlagsGlobal(flagSet *pflag.FlagSet, p *commands.OptsGlobal) {
 	flagSet.StringVar(&p.Color, "color", "auto", "Output colorization yes|no|auto.")
-	flagSet.StringVarP(&p.Output, "output", "o", "auto", "Output format json|flat|auto|tab=<header>:<jsonpath>,...")
+	flagSet.StringVarP(&p.Output, "output", "o", "auto", "Output format json|flat|auto|junit|sarif|tab=<header>:<jsonpath>,...")
 	flagSet.StringVar(&p.Server, "server", "", "URI of the opensvc api server. scheme https|tls.")
 	flagSet.StringVarP(&p.ObjectSelector, "service", "s", "", "Execute on a list of objects.")
 
@@ -56,6 +57,18 @@ func addFlagsTo(flagSet *pflag.FlagSet, p *commands.OptTo) {
 	addFlagDownTo(flagSet, &p.DownTo)
 }
 
+func addFlagApplyFiles(flagSet *pflag.FlagSet, p *[]string) {
+	flagSet.StringArrayVarP(p, "file", "f", []string{}, "An object definitions file, or a directory walked for object definitions files. The ini, json and yaml formats are supported. Can be set multiple times.")
+}
+
+func addFlagApplyManagedBy(flagSet *pflag.FlagSet, p *string) {
+	flagSet.StringVar(p, "managed-by", objectapply.DefaultManagedBy, "The value of the labels.managed_by keyword set on the applied objects. Only the objects with the same value are pruned.")
+}
+
+func addFlagApplyPrune(flagSet *pflag.FlagSet, p *bool) {
+	flagSet.BoolVar(p, "prune", false, "Delete the objects managed by the same --managed-by value and absent from the definitions.")
+}
+
 func addFlagComplianceAttach(flagSet *pflag.FlagSet, p *bool) {
 	flagSet.BoolVar(p, "attach", false, "Attach the modulesets selected for the compliance run.")
 }
@@ -201,6 +214,10 @@ func addFlagObjectSelector(flagSet *pflag.FlagSet, p *string) {
 	flagSet.StringVarP(p, "service", "s", "", "An object selector expression. `**/s[12]+!*/vol/*`.")
 }
 
+func addFlagPlan(flagSet *pflag.FlagSet, p *bool) {
+	flagSet.BoolVar(p, "plan", false, "Show the orchestration plan without queuing the orchestration.")
+}
+
 func addFlagPoolName(flagSet *pflag.FlagSet, p *string) {
 	flagSet.StringVar(p, "name", "", "Filter on a pool name.")
 }
@@ -225,6 +242,34 @@ func addFlagRelay(flagSet *pflag.FlagSet, p *string) {
 	flagSet.StringVar(p, "relay", "", "The name of the relay to query. If not specified, all known relays are queried.")
 }
 
+func addFlagRev(flagSet *pflag.FlagSet, p *string) {
+	flagSet.StringVar(p, "rev", "", "A configuration revision id, or a unique prefix of a configuration revision id.")
+}
+
+func addFlagRevRange(flagSet *pflag.FlagSet, p *string) {
+	flagSet.StringVar(p, "rev", "", "A configuration revision id to compare to the installed configuration, or a <rev>..<rev> range of revision ids to compare.")
+}
+
+func addFlagTargetPool(flagSet *pflag.FlagSet, p *string) {
+	flagSet.StringVar(p, "pool", "", "The name of the pool to move the volume to.")
+}
+
+func addFlagResizeSize(flagSet *pflag.FlagSet, p *string) {
+	flagSet.StringVar(p, "size", "", "The new volume size, absolute like 20g or relative to the configured size like +5g.")
+}
+
+func addFlagSnapshotName(flagSet *pflag.FlagSet, p *string) {
+	flagSet.StringVar(p, "name", "", "A volume snapshot name.")
+}
+
+func addFlagSince(flagSet *pflag.FlagSet, p *string) {
+	flagSet.StringVar(p, "since", "", "Compare the latest sysreport snapshot to the last snapshot taken before this date. A date like 2006-01-02, 2006-01-02 15:04:05, RFC3339, or a duration like 2d.")
+}
+
+func addFlagSysreportRev(flagSet *pflag.FlagSet, p *string) {
+	flagSet.StringVar(p, "rev", "", "A sysreport snapshot id to compare to the latest snapshot, or a <id>..<id> range of snapshot ids to compare.")
+}
+
 func addFlagRID(flagSet *pflag.FlagSet, p *string) {
 	flagSet.StringVar(p, "rid", "", "Resource selector expression (ip#1,app,disk.type=zvol).")
 }
@@ -62,8 +62,9 @@ var (
 		Use:   "relay",
 		Short: "relay subsystem commands",
 	}
-	cmdNodeEdit     = newCmdNodeEdit()
-	cmdNodeValidate = newCmdNodeValidate()
+	cmdNodeEdit      = newCmdNodeEdit()
+	cmdNodeSysreport = newCmdNodeSysreport()
+	cmdNodeValidate  = newCmdNodeValidate()
 )
 
 func init() {
@@ -93,6 +94,7 @@ func init() {
 		newCmdNodeComplianceCheck(),
 		newCmdNodeComplianceFix(),
 		newCmdNodeComplianceFixable(),
+		newCmdNodeComplianceHistory(),
 	)
 	cmdNodeComplianceAttach.AddCommand(
 		newCmdNodeComplianceAttachModuleset(),
@@ -121,6 +123,7 @@ func init() {
 		cmdNodeRelay,
 		cmdNodeValidate,
 		newCmdNodeAbort(),
+		newCmdNodeAsset(),
 		newCmdNodeChecks(),
 		newCmdNodeClear(),
 		newCmdNodeDrain(),
@@ -134,7 +137,7 @@ func init() {
 		newCmdNodeEval(),
 		newCmdNodeRegister(),
 		newCmdNodeSet(),
-		newCmdNodeSysreport(),
+		cmdNodeSysreport,
 		newCmdNodeUnfreeze(),
 		newCmdNodeUpdate(),
 		newCmdNodeUnset(),
@@ -150,6 +153,10 @@ func init() {
 		newCmdNodePushPatch(),
 		newCmdNodePushPkg(),
 	)
+	cmdNodeSysreport.AddCommand(
+		newCmdNodeSysreportDiff(),
+		newCmdNodeSysreportList(),
+	)
 	cmdNodeValidate.AddCommand(
 		newCmdNodeValidateConfig(),
 	)
@@ -6,9 +6,11 @@ func init() {
 	cmdObject := newCmdVol()
 	cmdObjectCollector := newCmdObjectCollector(kind)
 	cmdObjectCollectorTag := newCmdObjectCollectorTag(kind)
+	cmdObjectConfig := newCmdObjectConfig(kind)
 	cmdObjectEdit := newCmdObjectEdit(kind)
 	cmdObjectInstance := newCmdObjectInstance(kind)
 	cmdObjectSet := newCmdObjectSet(kind)
+	cmdObjectSnapshot := newCmdObjectSnapshot(kind)
 	cmdObjectPrint := newCmdObjectPrint(kind)
 	cmdObjectPrintConfig := newCmdObjectPrintConfig(kind)
 	cmdObjectPush := newCmdObjectPush(kind)
@@ -21,17 +23,21 @@ func init() {
 	)
 	cmdObject.AddCommand(
 		cmdObjectCollector,
+		cmdObjectConfig,
 		cmdObjectEdit,
 		cmdObjectInstance,
 		cmdObjectPrint,
 		cmdObjectPush,
 		cmdObjectResource,
 		cmdObjectSet,
+		cmdObjectSnapshot,
 		cmdObjectSync,
 		cmdObjectValidate,
 		newCmdObjectAbort(kind),
 		newCmdObjectBoot(kind),
 		newCmdObjectClear(kind),
+		newCmdVolClone(),
+		newCmdVolMigrate(kind),
 		newCmdObjectCreate(kind),
 		newCmdObjectDelete(kind),
 		newCmdObjectEval(kind),
@@ -44,6 +50,7 @@ func init() {
 		newCmdObjectMonitor(kind),
 		newCmdObjectPurge(kind),
 		newCmdObjectProvision(kind),
+		newCmdObjectResize(kind),
 		newCmdObjectPRStart(kind),
 		newCmdObjectPRStop(kind),
 		newCmdObjectRestart(kind),
@@ -69,6 +76,11 @@ func init() {
 		newCmdObjectCollectorTagDetach(kind),
 		newCmdObjectCollectorTagShow(kind),
 	)
+	cmdObjectConfig.AddCommand(
+		newCmdObjectConfigDiff(kind),
+		newCmdObjectConfigHistory(kind),
+		newCmdObjectConfigRollback(kind),
+	)
 	cmdObjectEdit.AddCommand(
 		newCmdObjectEditConfig(kind),
 	)
@@ -96,6 +108,12 @@ func init() {
 		newCmdObjectSyncResync(kind),
 		newCmdObjectSyncUpdate(kind),
 	)
+	cmdObjectSnapshot.AddCommand(
+		newCmdObjectSnapshotCreate(kind),
+		newCmdObjectSnapshotDelete(kind),
+		newCmdObjectSnapshotList(kind),
+		newCmdObjectSnapshotRollback(kind),
+	)
 	cmdObjectValidate.AddCommand(
 		newCmdObjectValidateConfig(kind),
 	)
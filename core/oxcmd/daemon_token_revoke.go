@@ -0,0 +1,44 @@
+package oxcmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opensvc/om3/core/client"
+)
+
+type (
+	CmdDaemonTokenRevoke struct {
+		OptsGlobal
+		ID string
+	}
+)
+
+func (t *CmdDaemonTokenRevoke) Run() error {
+	if t.ID == "" {
+		return fmt.Errorf("%w: id is empty", ErrFlagInvalid)
+	}
+	c, err := client.New(client.WithURL(t.Server))
+	if err != nil {
+		return err
+	}
+	resp, err := c.DeleteAuthTokenWithResponse(context.Background(), t.ID)
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode() {
+	case 200:
+		renderAuthTokens(resp.JSON200.Items, t.Output, t.Color)
+	case 401:
+		return fmt.Errorf("%s", resp.JSON401)
+	case 403:
+		return fmt.Errorf("%s", resp.JSON403)
+	case 404:
+		return fmt.Errorf("%s", resp.JSON404)
+	case 500:
+		return fmt.Errorf("%s", resp.JSON500)
+	default:
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode())
+	}
+	return nil
+}
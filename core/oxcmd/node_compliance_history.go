@@ -0,0 +1,22 @@
+package oxcmd
+
+import (
+	"github.com/opensvc/om3/core/nodeaction"
+)
+
+type (
+	CmdNodeComplianceHistory struct {
+		OptsGlobal
+		NodeSelector string
+		Last         int
+	}
+)
+
+func (t *CmdNodeComplianceHistory) Run() error {
+	return nodeaction.New(
+		nodeaction.WithRemoteNodes(t.NodeSelector),
+		nodeaction.WithFormat(t.Output),
+		nodeaction.WithColor(t.Color),
+		nodeaction.WithServer(t.Server),
+	).Do()
+}
@@ -2,6 +2,7 @@ package oxcmd
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -13,6 +14,10 @@ import (
 	"github.com/opensvc/om3/core/monitor"
 	"github.com/opensvc/om3/core/nodeaction"
 	"github.com/opensvc/om3/core/nodeselector"
+	"github.com/opensvc/om3/core/output"
+	"github.com/opensvc/om3/core/plan"
+	"github.com/opensvc/om3/core/rawconfig"
+	"github.com/opensvc/om3/daemon/api"
 	"github.com/opensvc/om3/util/hostname"
 )
 
@@ -20,6 +25,7 @@ type CmdNodeDrain struct {
 	OptsGlobal
 	OptsAsync
 	NodeSelector string
+	Plan         bool
 }
 
 func (t *CmdNodeDrain) Run() error {
@@ -29,6 +35,9 @@ func (t *CmdNodeDrain) Run() error {
 	if t.NodeSelector == "" {
 		return fmt.Errorf("--node must be specified")
 	}
+	if t.Plan {
+		return t.doPlan()
+	}
 	return t.doRemote()
 }
 
@@ -52,7 +61,7 @@ func (t *CmdNodeDrain) doRemote() error {
 				nodeaction.WithFormat(t.Output),
 				nodeaction.WithColor(t.Color),
 				nodeaction.WithAsyncFunc(func(ctx context.Context) error {
-					if resp, err := c.PostPeerActionDrainWithResponse(ctx, nodename); err != nil {
+					if resp, err := c.PostPeerActionDrainWithResponse(ctx, nodename, nil); err != nil {
 						return err
 					} else {
 						switch resp.StatusCode() {
@@ -127,3 +136,62 @@ func (t *CmdNodeDrain) doRemote() error {
 
 	return errs
 }
+
+func (t *CmdNodeDrain) doPlan() error {
+	c, err := client.New(client.WithURL(t.Server))
+	if err != nil {
+		return err
+	}
+	nodenames, err := nodeselector.New(t.NodeSelector, nodeselector.WithClient(c)).Expand()
+	if err != nil {
+		return err
+	}
+	var (
+		errs   error
+		plans  = make([]plan.T, 0)
+		dryRun = true
+		params = api.PostPeerActionDrainParams{DryRun: &dryRun}
+	)
+	for _, nodename := range nodenames {
+		resp, err := c.PostPeerActionDrainWithResponse(context.Background(), nodename, &params)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		switch resp.StatusCode() {
+		case http.StatusOK:
+			var data struct {
+				Plan plan.T `json:"plan"`
+			}
+			if err := json.Unmarshal(resp.Body, &data); err != nil {
+				errs = errors.Join(errs, fmt.Errorf("%s: %w", nodename, err))
+			} else {
+				plans = append(plans, data.Plan)
+			}
+		case 400:
+			errs = errors.Join(errs, fmt.Errorf("%s: %s", nodename, *resp.JSON400))
+		case 401:
+			errs = errors.Join(errs, fmt.Errorf("%s: %s", nodename, *resp.JSON401))
+		case 403:
+			errs = errors.Join(errs, fmt.Errorf("%s: %s", nodename, *resp.JSON403))
+		case 500:
+			errs = errors.Join(errs, fmt.Errorf("%s: %s", nodename, *resp.JSON500))
+		default:
+			errs = errors.Join(errs, fmt.Errorf("%s: unexpected status [%d]", nodename, resp.StatusCode()))
+		}
+	}
+	output.Renderer{
+		Output: t.Output,
+		Color:  t.Color,
+		Data:   plans,
+		HumanRenderer: func() string {
+			s := ""
+			for _, p := range plans {
+				s += p.Render()
+			}
+			return s
+		},
+		Colorize: rawconfig.Colorize,
+	}.Print()
+	return errs
+}
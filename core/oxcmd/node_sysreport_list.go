@@ -0,0 +1,77 @@
+package oxcmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/nodeselector"
+	"github.com/opensvc/om3/core/output"
+	"github.com/opensvc/om3/core/rawconfig"
+	"github.com/opensvc/om3/daemon/api"
+)
+
+type (
+	CmdNodeSysreportList struct {
+		OptsGlobal
+		NodeSelector string
+	}
+)
+
+func (t *CmdNodeSysreportList) extract(c *client.T, nodename string) (api.SysreportSnapshotList, error) {
+	resp, err := c.GetNodeSysreportSnapshotsWithResponse(context.Background(), nodename)
+	if err != nil {
+		return api.SysreportSnapshotList{}, err
+	}
+	switch resp.StatusCode() {
+	case 200:
+		return *resp.JSON200, nil
+	case 401:
+		return api.SysreportSnapshotList{}, fmt.Errorf("%s: %s", nodename, *resp.JSON401)
+	case 403:
+		return api.SysreportSnapshotList{}, fmt.Errorf("%s: %s", nodename, *resp.JSON403)
+	default:
+		return api.SysreportSnapshotList{}, fmt.Errorf("%s: unexpected statuscode: %s", nodename, resp.Status())
+	}
+}
+
+func (t *CmdNodeSysreportList) remote() (api.SysreportSnapshotList, error) {
+	var (
+		errs error
+		data api.SysreportSnapshotList
+	)
+	c, err := client.New(client.WithURL(t.Server))
+	if err != nil {
+		return data, err
+	}
+	if t.NodeSelector == "" {
+		t.NodeSelector = "*"
+	}
+	nodenames, err := nodeselector.New(t.NodeSelector, nodeselector.WithClient(c)).Expand()
+	if err != nil {
+		return data, err
+	}
+	for i, nodename := range nodenames {
+		if d, err := t.extract(c, nodename); err != nil {
+			errs = errors.Join(errs, err)
+		} else if i == 0 {
+			data = d
+		} else {
+			data.Items = append(data.Items, d.Items...)
+		}
+	}
+	return data, errs
+}
+
+func (t *CmdNodeSysreportList) Run() error {
+	data, err := t.remote()
+	output.Renderer{
+		DefaultOutput: "tab=NODE:meta.node,ID:data.id,CREATED_AT:data.created_at,FILES:data.files,COMMANDS:data.commands",
+		Output:        t.Output,
+		Color:         t.Color,
+		Data:          data,
+		Colorize:      rawconfig.Colorize,
+	}.Print()
+	return err
+}
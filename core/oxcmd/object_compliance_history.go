@@ -0,0 +1,22 @@
+package oxcmd
+
+import (
+	"github.com/opensvc/om3/core/objectaction"
+)
+
+type (
+	CmdObjectComplianceHistory struct {
+		OptsGlobal
+		Last int
+	}
+)
+
+func (t *CmdObjectComplianceHistory) Run(selector, kind string) error {
+	mergedSelector := mergeSelector(selector, t.ObjectSelector, kind, "")
+	return objectaction.New(
+		objectaction.WithColor(t.Color),
+		objectaction.WithOutput(t.Output),
+		objectaction.WithObjectSelector(mergedSelector),
+		objectaction.WithServer(t.Server),
+	).Do()
+}
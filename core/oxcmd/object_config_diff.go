@@ -0,0 +1,129 @@
+package oxcmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/clientcontext"
+	"github.com/opensvc/om3/core/confighistory"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/objectselector"
+	"github.com/opensvc/om3/core/output"
+	"github.com/opensvc/om3/core/rawconfig"
+)
+
+type (
+	CmdObjectConfigDiff struct {
+		OptsGlobal
+		Rev string
+	}
+)
+
+// revs returns the revision ids to compare. An empty second id designates
+// the installed configuration.
+func (t *CmdObjectConfigDiff) revs() (string, string, error) {
+	a, b, _ := strings.Cut(t.Rev, "..")
+	if a == "" {
+		return "", "", fmt.Errorf("invalid --rev value %q: expected <rev> or <rev>..<rev>", t.Rev)
+	}
+	return a, b, nil
+}
+
+func (t *CmdObjectConfigDiff) diff(p naming.Path, c *client.T) (string, error) {
+	revA, revB, err := t.revs()
+	if err != nil {
+		return "", err
+	}
+	nameA, dataA, err := t.revision(p, c, revA)
+	if err != nil {
+		return "", err
+	}
+	var (
+		nameB string
+		dataB []byte
+	)
+	if revB == "" {
+		nameB = p.String()
+		dataB, err = t.installed(p, c)
+	} else {
+		nameB, dataB, err = t.revision(p, c, revB)
+	}
+	if err != nil {
+		return "", err
+	}
+	return confighistory.Diff(nameA, dataA, nameB, dataB), nil
+}
+
+func (t *CmdObjectConfigDiff) revision(p naming.Path, c *client.T, rev string) (string, []byte, error) {
+	extractLocal := func() (string, []byte, error) {
+		revision, b, err := confighistory.New(p).Data(rev)
+		return p.String() + "@" + revision.ID, b, err
+	}
+	resp, err := c.GetObjectConfigRevisionWithResponse(context.Background(), p.Namespace, p.Kind, p.Name, rev)
+	switch {
+	case err != nil && !clientcontext.IsSet() && p.Exists():
+		return extractLocal()
+	case err != nil:
+		return "", nil, err
+	case resp.StatusCode() == http.StatusOK:
+		return p.String() + "@" + rev, resp.JSON200.Data, nil
+	default:
+		return "", nil, fmt.Errorf("get object %s config revision %s: %s", p, rev, resp.Status())
+	}
+}
+
+func (t *CmdObjectConfigDiff) installed(p naming.Path, c *client.T) ([]byte, error) {
+	if b, err := fetchConfig(p, c); err == nil {
+		return b, nil
+	} else if !clientcontext.IsSet() && p.Exists() {
+		return os.ReadFile(p.ConfigFile())
+	} else if rc, err := remoteClient(p, c); err != nil {
+		return nil, err
+	} else {
+		return fetchConfig(p, rc)
+	}
+}
+
+func (t *CmdObjectConfigDiff) Run(selector, kind string) error {
+	mergedSelector := mergeSelector(selector, t.ObjectSelector, kind, "")
+	c, err := client.New(client.WithURL(t.Server))
+	if err != nil {
+		return err
+	}
+	paths, err := objectselector.New(
+		mergedSelector,
+		objectselector.WithClient(c),
+	).MustExpand()
+	if err != nil {
+		return err
+	}
+	data := make(map[string]string)
+	for _, p := range paths {
+		if !confighistory.IsSupported(p.Kind) {
+			continue
+		}
+		if s, err := t.diff(p, c); err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %s\n", p, err)
+		} else {
+			data[p.String()] = s
+		}
+	}
+	output.Renderer{
+		Output: t.Output,
+		Color:  t.Color,
+		Data:   data,
+		HumanRenderer: func() string {
+			var s string
+			for _, p := range paths {
+				s += data[p.String()]
+			}
+			return s
+		},
+		Colorize: rawconfig.Colorize,
+	}.Print()
+	return nil
+}
@@ -0,0 +1,121 @@
+package oxcmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/clientcontext"
+	"github.com/opensvc/om3/core/confighistory"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/objectselector"
+	"github.com/opensvc/om3/core/output"
+	"github.com/opensvc/om3/core/rawconfig"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/util/hostname"
+)
+
+type (
+	CmdObjectConfigHistory struct {
+		OptsGlobal
+	}
+)
+
+func (t *CmdObjectConfigHistory) extract(selector string, c *client.T) (api.ObjectConfigRevisionList, error) {
+	data := api.ObjectConfigRevisionList{
+		Kind:  "ObjectConfigRevisionList",
+		Items: make(api.ObjectConfigRevisionItems, 0),
+	}
+	paths, err := objectselector.New(
+		selector,
+		objectselector.WithClient(c),
+	).MustExpand()
+	if err != nil {
+		return data, err
+	}
+	for _, p := range paths {
+		if !confighistory.IsSupported(p.Kind) {
+			continue
+		}
+		if items, err := t.extractOne(p, c); err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %s\n", p, err)
+		} else {
+			data.Items = append(data.Items, items...)
+		}
+	}
+	return data, nil
+}
+
+func (t *CmdObjectConfigHistory) extractOne(p naming.Path, c *client.T) (api.ObjectConfigRevisionItems, error) {
+	if items, err := t.extractFromDaemon(p, c); err == nil {
+		return items, nil
+	} else if clientcontext.IsSet() {
+		return nil, err
+	} else if p.Exists() {
+		return t.extractLocal(p)
+	} else {
+		return nil, fmt.Errorf("%w, and no local instance to read from", err)
+	}
+}
+
+func (t *CmdObjectConfigHistory) extractLocal(p naming.Path) (api.ObjectConfigRevisionItems, error) {
+	l, err := confighistory.New(p).List()
+	if err != nil {
+		return nil, err
+	}
+	items := make(api.ObjectConfigRevisionItems, len(l))
+	for i, rev := range l {
+		items[i] = api.ObjectConfigRevisionItem{
+			Kind: "ObjectConfigRevisionItem",
+			Meta: api.InstanceMeta{
+				Node:   hostname.Hostname(),
+				Object: p.String(),
+			},
+			Data: rev,
+		}
+	}
+	return items, nil
+}
+
+func (t *CmdObjectConfigHistory) extractFromDaemon(p naming.Path, c *client.T) (api.ObjectConfigRevisionItems, error) {
+	resp, err := c.GetObjectConfigHistoryWithResponse(context.Background(), p.Namespace, p.Kind, p.Name)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		return resp.JSON200.Items, nil
+	case http.StatusBadRequest:
+		return nil, fmt.Errorf("%s", *resp.JSON400)
+	case http.StatusUnauthorized:
+		return nil, fmt.Errorf("%s", *resp.JSON401)
+	case http.StatusForbidden:
+		return nil, fmt.Errorf("%s", *resp.JSON403)
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("%s", *resp.JSON404)
+	default:
+		return nil, fmt.Errorf("get object config history: %s", resp.Status())
+	}
+}
+
+func (t *CmdObjectConfigHistory) Run(selector, kind string) error {
+	mergedSelector := mergeSelector(selector, t.ObjectSelector, kind, "")
+	c, err := client.New(client.WithURL(t.Server))
+	if err != nil {
+		return err
+	}
+	data, err := t.extract(mergedSelector, c)
+	if err != nil {
+		return err
+	}
+	output.Renderer{
+		DefaultOutput: "tab=OBJECT:meta.object,NODE:meta.node,ID:data.id,CREATED_AT:data.created_at,AUTHOR:data.author,CSUM:data.csum",
+		Output:        t.Output,
+		Color:         t.Color,
+		Data:          data,
+		Colorize:      rawconfig.Colorize,
+	}.Print()
+	return nil
+}
@@ -2,7 +2,6 @@ package oxcmd
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -11,7 +10,6 @@ import (
 	"time"
 
 	"github.com/google/uuid"
-	"github.com/iancoleman/orderedmap"
 
 	"github.com/opensvc/om3/core/client"
 	"github.com/opensvc/om3/core/keyop"
@@ -88,7 +86,7 @@ func (t *CmdObjectCreate) parseSelector(selector, kind string) (naming.Path, err
 		objectselector.WithClient(t.client),
 	).Expand()
 	if err == nil && len(paths) > 1 {
-		return p, fmt.Errorf("at most one object can be selected for create. to create many objects in a single create, use --config - and pipe json definitions")
+		return p, fmt.Errorf("at most one object can be selected for create. to create many objects in a single create, use --config - and pipe json or yaml definitions")
 	}
 	return p, nil
 }
@@ -284,7 +282,13 @@ func rawFromConfigURI(p naming.Path, u uri.T) (Pivot, error) {
 
 func rawFromConfigFile(p naming.Path, fpath string) (Pivot, error) {
 	pivot := make(Pivot)
-	c, err := xconfig.NewObject("", fpath)
+	b, err := os.ReadFile(fpath)
+	if err != nil {
+		return pivot, err
+	}
+	// The file is either an ini configuration or a json or yaml
+	// structured document.
+	c, err := xconfig.NewObject("", b)
 	if err != nil {
 		return pivot, err
 	}
@@ -305,45 +309,41 @@ func rawFromStdinNested(namespace string) (Pivot, error) {
 	if err != nil {
 		return pivot, err
 	}
-	if err = json.Unmarshal(b, &pivot); err != nil {
+	keys, docs, err := xconfig.SubDocuments(b)
+	if err != nil {
 		return pivot, err
 	}
-	if md, ok := pivot["metadata"]; ok {
-		p, err := pathFromMetadata(md.Data)
+	for _, k := range keys {
+		if k != "metadata" {
+			continue
+		}
+		c, err := xconfig.NewObject("", b)
+		if err != nil {
+			return pivot, err
+		}
+		p, err := pathFromMetadata(c)
 		if err != nil {
 			return pivot, err
 		}
 		if namespace != "" {
 			p.Namespace = namespace
 		}
-		return rawFromBytesFlat(p, b)
+		pivot[p.String()] = c.Raw()
+		return pivot, nil
+	}
+	for i, k := range keys {
+		c, err := xconfig.NewObject("", docs[i])
+		if err != nil {
+			return pivot, fmt.Errorf("%s: %w", k, err)
+		}
+		pivot[k] = c.Raw()
 	}
 	return pivot, nil
 }
 
-func pathFromMetadata(data *orderedmap.OrderedMap) (naming.Path, error) {
-	var name, namespace, kind string
-	if s, ok := data.Get("name"); ok {
-		if name, ok = s.(string); !ok {
-			return naming.Path{}, fmt.Errorf("metadata format error: name")
-		}
-	}
-	if s, ok := data.Get("kind"); ok {
-		if kind, ok = s.(string); !ok {
-			return naming.Path{}, fmt.Errorf("metadata format error: kind")
-		}
-	}
-	if s, ok := data.Get("namespace"); ok {
-		switch k := s.(type) {
-		case nil:
-			namespace = ""
-		case string:
-			namespace = k
-		default:
-			return naming.Path{}, fmt.Errorf("metadata format error: namespace")
-		}
-	}
-	return naming.NewPathFromStrings(namespace, kind, name)
+func pathFromMetadata(c *xconfig.T) (naming.Path, error) {
+	md := c.SectionMap("metadata")
+	return naming.NewPathFromStrings(md["namespace"], md["kind"], md["name"])
 }
 
 func rawFromStdinFlat(p naming.Path) (Pivot, error) {
@@ -356,10 +356,10 @@ func rawFromStdinFlat(p naming.Path) (Pivot, error) {
 
 func rawFromBytesFlat(p naming.Path, b []byte) (Pivot, error) {
 	pivot := make(Pivot)
-	c := &rawconfig.T{}
-	if err := json.Unmarshal(b, c); err != nil {
+	c, err := xconfig.NewObject("", b)
+	if err != nil {
 		return pivot, err
 	}
-	pivot[p.String()] = *c
+	pivot[p.String()] = c.Raw()
 	return pivot, nil
 }
@@ -9,6 +9,7 @@ type (
 		OptsGlobal
 		OptsAsync
 		OptsLock
+		Plan bool
 	}
 )
 
@@ -22,5 +23,6 @@ func (t *CmdObjectGiveback) Run(selector, kind string) error {
 		objectaction.WithAsyncTime(t.Time),
 		objectaction.WithAsyncWait(t.Wait),
 		objectaction.WithAsyncWatch(t.Watch),
+		objectaction.WithAsyncDryRun(t.Plan),
 	).Do()
 }
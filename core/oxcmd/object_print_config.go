@@ -7,8 +7,11 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"sort"
 	"strings"
 
+	"github.com/iancoleman/orderedmap"
+
 	"github.com/opensvc/om3/core/client"
 	"github.com/opensvc/om3/core/clientcontext"
 	"github.com/opensvc/om3/core/naming"
@@ -16,6 +19,7 @@ import (
 	"github.com/opensvc/om3/core/objectselector"
 	"github.com/opensvc/om3/core/output"
 	"github.com/opensvc/om3/core/rawconfig"
+	"github.com/opensvc/om3/core/xconfig"
 	"github.com/opensvc/om3/daemon/api"
 )
 
@@ -131,6 +135,9 @@ func (t *CmdObjectPrintConfig) Run(selector, kind string) error {
 	if len(data) == 0 {
 		return fmt.Errorf("no match")
 	}
+	if output.New(t.Output) == output.YAML && !t.Eval {
+		return t.printDocuments(selector, data)
+	}
 	var render func() string
 	if _, err := naming.ParsePath(selector); err == nil {
 		// single object selection
@@ -167,3 +174,36 @@ func (t *CmdObjectPrintConfig) Run(selector, kind string) error {
 	}
 	return nil
 }
+
+// printDocuments prints the configurations as yaml structured documents,
+// with the list values as arrays and the scoped values as nested maps. A
+// multiple objects selection is printed as a map of documents indexed by
+// object path.
+func (t *CmdObjectPrintConfig) printDocuments(selector string, data result) error {
+	docs := orderedmap.New()
+	for s, d := range data {
+		p, err := naming.ParsePath(s)
+		if err != nil {
+			return err
+		}
+		o, err := object.NewConfigurer(p, object.WithVolatile(true), object.WithConfigData(d.Data))
+		if err != nil {
+			return err
+		}
+		docs.Set(s, o.Config().Document())
+	}
+	if _, err := naming.ParsePath(selector); err == nil {
+		// single object selection
+		if doc, ok := docs.Get(selector); ok {
+			docs = doc.(*orderedmap.OrderedMap)
+		}
+	} else {
+		docs.SortKeys(sort.Strings)
+	}
+	b, err := xconfig.MarshalDocument(docs, xconfig.DocumentFormatYAML)
+	if err != nil {
+		return err
+	}
+	fmt.Print(string(b))
+	return nil
+}
@@ -0,0 +1,130 @@
+package oxcmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/clientcontext"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/objectselector"
+	"github.com/opensvc/om3/core/output"
+	"github.com/opensvc/om3/core/rawconfig"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/util/hostname"
+)
+
+type (
+	CmdObjectSnapshotList struct {
+		OptsGlobal
+	}
+)
+
+func (t *CmdObjectSnapshotList) extract(selector string, c *client.T) (api.VolumeSnapshotList, error) {
+	data := api.VolumeSnapshotList{
+		Kind:  "VolumeSnapshotList",
+		Items: make(api.VolumeSnapshotItems, 0),
+	}
+	paths, err := objectselector.New(
+		selector,
+		objectselector.WithClient(c),
+	).MustExpand()
+	if err != nil {
+		return data, err
+	}
+	for _, p := range paths {
+		if p.Kind != naming.KindVol {
+			continue
+		}
+		if items, err := t.extractOne(p, c); err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %s\n", p, err)
+		} else {
+			data.Items = append(data.Items, items...)
+		}
+	}
+	return data, nil
+}
+
+func (t *CmdObjectSnapshotList) extractOne(p naming.Path, c *client.T) (api.VolumeSnapshotItems, error) {
+	if items, err := t.extractFromDaemon(p, c); err == nil {
+		return items, nil
+	} else if clientcontext.IsSet() {
+		return nil, err
+	} else if p.Exists() {
+		return t.extractLocal(p)
+	} else {
+		return nil, fmt.Errorf("%w, and no local instance to read from", err)
+	}
+}
+
+func (t *CmdObjectSnapshotList) extractLocal(p naming.Path) (api.VolumeSnapshotItems, error) {
+	o, err := object.NewVol(p)
+	if err != nil {
+		return nil, err
+	}
+	l, err := o.ListSnapshots()
+	if err != nil {
+		return nil, err
+	}
+	items := make(api.VolumeSnapshotItems, len(l))
+	for i, snap := range l {
+		items[i] = api.VolumeSnapshotItem{
+			Kind: "VolumeSnapshotItem",
+			Meta: api.InstanceMeta{
+				Node:   hostname.Hostname(),
+				Object: p.String(),
+			},
+			Data: api.VolumeSnapshot{
+				Name:      snap.Name,
+				Volume:    snap.Volume,
+				CreatedAt: snap.CreatedAt,
+				Used:      snap.Used,
+			},
+		}
+	}
+	return items, nil
+}
+
+func (t *CmdObjectSnapshotList) extractFromDaemon(p naming.Path, c *client.T) (api.VolumeSnapshotItems, error) {
+	resp, err := c.GetVolumeSnapshotsWithResponse(context.Background(), p.Namespace, p.Kind, p.Name)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		return resp.JSON200.Items, nil
+	case http.StatusBadRequest:
+		return nil, fmt.Errorf("%s", *resp.JSON400)
+	case http.StatusUnauthorized:
+		return nil, fmt.Errorf("%s", *resp.JSON401)
+	case http.StatusForbidden:
+		return nil, fmt.Errorf("%s", *resp.JSON403)
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("%s", *resp.JSON404)
+	default:
+		return nil, fmt.Errorf("get volume snapshots: %s", resp.Status())
+	}
+}
+
+func (t *CmdObjectSnapshotList) Run(selector, kind string) error {
+	mergedSelector := mergeSelector(selector, t.ObjectSelector, kind, "")
+	c, err := client.New(client.WithURL(t.Server))
+	if err != nil {
+		return err
+	}
+	data, err := t.extract(mergedSelector, c)
+	if err != nil {
+		return err
+	}
+	output.Renderer{
+		DefaultOutput: "tab=OBJECT:meta.object,NODE:meta.node,NAME:data.name,CREATED_AT:data.created_at,USED:data.used",
+		Output:        t.Output,
+		Color:         t.Color,
+		Data:          data,
+		Colorize:      rawconfig.Colorize,
+	}.Print()
+	return nil
+}
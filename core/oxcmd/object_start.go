@@ -21,6 +21,7 @@ type (
 		Force           bool
 		DisableRollback bool
 		NodeSelector    string
+		Plan            bool
 	}
 )
 
@@ -37,6 +38,7 @@ func (t *CmdObjectStart) Run(selector, kind string) error {
 		objectaction.WithAsyncTime(t.Time),
 		objectaction.WithAsyncWait(t.Wait),
 		objectaction.WithAsyncWatch(t.Watch),
+		objectaction.WithAsyncDryRun(t.Plan),
 		objectaction.WithRemoteNodes(t.NodeSelector),
 		objectaction.WithRemoteFunc(func(ctx context.Context, p naming.Path, nodename string) (interface{}, error) {
 			c, err := client.New(client.WithURL(t.Server))
@@ -20,6 +20,7 @@ type (
 		OptTo
 		Force        bool
 		NodeSelector string
+		Plan         bool
 	}
 )
 
@@ -36,6 +37,7 @@ func (t *CmdObjectStop) Run(selector, kind string) error {
 		objectaction.WithAsyncTime(t.Time),
 		objectaction.WithAsyncWait(t.Wait),
 		objectaction.WithAsyncWatch(t.Watch),
+		objectaction.WithAsyncDryRun(t.Plan),
 		objectaction.WithRemoteNodes(t.NodeSelector),
 		objectaction.WithRemoteFunc(func(ctx context.Context, p naming.Path, nodename string) (interface{}, error) {
 			c, err := client.New(client.WithURL(t.Server))
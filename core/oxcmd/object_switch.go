@@ -12,7 +12,8 @@ type (
 		OptsGlobal
 		OptsAsync
 		OptsLock
-		To string
+		To   string
+		Plan bool
 	}
 )
 
@@ -34,5 +35,6 @@ func (t *CmdObjectSwitch) Run(selector, kind string) error {
 		objectaction.WithAsyncTime(t.Time),
 		objectaction.WithAsyncWait(t.Wait),
 		objectaction.WithAsyncWatch(t.Watch),
+		objectaction.WithAsyncDryRun(t.Plan),
 	).Do()
 }
@@ -0,0 +1,48 @@
+package oxcmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/output"
+	"github.com/opensvc/om3/core/rawconfig"
+	"github.com/opensvc/om3/daemon/api"
+)
+
+type (
+	CmdSchema struct {
+		OptsGlobal
+	}
+)
+
+func (t *CmdSchema) Run(name string) error {
+	c, err := client.New(client.WithURL(t.Server))
+	if err != nil {
+		return err
+	}
+	resp, err := c.GetSchemaWithResponse(context.Background(), api.GetSchemaParamsName(name))
+	if err != nil {
+		return err
+	}
+	var pb api.Problem
+	switch resp.StatusCode() {
+	case 200:
+		output.Renderer{
+			Output:   t.Output,
+			Color:    t.Color,
+			Data:     resp.JSON200,
+			Colorize: rawconfig.Colorize,
+		}.Print()
+		return nil
+	case 400:
+		pb = *resp.JSON400
+	case 401:
+		pb = *resp.JSON401
+	case 403:
+		pb = *resp.JSON403
+	case 500:
+		pb = *resp.JSON500
+	}
+	return fmt.Errorf("%s", pb)
+}
@@ -0,0 +1,96 @@
+package oxcmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/clientcontext"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/util/xsession"
+)
+
+type (
+	CmdVolClone struct {
+		OptsGlobal
+		Source string
+		Target string
+	}
+)
+
+func (t *CmdVolClone) doLocal(src, dst naming.Path) error {
+	o, err := object.NewVol(src)
+	if err != nil {
+		return err
+	}
+	return o.Clone(context.Background(), dst)
+}
+
+func (t *CmdVolClone) doRemote(src, dst naming.Path, c *client.T) error {
+	evReader, err := sessionReader(c, src, 0)
+	if err != nil {
+		return err
+	}
+	defer evReader.Close()
+	sid := xsession.ID
+	params := api.PostVolumeCloneParams{
+		Target:       dst.String(),
+		RequesterSid: &sid,
+	}
+	resp, err := c.PostVolumeCloneWithResponse(context.Background(), src.Namespace, src.Kind, src.Name, &params)
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode() {
+	case http.StatusOK:
+	default:
+		return fmt.Errorf("clone volume %s to %s on %s: %s", src, dst, c.URL(), resp.Status()+string(resp.Body))
+	}
+	if err := waitSession(evReader); err != nil {
+		return fmt.Errorf("clone volume %s to %s on %s: session %s: %w", src, dst, c.URL(), resp.JSON200.SessionID, err)
+	}
+	return nil
+}
+
+// parseVolPath parses a vol object path, accepting a bare "<name>" as a
+// shortcut for "vol/<name>".
+func parseVolPath(s string) (naming.Path, error) {
+	if !strings.Contains(s, naming.Separator) {
+		s = naming.KindVol.String() + naming.Separator + s
+	}
+	return naming.ParsePath(s)
+}
+
+func (t *CmdVolClone) Run() error {
+	if t.Source == "" {
+		return fmt.Errorf("no source volume, use the <src> argument or --service")
+	}
+	src, err := parseVolPath(t.Source)
+	if err != nil {
+		return fmt.Errorf("source: %w", err)
+	}
+	dst, err := parseVolPath(t.Target)
+	if err != nil {
+		return fmt.Errorf("target: %w", err)
+	}
+	for _, p := range []naming.Path{src, dst} {
+		if p.Kind != naming.KindVol {
+			return fmt.Errorf("%s: not a vol object", p)
+		}
+	}
+	c, err := client.New(client.WithURL(t.Server))
+	if err != nil {
+		return err
+	}
+	if !clientcontext.IsSet() && src.Exists() {
+		if err := t.doLocal(src, dst); err != nil {
+			return fmt.Errorf("%s: %w", src, err)
+		}
+		return nil
+	}
+	return t.doRemote(src, dst, c)
+}
@@ -0,0 +1,179 @@
+package oxcmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/clientcontext"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/objectselector"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/util/xsession"
+)
+
+type (
+	CmdVolMigrate struct {
+		OptsGlobal
+		Pool string
+		Time time.Duration
+	}
+)
+
+// quiesce stops the <paths> objects through the orchestration and waits
+// for them to reach the stopped state.
+func (t *CmdVolMigrate) quiesce(paths naming.Paths) error {
+	cmd := CmdObjectStop{
+		OptsGlobal: OptsGlobal{Color: t.Color, Output: t.Output, Server: t.Server},
+		OptsAsync:  OptsAsync{Wait: true, Time: t.Time},
+	}
+	return cmd.Run(paths.String(), "")
+}
+
+// resume starts the <paths> objects through the orchestration and waits
+// for them to reach the started state.
+func (t *CmdVolMigrate) resume(paths naming.Paths) error {
+	cmd := CmdObjectStart{
+		OptsGlobal: OptsGlobal{Color: t.Color, Output: t.Output, Server: t.Server},
+		OptsAsync:  OptsAsync{Wait: true, Time: t.Time},
+	}
+	return cmd.Run(paths.String(), "")
+}
+
+func (t *CmdVolMigrate) prepareLocal(p naming.Path) (naming.Paths, error) {
+	ctx := context.Background()
+	o, err := object.NewVol(p)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.MigratePrepare(ctx, t.Pool); err != nil {
+		return nil, err
+	}
+	return o.HoldersExcept(ctx, naming.Path{}), nil
+}
+
+func (t *CmdVolMigrate) prepareRemote(p naming.Path, c *client.T) (naming.Paths, error) {
+	evReader, err := sessionReader(c, p, t.Time)
+	if err != nil {
+		return nil, err
+	}
+	defer evReader.Close()
+	sid := xsession.ID
+	params := api.PostVolumeMigratePrepareParams{
+		Pool:         t.Pool,
+		RequesterSid: &sid,
+	}
+	resp, err := c.PostVolumeMigratePrepareWithResponse(context.Background(), p.Namespace, p.Kind, p.Name, &params)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode() {
+	case http.StatusOK:
+	default:
+		return nil, fmt.Errorf("prepare the migration of volume %s to the pool %s on %s: %s", p, t.Pool, c.URL(), resp.Status()+string(resp.Body))
+	}
+	if err := waitSession(evReader); err != nil {
+		return nil, fmt.Errorf("prepare the migration of volume %s to the pool %s on %s: session %s: %w", p, t.Pool, c.URL(), resp.JSON200.SessionID, err)
+	}
+	return naming.ParsePaths(resp.JSON200.Holders...)
+}
+
+func (t *CmdVolMigrate) commitLocal(p naming.Path) error {
+	// the volume status changed with its consumers stop. Allocate a new
+	// object to drop the cached status.
+	o, err := object.NewVol(p)
+	if err != nil {
+		return err
+	}
+	return o.MigrateCommit(context.Background())
+}
+
+func (t *CmdVolMigrate) commitRemote(p naming.Path, c *client.T) error {
+	evReader, err := sessionReader(c, p, t.Time)
+	if err != nil {
+		return err
+	}
+	defer evReader.Close()
+	sid := xsession.ID
+	params := api.PostVolumeMigrateCommitParams{
+		RequesterSid: &sid,
+	}
+	resp, err := c.PostVolumeMigrateCommitWithResponse(context.Background(), p.Namespace, p.Kind, p.Name, &params)
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode() {
+	case http.StatusOK:
+	default:
+		return fmt.Errorf("commit the migration of volume %s on %s: %s", p, c.URL(), resp.Status()+string(resp.Body))
+	}
+	if err := waitSession(evReader); err != nil {
+		return fmt.Errorf("commit the migration of volume %s on %s: session %s: %w", p, c.URL(), resp.JSON200.SessionID, err)
+	}
+	return nil
+}
+
+func (t *CmdVolMigrate) migrate(p naming.Path, c *client.T, local bool) error {
+	var (
+		holders naming.Paths
+		err     error
+	)
+	if local {
+		holders, err = t.prepareLocal(p)
+	} else {
+		holders, err = t.prepareRemote(p, c)
+	}
+	if err != nil {
+		return err
+	}
+	if len(holders) > 0 {
+		if err := t.quiesce(holders); err != nil {
+			return fmt.Errorf("stop %s: %w", holders, err)
+		}
+	}
+	var errs error
+	if local {
+		errs = t.commitLocal(p)
+	} else {
+		errs = t.commitRemote(p, c)
+	}
+	if len(holders) > 0 {
+		if err := t.resume(holders); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("start %s: %w", holders, err))
+		}
+	}
+	return errs
+}
+
+func (t *CmdVolMigrate) Run(selector, kind string) error {
+	if t.Pool == "" {
+		return fmt.Errorf("no target pool, use --pool")
+	}
+	mergedSelector := mergeSelector(selector, t.ObjectSelector, kind, "")
+	c, err := client.New(client.WithURL(t.Server))
+	if err != nil {
+		return err
+	}
+	paths, err := objectselector.New(
+		mergedSelector,
+		objectselector.WithClient(c),
+	).MustExpand()
+	if err != nil {
+		return err
+	}
+	wc := clientcontext.IsSet()
+	for _, p := range paths {
+		if p.Kind != naming.KindVol {
+			return fmt.Errorf("%s: %s objects can not be migrated", p, p.Kind)
+		}
+		if err := t.migrate(p, c, !wc && p.Exists()); err != nil {
+			return fmt.Errorf("%s: %w", p, err)
+		}
+		fmt.Printf("%s: migrated to the pool %s\n", p, t.Pool)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package plan
+
+import (
+	"bytes"
+	"crypto/md5"
+	"sort"
+	"time"
+
+	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/node"
+	"github.com/opensvc/om3/core/placement"
+	"github.com/opensvc/om3/util/stringslice"
+)
+
+type (
+	// CandidateSorter sorts the candidate nodes of an object according to
+	// its placement policy. It is shared by the daemon instance monitor and
+	// the plan evaluation, so both rank nodes the same way.
+	CandidateSorter struct {
+		Path           naming.Path
+		Policy         placement.Policy
+		Scope          []string
+		NodeStats      map[string]node.Stats
+		InstanceStatus map[string]instance.Status
+	}
+)
+
+// Sort returns the candidates list sorted by the placement policy.
+func (t CandidateSorter) Sort(candidates []string) []string {
+	switch t.Policy {
+	case placement.NodesOrder:
+		return t.sortWithNodesOrderPolicy(candidates)
+	case placement.Spread:
+		return t.sortWithSpreadPolicy(candidates)
+	case placement.Score:
+		return t.sortWithScorePolicy(candidates)
+	case placement.Shift:
+		return t.sortWithShiftPolicy(candidates)
+	case placement.LastStart:
+		return t.sortWithLastStartPolicy(candidates)
+	default:
+		return []string{}
+	}
+}
+
+func (t CandidateSorter) sortWithSpreadPolicy(candidates []string) []string {
+	l := append([]string{}, candidates...)
+	sum := func(s string) []byte {
+		b := append([]byte(t.Path.String()), []byte(s)...)
+		return md5.New().Sum(b)
+	}
+	sort.SliceStable(l, func(i, j int) bool {
+		return bytes.Compare(sum(l[i]), sum(l[j])) < 0
+	})
+	return l
+}
+
+// sortWithScorePolicy sorts candidates by descending cluster.NodeStats.Score
+func (t CandidateSorter) sortWithScorePolicy(candidates []string) []string {
+	l := append([]string{}, candidates...)
+	sort.SliceStable(l, func(i, j int) bool {
+		var si, sj uint64
+		if stats, ok := t.NodeStats[l[i]]; ok {
+			si = stats.Score
+		}
+		if stats, ok := t.NodeStats[l[j]]; ok {
+			sj = stats.Score
+		}
+		return si > sj
+	})
+	return l
+}
+
+func (t CandidateSorter) sortWithLastStartPolicy(candidates []string) []string {
+	l := append([]string{}, candidates...)
+	sort.SliceStable(l, func(i, j int) bool {
+		var si, sj time.Time
+		if instStatus, ok := t.InstanceStatus[l[i]]; ok {
+			si = instStatus.LastStartedAt
+		}
+		if instStatus, ok := t.InstanceStatus[l[j]]; ok {
+			sj = instStatus.LastStartedAt
+		}
+		return si.After(sj)
+	})
+	return l
+}
+
+func (t CandidateSorter) sortWithShiftPolicy(candidates []string) []string {
+	var i int
+	l := t.sortWithNodesOrderPolicy(candidates)
+	n := len(l)
+	l = append(l, l...)
+	scalerSliceIndex := t.Path.ScalerSliceIndex()
+	if n > 0 && scalerSliceIndex > n {
+		i = scalerSliceIndex % n
+	}
+	return l[i : i+n]
+}
+
+func (t CandidateSorter) sortWithNodesOrderPolicy(candidates []string) []string {
+	var l []string
+	for _, node := range t.Scope {
+		if stringslice.Has(node, candidates) {
+			l = append(l, node)
+		}
+	}
+	return l
+}
@@ -0,0 +1,126 @@
+package plan
+
+import (
+	"sort"
+
+	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/node"
+	"github.com/opensvc/om3/core/status"
+	"github.com/opensvc/om3/core/topology"
+)
+
+// Drain returns the plan of a drained node local expect orchestration:
+// the node is frozen, its svc instances are shut down, and the ha objects
+// are taken over by peer nodes.
+func (s *Snapshot) Drain(nodename string) T {
+	t := newT(node.MonitorLocalExpectDrained.String())
+	nodeMonitor, ok := s.NodeMonitor[nodename]
+	switch {
+	case !ok:
+		t.refuse(naming.Path{}, nodename, "node monitor not found")
+		return t
+	case nodeMonitor.LocalExpect == node.MonitorLocalExpectDrained:
+		t.refuse(naming.Path{}, nodename, "node is already draining")
+		return t
+	case nodeMonitor.State != node.MonitorStateIdle && nodeMonitor.State != node.MonitorStateFrozen:
+		t.refuse(naming.Path{}, nodename, "node monitor state is %s", nodeMonitor.State)
+		return t
+	}
+	if nodeStatus, ok := s.NodeStatus[nodename]; !ok || !nodeStatus.IsFrozen() {
+		t.addStep(Step{Node: nodename, Action: ActionFreeze, Reason: "prevent ha restart"})
+	}
+	for _, p := range s.localPaths(nodename, naming.KindSvc) {
+		instStatus := s.InstanceStatus[p][nodename]
+		if instStatus.Avail.Is(status.Down, status.NotApplicable) {
+			continue
+		}
+		t.addStep(s.newInstanceStep(p, nodename, ActionShutdown, "instance is "+instStatus.Avail.String()))
+		s.takeover(&t, p, nodename)
+	}
+	t.resolveWaits()
+	t.order()
+	return t
+}
+
+// Shutdown returns the plan of a daemon shutdown: the svc and vol instances
+// with an idle monitor are shut down, and the ha objects are taken over by
+// peer nodes.
+func (s *Snapshot) Shutdown(nodename string) T {
+	t := newT(node.MonitorStateShutdown.String())
+	nodeMonitor, ok := s.NodeMonitor[nodename]
+	switch {
+	case !ok:
+		t.refuse(naming.Path{}, nodename, "node monitor not found")
+		return t
+	case nodeMonitor.State == node.MonitorStateShutting:
+		t.refuse(naming.Path{}, nodename, "node is already shutting down")
+		return t
+	}
+	for _, p := range s.localPaths(nodename, naming.KindSvc, naming.KindVol) {
+		if instMon := s.InstanceMonitor[p][nodename]; instMon.State != instance.MonitorStateIdle {
+			t.refuse(p, nodename, "instance monitor state is %s", instMon.State)
+			continue
+		}
+		instStatus := s.InstanceStatus[p][nodename]
+		step := s.newInstanceStep(p, nodename, ActionShutdown, "instance is "+instStatus.Avail.String())
+		step.children = s.children(p, nodename)
+		t.addStep(step)
+		if !instStatus.Avail.Is(status.Down, status.NotApplicable) {
+			s.takeover(&t, p, nodename)
+		}
+	}
+	t.resolveWaits()
+	t.order()
+	return t
+}
+
+// takeover adds the start steps the peer instance monitors would execute
+// when the ha object instance on nodename goes down.
+func (s *Snapshot) takeover(t *T, p naming.Path, nodename string) {
+	objStatus, ok := s.ObjectStatus[p]
+	if !ok || objStatus.Orchestrate != "ha" {
+		return
+	}
+	switch objStatus.Topology {
+	case topology.Failover, topology.Flex:
+	default:
+		return
+	}
+	if v, reason := isHAOrchestrateable(objStatus); !v {
+		t.refuse(p, "", "no takeover: %s", reason)
+		return
+	}
+	leaders := s.haLeaders(p, false, nodename)
+	if len(leaders) == 0 {
+		t.refuse(p, "", "no takeover: no candidate node")
+		return
+	}
+	for _, leader := range leaders {
+		if instStatus := s.InstanceStatus[p][leader]; isLocalStarted(instStatus.Avail) {
+			continue
+		}
+		step := s.newInstanceStep(p, leader, ActionStart, "takeover from "+nodename)
+		step.parents = s.parents(p, leader)
+		s.addStep(t, step)
+	}
+}
+
+// localPaths returns the sorted paths of the objects of the kinds having an
+// instance on the node.
+func (s *Snapshot) localPaths(nodename string, kinds ...naming.Kind) naming.Paths {
+	var l naming.Paths
+	for p, m := range s.InstanceStatus {
+		if _, ok := m[nodename]; !ok {
+			continue
+		}
+		for _, kind := range kinds {
+			if p.Kind == kind {
+				l = append(l, p)
+				break
+			}
+		}
+	}
+	sort.Slice(l, func(i, j int) bool { return l[i].String() < l[j].String() })
+	return l
+}
@@ -0,0 +1,271 @@
+package plan
+
+import (
+	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/provisioned"
+	"github.com/opensvc/om3/core/status"
+	"github.com/opensvc/om3/core/topology"
+	"github.com/opensvc/om3/util/stringslice"
+)
+
+// Start returns the plan of a started global expect orchestration.
+func (s *Snapshot) Start(p naming.Path) T {
+	t := newT(instance.MonitorGlobalExpectStarted.String())
+	objStatus, ok := s.checkObject(&t, p)
+	if !ok {
+		return t
+	}
+	if v, reason := isStartable(objStatus); !v {
+		t.refuse(p, "", "%s", reason)
+		return t
+	}
+	for _, nodename := range s.scope(p) {
+		if instStatus, ok := s.InstanceStatus[p][nodename]; ok && instStatus.IsFrozen() {
+			s.addInstanceStep(&t, p, nodename, ActionUnfreeze, "thaw before start")
+		}
+	}
+	leaders := s.haLeaders(p, true)
+	if len(leaders) == 0 {
+		t.refuse(p, "", "no candidate node to start on")
+	}
+	for _, nodename := range leaders {
+		if instStatus := s.InstanceStatus[p][nodename]; isLocalStarted(instStatus.Avail) {
+			continue
+		}
+		if instStatus := s.InstanceStatus[p][nodename]; instStatus.Provisioned.IsOneOf(provisioned.False, provisioned.Undef) {
+			t.refuse(p, nodename, "instance is not provisioned")
+			continue
+		}
+		step := s.newInstanceStep(p, nodename, ActionStart, "elected ha leader")
+		step.parents = s.parents(p, nodename)
+		s.addStep(&t, step)
+	}
+	t.resolveWaits()
+	t.order()
+	return t
+}
+
+// Stop returns the plan of a stopped global expect orchestration.
+func (s *Snapshot) Stop(p naming.Path) T {
+	t := newT(instance.MonitorGlobalExpectStopped.String())
+	if _, ok := s.checkObject(&t, p); !ok {
+		return t
+	}
+	for _, nodename := range s.scope(p) {
+		instStatus, ok := s.InstanceStatus[p][nodename]
+		if !ok {
+			continue
+		}
+		if instStatus.IsThawed() {
+			s.addInstanceStep(&t, p, nodename, ActionFreeze, "prevent ha restart")
+		}
+		if isLocalStopped(instStatus.Avail) {
+			continue
+		}
+		step := s.newInstanceStep(p, nodename, ActionStop, "instance is "+instStatus.Avail.String())
+		step.children = s.children(p, nodename)
+		s.addStep(&t, step)
+	}
+	t.resolveWaits()
+	t.order()
+	return t
+}
+
+// Switch returns the plan of a placed@ global expect orchestration. An
+// empty destination selects the next preferred node.
+func (s *Snapshot) Switch(p naming.Path, destination []string) T {
+	t := newT(instance.MonitorGlobalExpectPlacedAt.String())
+	objStatus, ok := s.checkObject(&t, p)
+	if !ok {
+		return t
+	}
+	var dst []string
+	if len(destination) == 0 {
+		if nodename := s.nextPlacedAtCandidate(p, objStatus); nodename == "" {
+			t.refuse(p, "", "no destination node could be selected from candidates")
+			return t
+		} else {
+			dst = []string{nodename}
+		}
+	} else {
+		for _, nodename := range destination {
+			if _, ok := s.InstanceStatus[p][nodename]; ok {
+				dst = append(dst, nodename)
+			}
+		}
+		if len(dst) == 0 {
+			t.refuse(p, "", "no destination node could be selected from %s", destination)
+			return t
+		}
+	}
+	s.placed(&t, p, objStatus, dst)
+	return t
+}
+
+// Giveback returns the plan of a placed global expect orchestration.
+func (s *Snapshot) Giveback(p naming.Path) T {
+	t := newT(instance.MonitorGlobalExpectPlaced.String())
+	objStatus, ok := s.checkObject(&t, p)
+	if !ok {
+		return t
+	}
+	dst := s.haLeaders(p, true)
+	if len(dst) == 0 {
+		t.refuse(p, "", "no candidate node to place on")
+		return t
+	}
+	s.placed(&t, p, objStatus, dst)
+	return t
+}
+
+// placed adds the steps needed to have the object instances started on the
+// dst nodes and stopped on the other nodes.
+func (s *Snapshot) placed(t *T, p naming.Path, objStatus object.Status, dst []string) {
+	var stopNodes []string
+	for _, nodename := range s.scope(p) {
+		instStatus, ok := s.InstanceStatus[p][nodename]
+		if !ok {
+			continue
+		}
+		if instStatus.IsFrozen() {
+			s.addInstanceStep(t, p, nodename, ActionUnfreeze, "thaw before placement")
+		}
+		if stringslice.Has(nodename, dst) {
+			if objStatus.Topology == topology.Failover && objStatus.Avail.Is(status.NotApplicable, status.Undef) {
+				continue
+			}
+			switch instStatus.Avail {
+			case status.Down, status.StandbyDown, status.StandbyUp:
+				step := s.newInstanceStep(p, nodename, ActionStart, "destination node")
+				step.parents = s.parents(p, nodename)
+				s.addStep(t, step)
+			}
+		} else {
+			switch instStatus.Avail {
+			case status.Up, status.Warn:
+				step := s.newInstanceStep(p, nodename, ActionStop, "not a destination node")
+				step.children = s.children(p, nodename)
+				s.addStep(t, step)
+				stopNodes = append(stopNodes, nodename)
+			}
+		}
+	}
+	t.resolveWaits()
+	if objStatus.Topology == topology.Failover {
+		// a failover instance starts only once the stops are done
+		for _, nodename := range stopNodes {
+			if !t.hasStep(p, nodename, ActionStop) {
+				t.refuseSteps(p, ActionStart, "start would wait: stop on %s is refused", nodename)
+				break
+			}
+		}
+	}
+	t.order()
+}
+
+// nextPlacedAtCandidate returns the first node of the sorted candidates
+// where the instance is not started, like the instance monitor does to
+// select a switch destination when none is specified.
+func (s *Snapshot) nextPlacedAtCandidate(p naming.Path, objStatus object.Status) string {
+	if objStatus.Topology == topology.Flex {
+		return ""
+	}
+	candidates := s.candidateSorter(p).Sort(append([]string{}, s.scope(p)...))
+	for _, candidate := range candidates {
+		if instStatus, ok := s.InstanceStatus[p][candidate]; ok {
+			switch instStatus.Avail {
+			case status.Down, status.StandbyDown, status.StandbyUp:
+				return candidate
+			}
+		}
+	}
+	return ""
+}
+
+// checkObject verifies the object exists and has no orchestration in
+// progress, and refuses the instances hosted on nodes whose monitor does
+// not orchestrate.
+func (s *Snapshot) checkObject(t *T, p naming.Path) (object.Status, bool) {
+	objStatus, ok := s.ObjectStatus[p]
+	if !ok {
+		t.refuse(p, "", "object does not exist")
+		return objStatus, false
+	}
+	if id, globalExpect, ok := s.orchestrationInProgress(p); ok {
+		t.refuse(p, "", "a %s orchestration is already in progress with id %s", globalExpect, id)
+		return objStatus, false
+	}
+	for _, nodename := range s.scope(p) {
+		if _, ok := s.InstanceStatus[p][nodename]; !ok {
+			continue
+		}
+		if v, reason := s.nodeCanOrchestrate(nodename); !v {
+			t.refuse(p, nodename, "%s", reason)
+		}
+	}
+	return objStatus, true
+}
+
+func (s *Snapshot) newInstanceStep(p naming.Path, nodename string, action Action, reason string) Step {
+	step := Step{
+		Path:   p.String(),
+		Node:   nodename,
+		Action: action,
+		Reason: reason,
+	}
+	if cfg, ok := s.InstanceConfig[p][nodename]; ok {
+		step.priority = cfg.Priority
+	} else {
+		step.priority = s.ObjectStatus[p].Priority
+	}
+	return step
+}
+
+func (s *Snapshot) addInstanceStep(t *T, p naming.Path, nodename string, action Action, reason string) {
+	s.addStep(t, s.newInstanceStep(p, nodename, action, reason))
+}
+
+// addStep adds the step to the plan, unless the instance is hosted on a node
+// whose monitor does not orchestrate.
+func (s *Snapshot) addStep(t *T, step Step) {
+	if v, _ := s.nodeCanOrchestrate(step.Node); !v {
+		return
+	}
+	t.addStep(step)
+}
+
+func isHAOrchestrateable(objStatus object.Status) (bool, string) {
+	if (objStatus.Topology == topology.Failover) && (objStatus.Avail == status.Warn) {
+		return false, "failover object is warn state"
+	}
+	switch objStatus.Provisioned {
+	case provisioned.Mixed:
+		return false, "mixed object provisioned state"
+	case provisioned.False:
+		return false, "false object provisioned state"
+	}
+	return true, ""
+}
+
+func isStartable(objStatus object.Status) (bool, string) {
+	if v, reason := isHAOrchestrateable(objStatus); !v {
+		return false, reason
+	}
+	if isStarted(objStatus) {
+		return false, "already started"
+	}
+	return true, "object is startable"
+}
+
+func isStarted(objStatus object.Status) bool {
+	switch objStatus.Topology {
+	case topology.Flex:
+		return objStatus.UpInstancesCount >= objStatus.FlexTarget
+	case topology.Failover:
+		return objStatus.Avail == status.Up
+	default:
+		return false
+	}
+}
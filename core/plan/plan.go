@@ -0,0 +1,309 @@
+// Package plan computes, without side effects, the instance actions the
+// daemon orchestrations would execute to reach a new object global expect
+// or a new node local expect.
+//
+// The evaluation mirrors the daemon imon and nmon orchestration rules
+// against a Snapshot of the cluster data, so operators can review a switch,
+// giveback, start, stop, drain or shutdown before submitting it.
+package plan
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/priority"
+	"github.com/opensvc/om3/core/rawconfig"
+	"github.com/opensvc/om3/util/render/tree"
+)
+
+type (
+	// Action is the name of an action the daemon would execute on an
+	// instance or a node.
+	Action string
+
+	// Step is an action the daemon would execute on an instance, or on a
+	// node when Path is empty.
+	Step struct {
+		// Order is the rank of the step in the plan. Steps with the same
+		// order can run in parallel.
+		Order  int    `json:"order"`
+		Path   string `json:"path"`
+		Node   string `json:"node"`
+		Action Action `json:"action"`
+		Reason string `json:"reason,omitempty"`
+
+		priority priority.T
+		parents  []relationStatus
+		children []relationStatus
+	}
+
+	// Refusal explains why an instance or an object would not reach the
+	// orchestration target.
+	Refusal struct {
+		Path   string `json:"path"`
+		Node   string `json:"node,omitempty"`
+		Reason string `json:"reason"`
+	}
+
+	// T is the plan of an orchestration.
+	T struct {
+		// Target is the object global expect or the node local expect
+		// the plan was evaluated for.
+		Target  string    `json:"target"`
+		Steps   []Step    `json:"steps"`
+		Refused []Refusal `json:"refused"`
+	}
+)
+
+const (
+	ActionFreeze   Action = "freeze"
+	ActionShutdown Action = "shutdown"
+	ActionStart    Action = "start"
+	ActionStop     Action = "stop"
+	ActionUnfreeze Action = "unfreeze"
+)
+
+func (t Action) String() string {
+	return string(t)
+}
+
+// phase returns the rank of the action family in a plan. Freeze and
+// unfreeze precede stops, and stops precede starts.
+func (t Action) phase() int {
+	switch t {
+	case ActionFreeze, ActionUnfreeze:
+		return 0
+	case ActionShutdown, ActionStop:
+		return 1
+	default:
+		return 2
+	}
+}
+
+func newT(target string) T {
+	return T{
+		Target:  target,
+		Steps:   make([]Step, 0),
+		Refused: make([]Refusal, 0),
+	}
+}
+
+// IsEmpty returns true if the plan has no step and no refusal.
+func (t T) IsEmpty() bool {
+	return len(t.Steps) == 0 && len(t.Refused) == 0
+}
+
+// Merge appends the steps and refusals of other plans, and reorders the
+// resulting steps.
+func (t T) Merge(others ...T) T {
+	for _, other := range others {
+		t.Steps = append(t.Steps, other.Steps...)
+		t.Refused = append(t.Refused, other.Refused...)
+	}
+	t.order()
+	return t
+}
+
+func (t *T) addStep(step Step) {
+	t.Steps = append(t.Steps, step)
+}
+
+func (t *T) refuse(p naming.Path, nodename string, format string, args ...any) {
+	t.Refused = append(t.Refused, Refusal{
+		Path:   p.String(),
+		Node:   nodename,
+		Reason: fmt.Sprintf(format, args...),
+	})
+}
+
+// refuseSteps moves to the refused list the <action> steps of the object.
+func (t *T) refuseSteps(p naming.Path, action Action, format string, args ...any) {
+	steps := make([]Step, 0, len(t.Steps))
+	for _, step := range t.Steps {
+		if step.Path == p.String() && step.Action == action {
+			t.refuse(p, step.Node, format, args...)
+			continue
+		}
+		steps = append(steps, step)
+	}
+	t.Steps = steps
+}
+
+func (t T) hasStep(p naming.Path, nodename string, actions ...Action) bool {
+	for _, step := range t.Steps {
+		if step.Path != p.String() {
+			continue
+		}
+		if nodename != "" && step.Node != nodename {
+			continue
+		}
+		for _, action := range actions {
+			if step.Action == action {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// resolveWaits moves to the refused list the steps waiting for a parent or
+// a child relation that is not ready and that the plan does not bring to the
+// expected state.
+func (t *T) resolveWaits() {
+	steps := make([]Step, 0, len(t.Steps))
+	for _, step := range t.Steps {
+		var blocking []string
+		for _, r := range step.parents {
+			if !r.ready && !t.hasStep(r.path, r.node, ActionStart) {
+				blocking = append(blocking, fmt.Sprintf("parent %s is %s", r.relation, r.avail))
+			}
+		}
+		for _, r := range step.children {
+			if !r.ready && !t.hasStep(r.path, r.node, ActionStop, ActionShutdown) {
+				blocking = append(blocking, fmt.Sprintf("child %s is %s", r.relation, r.avail))
+			}
+		}
+		if len(blocking) > 0 {
+			t.Refused = append(t.Refused, Refusal{
+				Path:   step.Path,
+				Node:   step.Node,
+				Reason: fmt.Sprintf("%s would wait: %s", step.Action, strings.Join(blocking, ", ")),
+			})
+			continue
+		}
+		steps = append(steps, step)
+	}
+	t.Steps = steps
+}
+
+// order sorts the steps and sets their Order.
+//
+// Freeze and unfreeze steps come first, then stops ordered children first,
+// then starts ordered parents first. Within a dependency level, the steps
+// are ordered by ascending priority value.
+func (t *T) order() {
+	depth := make(map[int]int)
+	var depthOf func(i int, seen map[int]bool) int
+	depthOf = func(i int, seen map[int]bool) int {
+		if d, ok := depth[i]; ok {
+			return d
+		}
+		if seen[i] {
+			// relation loop
+			return 0
+		}
+		seen[i] = true
+		d := 0
+		step := t.Steps[i]
+		var deps []relationStatus
+		switch step.Action.phase() {
+		case 1:
+			deps = step.children
+		case 2:
+			deps = step.parents
+		}
+		for _, r := range deps {
+			for j, other := range t.Steps {
+				if j == i || other.Path != r.path.String() || other.Action.phase() != step.Action.phase() {
+					continue
+				}
+				if r.node != "" && other.Node != r.node {
+					continue
+				}
+				if dd := depthOf(j, seen) + 1; dd > d {
+					d = dd
+				}
+			}
+		}
+		depth[i] = d
+		return d
+	}
+	for i := range t.Steps {
+		depthOf(i, make(map[int]bool))
+	}
+	type sortable struct {
+		step  Step
+		depth int
+	}
+	l := make([]sortable, len(t.Steps))
+	for i, step := range t.Steps {
+		l[i] = sortable{step: step, depth: depth[i]}
+	}
+	sort.SliceStable(l, func(i, j int) bool {
+		a, b := l[i], l[j]
+		if pa, pb := a.step.Action.phase(), b.step.Action.phase(); pa != pb {
+			return pa < pb
+		}
+		if a.depth != b.depth {
+			return a.depth < b.depth
+		}
+		if a.step.priority != b.step.priority {
+			return a.step.priority < b.step.priority
+		}
+		if a.step.Path != b.step.Path {
+			return a.step.Path < b.step.Path
+		}
+		return a.step.Node < b.step.Node
+	})
+	order := 0
+	for i := range l {
+		if i > 0 {
+			a, b := l[i-1], l[i]
+			if a.step.Action.phase() != b.step.Action.phase() || a.depth != b.depth || a.step.priority != b.step.priority {
+				order++
+			}
+		}
+		l[i].step.Order = order
+		t.Steps[i] = l[i].step
+	}
+	sort.SliceStable(t.Refused, func(i, j int) bool {
+		if t.Refused[i].Path != t.Refused[j].Path {
+			return t.Refused[i].Path < t.Refused[j].Path
+		}
+		return t.Refused[i].Node < t.Refused[j].Node
+	})
+}
+
+// Render returns a human friendly string representation of the plan.
+func (t T) Render() string {
+	tr := tree.New()
+	tr.AddColumn().AddText("plan").SetColor(rawconfig.Color.Bold)
+	tr.AddColumn().AddText(t.Target).SetColor(rawconfig.Color.Primary)
+	steps := tr.AddNode()
+	steps.AddColumn().AddText("steps").SetColor(rawconfig.Color.Bold)
+	if len(t.Steps) == 0 {
+		steps.AddColumn().AddText("nothing to do")
+	}
+	for _, step := range t.Steps {
+		n := steps.AddNode()
+		n.AddColumn().AddText(fmt.Sprintf("%d", step.Order))
+		n.AddColumn().AddText(step.Action.String()).SetColor(rawconfig.Color.Primary)
+		if step.Path == "" {
+			n.AddColumn().AddText("node " + step.Node)
+		} else {
+			n.AddColumn().AddText(step.Path + "@" + step.Node)
+		}
+		n.AddColumn().AddText(step.Reason).SetColor(rawconfig.Color.Secondary)
+	}
+	if len(t.Refused) == 0 {
+		return tr.Render()
+	}
+	refused := tr.AddNode()
+	refused.AddColumn().AddText("refused").SetColor(rawconfig.Color.Bold)
+	for _, refusal := range t.Refused {
+		n := refused.AddNode()
+		s := refusal.Path
+		if refusal.Node != "" {
+			if s == "" {
+				s = "node " + refusal.Node
+			} else {
+				s += "@" + refusal.Node
+			}
+		}
+		n.AddColumn().AddText(s)
+		n.AddColumn().AddText(refusal.Reason).SetColor(rawconfig.Color.Error)
+	}
+	return tr.Render()
+}
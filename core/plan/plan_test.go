@@ -0,0 +1,308 @@
+package plan
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/node"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/placement"
+	"github.com/opensvc/om3/core/priority"
+	"github.com/opensvc/om3/core/provisioned"
+	"github.com/opensvc/om3/core/status"
+	"github.com/opensvc/om3/core/topology"
+)
+
+type (
+	testInstance struct {
+		avail    status.T
+		frozen   bool
+		parents  []string
+		children []string
+	}
+
+	testObject struct {
+		path      string
+		topology  topology.T
+		priority  priority.T
+		instances map[string]testInstance
+	}
+
+	testStep struct {
+		order  int
+		path   string
+		node   string
+		action Action
+	}
+)
+
+var (
+	testNodes = []string{"node1", "node2"}
+)
+
+func mustParsePath(s string) naming.Path {
+	p, err := naming.ParsePath(s)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+func newTestSnapshot(t *testing.T, objects ...testObject) *Snapshot {
+	t.Helper()
+	s := NewSnapshot()
+	for _, nodename := range testNodes {
+		s.NodeMonitor[nodename] = node.Monitor{State: node.MonitorStateIdle}
+		s.NodeStatus[nodename] = node.Status{}
+	}
+	for _, o := range objects {
+		p := mustParsePath(o.path)
+		prio := o.priority
+		if prio == 0 {
+			prio = priority.Default
+		}
+		objStatus := object.Status{
+			Avail:           status.Down,
+			Orchestrate:     "ha",
+			PlacementPolicy: placement.NodesOrder,
+			Priority:        prio,
+			Provisioned:     provisioned.True,
+			Scope:           testNodes,
+			Topology:        o.topology,
+			FlexTarget:      1,
+		}
+		for _, nodename := range testNodes {
+			inst, ok := o.instances[nodename]
+			if !ok {
+				inst = testInstance{avail: status.Down}
+			}
+			if inst.avail == status.Up {
+				objStatus.Avail = status.Up
+				objStatus.UpInstancesCount++
+			}
+			instStatus := instance.Status{
+				Avail:       inst.avail,
+				Provisioned: provisioned.True,
+			}
+			if inst.frozen {
+				instStatus.FrozenAt = time.Now()
+			}
+			cfg := instance.Config{
+				Priority: prio,
+				Scope:    testNodes,
+			}
+			for _, s := range inst.parents {
+				cfg.Parents = append(cfg.Parents, naming.Relation(s))
+			}
+			for _, s := range inst.children {
+				cfg.Children = append(cfg.Children, naming.Relation(s))
+			}
+			s.SetInstanceStatus(p, nodename, instStatus)
+			s.SetInstanceConfig(p, nodename, cfg)
+			s.SetInstanceMonitor(p, nodename, instance.Monitor{State: instance.MonitorStateIdle})
+		}
+		s.ObjectStatus[p] = objStatus
+	}
+	return s
+}
+
+func assertSteps(t *testing.T, expected []testStep, plan T) {
+	t.Helper()
+	found := make([]testStep, len(plan.Steps))
+	for i, step := range plan.Steps {
+		found[i] = testStep{
+			order:  step.Order,
+			path:   step.Path,
+			node:   step.Node,
+			action: step.Action,
+		}
+	}
+	assert.Equal(t, expected, found)
+}
+
+func TestSnapshot(t *testing.T) {
+	up := map[string]testInstance{"node1": {avail: status.Up}}
+	cases := map[string]struct {
+		objects       []testObject
+		eval          func(s *Snapshot) T
+		expectedSteps []testStep
+		refused       int
+	}{
+		"switch failover": {
+			objects: []testObject{{path: "svc1", topology: topology.Failover, instances: up}},
+			eval:    func(s *Snapshot) T { return s.Switch(mustParsePath("svc1"), nil) },
+			expectedSteps: []testStep{
+				{order: 0, path: "svc1", node: "node1", action: ActionStop},
+				{order: 1, path: "svc1", node: "node2", action: ActionStart},
+			},
+		},
+		"switch waits a down parent": {
+			objects: []testObject{
+				{path: "db", topology: topology.Failover, instances: up},
+				{path: "app", topology: topology.Failover, instances: map[string]testInstance{
+					"node1": {avail: status.Up, parents: []string{"db@node2"}},
+					"node2": {avail: status.Down, parents: []string{"db@node2"}},
+				}},
+			},
+			eval: func(s *Snapshot) T { return s.Switch(mustParsePath("app"), nil) },
+			expectedSteps: []testStep{
+				{order: 0, path: "app", node: "node1", action: ActionStop},
+			},
+			refused: 1,
+		},
+		"switch waits children stop": {
+			objects: []testObject{
+				{path: "db", topology: topology.Failover, instances: map[string]testInstance{
+					"node1": {avail: status.Up, children: []string{"app"}},
+				}},
+				{path: "app", topology: topology.Failover, instances: up},
+			},
+			eval:          func(s *Snapshot) T { return s.Switch(mustParsePath("db"), nil) },
+			expectedSteps: []testStep{},
+			refused:       2,
+		},
+		"switch to unknown node": {
+			objects: []testObject{{path: "svc1", topology: topology.Failover, instances: up}},
+			eval: func(s *Snapshot) T {
+				return s.Switch(mustParsePath("svc1"), []string{"node3"})
+			},
+			expectedSteps: []testStep{},
+			refused:       1,
+		},
+		"switch refused during orchestration": {
+			objects: []testObject{{path: "svc1", topology: topology.Failover, instances: up}},
+			eval: func(s *Snapshot) T {
+				p := mustParsePath("svc1")
+				s.SetInstanceMonitor(p, "node1", instance.Monitor{OrchestrationID: uuid.New()})
+				return s.Switch(p, nil)
+			},
+			expectedSteps: []testStep{},
+			refused:       1,
+		},
+		"giveback": {
+			objects: []testObject{{path: "svc1", topology: topology.Failover, instances: map[string]testInstance{
+				"node2": {avail: status.Up},
+			}}},
+			eval: func(s *Snapshot) T { return s.Giveback(mustParsePath("svc1")) },
+			expectedSteps: []testStep{
+				{order: 0, path: "svc1", node: "node2", action: ActionStop},
+				{order: 1, path: "svc1", node: "node1", action: ActionStart},
+			},
+		},
+		"start frozen": {
+			objects: []testObject{{path: "svc1", topology: topology.Failover, instances: map[string]testInstance{
+				"node1": {avail: status.Down, frozen: true},
+			}}},
+			eval: func(s *Snapshot) T { return s.Start(mustParsePath("svc1")) },
+			expectedSteps: []testStep{
+				{order: 0, path: "svc1", node: "node1", action: ActionUnfreeze},
+				{order: 1, path: "svc1", node: "node1", action: ActionStart},
+			},
+		},
+		"start already started": {
+			objects:       []testObject{{path: "svc1", topology: topology.Failover, instances: up}},
+			eval:          func(s *Snapshot) T { return s.Start(mustParsePath("svc1")) },
+			expectedSteps: []testStep{},
+			refused:       1,
+		},
+		"start waits a down parent": {
+			objects: []testObject{
+				{path: "svc1", topology: topology.Failover},
+				{path: "svc2", topology: topology.Failover, instances: map[string]testInstance{
+					"node1": {avail: status.Down, parents: []string{"svc1"}},
+				}},
+			},
+			eval:          func(s *Snapshot) T { return s.Start(mustParsePath("svc2")) },
+			expectedSteps: []testStep{},
+			refused:       1,
+		},
+		"stop": {
+			objects: []testObject{{path: "svc1", topology: topology.Failover, instances: up}},
+			eval:    func(s *Snapshot) T { return s.Stop(mustParsePath("svc1")) },
+			expectedSteps: []testStep{
+				{order: 0, path: "svc1", node: "node1", action: ActionFreeze},
+				{order: 0, path: "svc1", node: "node2", action: ActionFreeze},
+				{order: 1, path: "svc1", node: "node1", action: ActionStop},
+			},
+		},
+		"drain honors priority and parents": {
+			objects: []testObject{
+				{path: "db", topology: topology.Failover, priority: 10, instances: up},
+				{path: "app", topology: topology.Failover, instances: map[string]testInstance{
+					"node1": {avail: status.Up, parents: []string{"db"}},
+					"node2": {avail: status.Down, parents: []string{"db"}},
+				}},
+				{path: "web", topology: topology.Failover, priority: 90, instances: up},
+			},
+			eval: func(s *Snapshot) T { return s.Drain("node1") },
+			expectedSteps: []testStep{
+				{order: 0, path: "", node: "node1", action: ActionFreeze},
+				{order: 1, path: "db", node: "node1", action: ActionShutdown},
+				{order: 2, path: "app", node: "node1", action: ActionShutdown},
+				{order: 3, path: "web", node: "node1", action: ActionShutdown},
+				{order: 4, path: "db", node: "node2", action: ActionStart},
+				{order: 5, path: "web", node: "node2", action: ActionStart},
+				{order: 6, path: "app", node: "node2", action: ActionStart},
+			},
+		},
+		"drain without takeover candidate": {
+			objects: []testObject{{path: "svc1", topology: topology.Failover, instances: map[string]testInstance{
+				"node1": {avail: status.Up},
+				"node2": {avail: status.Down, frozen: true},
+			}}},
+			eval: func(s *Snapshot) T { return s.Drain("node1") },
+			expectedSteps: []testStep{
+				{order: 0, path: "", node: "node1", action: ActionFreeze},
+				{order: 1, path: "svc1", node: "node1", action: ActionShutdown},
+			},
+			refused: 1,
+		},
+		"shutdown waits children": {
+			objects: []testObject{
+				{path: "db", topology: topology.Failover, instances: map[string]testInstance{
+					"node1": {avail: status.Up, children: []string{"app"}},
+				}},
+				{path: "app", topology: topology.Failover, instances: up},
+			},
+			eval: func(s *Snapshot) T { return s.Shutdown("node1") },
+			expectedSteps: []testStep{
+				{order: 0, path: "app", node: "node1", action: ActionShutdown},
+				{order: 1, path: "db", node: "node1", action: ActionShutdown},
+				{order: 2, path: "app", node: "node2", action: ActionStart},
+				{order: 2, path: "db", node: "node2", action: ActionStart},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			plan := tc.eval(newTestSnapshot(t, tc.objects...))
+			assertSteps(t, tc.expectedSteps, plan)
+			assert.Lenf(t, plan.Refused, tc.refused, "refused: %+v", plan.Refused)
+			assert.NotEmpty(t, plan.Render())
+		})
+	}
+}
+
+func TestCandidateSorterShift(t *testing.T) {
+	candidates := []string{"n3", "n1", "n2"}
+	for name, expected := range map[string][]string{
+		"1.svc1": {"n1", "n2", "n3"},
+		"4.svc1": {"n2", "n3", "n1"},
+		"5.svc1": {"n3", "n1", "n2"},
+		"svc1":   {"n1", "n2", "n3"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			sorter := CandidateSorter{
+				Path:   naming.Path{Namespace: "root", Kind: naming.KindSvc, Name: name},
+				Policy: placement.Shift,
+				Scope:  []string{"n1", "n2", "n3"},
+			}
+			assert.Equal(t, expected, sorter.Sort(candidates))
+		})
+	}
+}
@@ -0,0 +1,257 @@
+package plan
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/node"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/provisioned"
+	"github.com/opensvc/om3/core/status"
+	"github.com/opensvc/om3/core/topology"
+)
+
+type (
+	// Snapshot is a copy of the cluster data the orchestrations base their
+	// decisions on.
+	Snapshot struct {
+		ObjectStatus    map[naming.Path]object.Status
+		InstanceConfig  map[naming.Path]map[string]instance.Config
+		InstanceMonitor map[naming.Path]map[string]instance.Monitor
+		InstanceStatus  map[naming.Path]map[string]instance.Status
+		NodeMonitor     map[string]node.Monitor
+		NodeStats       map[string]node.Stats
+		NodeStatus      map[string]node.Status
+	}
+
+	relationStatus struct {
+		relation naming.Relation
+		path     naming.Path
+		node     string
+		avail    status.T
+
+		// ready is true when the relation avail status does not make the
+		// instance monitor wait.
+		ready bool
+	}
+)
+
+// NewSnapshot returns an empty Snapshot.
+func NewSnapshot() *Snapshot {
+	return &Snapshot{
+		ObjectStatus:    make(map[naming.Path]object.Status),
+		InstanceConfig:  make(map[naming.Path]map[string]instance.Config),
+		InstanceMonitor: make(map[naming.Path]map[string]instance.Monitor),
+		InstanceStatus:  make(map[naming.Path]map[string]instance.Status),
+		NodeMonitor:     make(map[string]node.Monitor),
+		NodeStats:       make(map[string]node.Stats),
+		NodeStatus:      make(map[string]node.Status),
+	}
+}
+
+// NewSnapshotFromData returns a Snapshot loaded from the object, instance and
+// node data holders maintained by the daemon.
+func NewSnapshotFromData() *Snapshot {
+	s := NewSnapshot()
+	for _, e := range object.StatusData.GetAll() {
+		s.ObjectStatus[e.Path] = *e.Value.DeepCopy()
+	}
+	for _, e := range instance.ConfigData.GetAll() {
+		s.SetInstanceConfig(e.Path, e.Node, *e.Value.DeepCopy())
+	}
+	for _, e := range instance.MonitorData.GetAll() {
+		s.SetInstanceMonitor(e.Path, e.Node, *e.Value)
+	}
+	for _, e := range instance.StatusData.GetAll() {
+		s.SetInstanceStatus(e.Path, e.Node, *e.Value.DeepCopy())
+	}
+	for _, e := range node.MonitorData.GetAll() {
+		s.NodeMonitor[e.Node] = *e.Value.DeepCopy()
+	}
+	for _, e := range node.StatsData.GetAll() {
+		s.NodeStats[e.Node] = *e.Value.DeepCopy()
+	}
+	for _, e := range node.StatusData.GetAll() {
+		s.NodeStatus[e.Node] = *e.Value.DeepCopy()
+	}
+	return s
+}
+
+// SetInstanceConfig adds or replaces an instance config in the snapshot.
+func (s *Snapshot) SetInstanceConfig(p naming.Path, nodename string, v instance.Config) {
+	if _, ok := s.InstanceConfig[p]; !ok {
+		s.InstanceConfig[p] = make(map[string]instance.Config)
+	}
+	s.InstanceConfig[p][nodename] = v
+}
+
+// SetInstanceMonitor adds or replaces an instance monitor in the snapshot.
+func (s *Snapshot) SetInstanceMonitor(p naming.Path, nodename string, v instance.Monitor) {
+	if _, ok := s.InstanceMonitor[p]; !ok {
+		s.InstanceMonitor[p] = make(map[string]instance.Monitor)
+	}
+	s.InstanceMonitor[p][nodename] = v
+}
+
+// SetInstanceStatus adds or replaces an instance status in the snapshot.
+func (s *Snapshot) SetInstanceStatus(p naming.Path, nodename string, v instance.Status) {
+	if _, ok := s.InstanceStatus[p]; !ok {
+		s.InstanceStatus[p] = make(map[string]instance.Status)
+	}
+	s.InstanceStatus[p][nodename] = v
+}
+
+// scope returns the object scope nodes, ordered as configured.
+func (s *Snapshot) scope(p naming.Path) []string {
+	if objStatus, ok := s.ObjectStatus[p]; ok && len(objStatus.Scope) > 0 {
+		return objStatus.Scope
+	}
+	for _, cfg := range s.InstanceConfig[p] {
+		return cfg.Scope
+	}
+	return nil
+}
+
+func (s *Snapshot) candidateSorter(p naming.Path) CandidateSorter {
+	return CandidateSorter{
+		Path:           p,
+		Policy:         s.ObjectStatus[p].PlacementPolicy,
+		Scope:          s.scope(p),
+		NodeStats:      s.NodeStats,
+		InstanceStatus: s.InstanceStatus[p],
+	}
+}
+
+// orchestrationInProgress returns the running orchestration id and global
+// expect of the object, if any.
+func (s *Snapshot) orchestrationInProgress(p naming.Path) (uuid.UUID, instance.MonitorGlobalExpect, bool) {
+	for _, instMon := range s.InstanceMonitor[p] {
+		if instMon.OrchestrationID != uuid.Nil {
+			return instMon.OrchestrationID, instMon.GlobalExpect, true
+		}
+	}
+	return uuid.Nil, instance.MonitorGlobalExpectZero, false
+}
+
+// haLeaders returns the nodes where the instance monitors would elect the
+// object instances as HA leaders.
+//
+// When ignoreInstanceFrozen is true, the frozen instances are considered
+// candidates, as the orchestration unfreezes them before the election
+// matters. Nodes listed in excluded are not candidates.
+func (s *Snapshot) haLeaders(p naming.Path, ignoreInstanceFrozen bool, excluded ...string) []string {
+	var candidates []string
+	objStatus := s.ObjectStatus[p]
+	isExcluded := func(nodename string) bool {
+		for _, e := range excluded {
+			if e == nodename {
+				return true
+			}
+		}
+		return false
+	}
+	for _, nodename := range s.scope(p) {
+		if isExcluded(nodename) {
+			continue
+		}
+		instStatus, ok := s.InstanceStatus[p][nodename]
+		if !ok || instStatus.Avail == status.NotApplicable {
+			continue
+		}
+		if nodeStatus, ok := s.NodeStatus[nodename]; !ok || nodeStatus.IsFrozen() {
+			continue
+		}
+		if !ignoreInstanceFrozen && instStatus.IsFrozen() {
+			continue
+		}
+		if instStatus.Provisioned.IsOneOf(provisioned.Mixed, provisioned.False) {
+			continue
+		}
+		if instMon, ok := s.InstanceMonitor[p][nodename]; !ok || instMon.State == instance.MonitorStateStartFailed {
+			continue
+		}
+		if nodeMonitor, ok := s.NodeMonitor[nodename]; !ok || !nodeMonitor.State.IsRankable() {
+			continue
+		}
+		candidates = append(candidates, nodename)
+	}
+	candidates = s.candidateSorter(p).Sort(candidates)
+	maxLeaders := 1
+	if objStatus.Topology == topology.Flex {
+		maxLeaders = objStatus.FlexTarget
+	}
+	if len(candidates) > maxLeaders {
+		candidates = candidates[:maxLeaders]
+	}
+	return candidates
+}
+
+// parents returns the parent relations of the instance. A parent is ready
+// if its avail status does not make the instance monitor wait before
+// starting.
+func (s *Snapshot) parents(p naming.Path, nodename string) []relationStatus {
+	cfg, ok := s.InstanceConfig[p][nodename]
+	if !ok {
+		return nil
+	}
+	return s.relations(cfg.Parents, status.Up, status.Undef)
+}
+
+// children returns the children relations of the instance. A child is
+// ready if its avail status does not make the instance monitor wait before
+// stopping.
+func (s *Snapshot) children(p naming.Path, nodename string) []relationStatus {
+	cfg, ok := s.InstanceConfig[p][nodename]
+	if !ok {
+		return nil
+	}
+	return s.relations(cfg.Children, status.Down, status.StandbyDown, status.StandbyUp, status.Undef, status.NotApplicable)
+}
+
+func (s *Snapshot) relations(relations naming.Relations, readyStates ...status.T) []relationStatus {
+	var l []relationStatus
+	for _, relation := range relations {
+		relationPath, relationNode, err := relation.Split()
+		if err != nil {
+			continue
+		}
+		avail := status.Undef
+		if relationNode == "" {
+			if objStatus, ok := s.ObjectStatus[relationPath]; ok {
+				avail = objStatus.Avail
+			}
+		} else if instStatus, ok := s.InstanceStatus[relationPath][relationNode]; ok {
+			avail = instStatus.Avail
+		}
+		l = append(l, relationStatus{
+			relation: relation,
+			path:     relationPath,
+			node:     relationNode,
+			avail:    avail,
+			ready:    avail.Is(readyStates...),
+		})
+	}
+	return l
+}
+
+// nodeCanOrchestrate returns false and a reason if the instance monitor of
+// the node would not orchestrate because of its node monitor state.
+func (s *Snapshot) nodeCanOrchestrate(nodename string) (bool, string) {
+	nodeMonitor, ok := s.NodeMonitor[nodename]
+	if !ok {
+		return false, "node monitor not found"
+	}
+	if nodeMonitor.State != node.MonitorStateIdle {
+		return false, "node monitor state is " + nodeMonitor.State.String()
+	}
+	return true, ""
+}
+
+func isLocalStarted(avail status.T) bool {
+	return avail.Is(status.NotApplicable, status.Up)
+}
+
+func isLocalStopped(avail status.T) bool {
+	return avail.Is(status.NotApplicable, status.Undef, status.Down, status.StandbyUp, status.StandbyDown)
+}
@@ -96,7 +96,12 @@ func (t Lookup) Do() (Pooler, error) {
 				cause = append(cause, fmt.Sprintf("[%s] no usage data: %s", p.Name(), err))
 				continue
 			}
-			if usage.Size > 0 && (usage.Free < t.Size) {
+			if o, ok := p.(Overcommitter); ok {
+				if err := o.CanOvercommit(t.Size); err != nil {
+					cause = append(cause, fmt.Sprintf("[%s] %s", p.Name(), err))
+					continue
+				}
+			} else if usage.Size > 0 && (usage.Free < t.Size) {
 				cause = append(cause, fmt.Sprintf("[%s] not enough free space: %s free, %s requested",
 					p.Name(), sizeconv.BSize(float64(usage.Free)), sizeconv.BSize(float64(t.Size))))
 				continue
@@ -34,6 +34,15 @@ type (
 		Used int64 `json:"used"`
 		// Size unit is Bytes
 		Size int64 `json:"size"`
+
+		// DataPercent and MetadataPercent are the data and metadata space
+		// allocation percentages reported by the thin provisioning pools.
+		DataPercent     float64 `json:"data_percent,omitempty"`
+		MetadataPercent float64 `json:"metadata_percent,omitempty"`
+
+		// Overcommit is the ratio of the volumes virtual sizes to the
+		// pool size, reported by the thin provisioning pools.
+		Overcommit float64 `json:"overcommit,omitempty"`
 	}
 
 	Status struct {
@@ -79,6 +88,11 @@ type (
 		Config() Config
 		Separator() string
 	}
+	// Validater is implemented by the pool drivers able to report the
+	// invalid values of their keywords.
+	Validater interface {
+		Validate() error
+	}
 	ArrayPooler interface {
 		Pooler
 		GetTargets() (san.Targets, error)
@@ -91,6 +105,22 @@ type (
 	BlkTranslater interface {
 		BlkTranslate(name string, size int64, shared bool) ([]string, error)
 	}
+
+	// Overcommitter is implemented by the thin provisioning pool drivers,
+	// which accept volumes larger than their free space, up to an
+	// overcommit limit.
+	Overcommitter interface {
+		// CanOvercommit returns an error if adding a volume of <size>
+		// bytes would exceed the pool overcommit limit.
+		CanOvercommit(size int64) error
+	}
+	// Resizer is implemented by the pool drivers able to grow the
+	// storage backing their volumes. ResizeVolume must be a no-op if
+	// the volume is already <size> bytes or larger, so a resize
+	// interrupted in a later layer can be replayed.
+	Resizer interface {
+		ResizeVolume(volume string, size int64) error
+	}
 	volumer interface {
 		FQDN() string
 		Set(context.Context, ...keyop.T) error
@@ -207,13 +237,16 @@ func GetStatus(t Pooler, withUsage bool) Status {
 	data.Name = t.Name()
 	data.Capabilities = t.Capabilities()
 	data.Head = t.Head()
+	if o, ok := t.(Validater); ok {
+		if err := o.Validate(); err != nil {
+			data.Errors = append(data.Errors, err.Error())
+		}
+	}
 	if withUsage {
 		if usage, err := t.Usage(); err != nil {
 			data.Errors = append(data.Errors, err.Error())
 		} else {
-			data.Usage.Free = usage.Free
-			data.Usage.Used = usage.Used
-			data.Usage.Size = usage.Size
+			data.Usage = usage
 		}
 	}
 	return data
@@ -247,6 +280,19 @@ func (t *T) GetSize(s string) *int64 {
 	return t.Config().GetSize(k)
 }
 
+func (t *T) GetFloat64(s string) (float64, error) {
+	k := pk(t.name, s)
+	v, err := t.Config().Eval(k)
+	if err != nil {
+		return 0, err
+	}
+	f, ok := v.(float64)
+	if !ok {
+		return 0, fmt.Errorf("%s: expected a float, got %v", k, v)
+	}
+	return f, nil
+}
+
 func (t *T) MkfsOptions() string {
 	return t.GetString("mkfs_opt")
 }
@@ -354,15 +400,23 @@ func ConfigureVolume(p Pooler, vol volumer, size int64, format bool, acs volacce
 		return err
 	}
 	kws = append(kws, env...)
+	kws = volumeKeywords(p, kws, size, acs, nodes)
+	if err := vol.Set(context.Background(), keyop.ParseOps(kws)...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// volumeKeywords returns the <kws> volume resources keywords returned by
+// the <p> pool driver, completed with the vol object keywords common to
+// the new, migrated and cloned volumes.
+func volumeKeywords(p Pooler, kws []string, size int64, acs volaccess.T, nodes []string) []string {
 	kws = append(kws, baseKeywords(p, size, acs)...)
 	kws = append(kws, flexKeywords(acs)...)
 	kws = append(kws, nodeKeywords(nodes)...)
 	kws = append(kws, statusScheduleKeywords(p)...)
 	kws = append(kws, syncKeywords()...)
-	if err := vol.Set(context.Background(), keyop.ParseOps(kws)...); err != nil {
-		return err
-	}
-	return nil
+	return kws
 }
 
 func translate(p Pooler, name string, size int64, format bool, shared bool) ([]string, error) {
@@ -0,0 +1,32 @@
+package pool
+
+import (
+	"github.com/opensvc/om3/core/volaccess"
+)
+
+type (
+	// Sender is implemented by the pool drivers able to copy a volume to
+	// another pool of the same driver, sending only the changes made since
+	// the previous copy.
+	Sender interface {
+		// SendVolume copies the <volume> data to the volume of the same
+		// name in the <dst> pool. The first call copies all the data, the
+		// next calls only the changes since the previous call.
+		SendVolume(volume string, dst Pooler) error
+
+		// ForgetSendVolume removes the references kept by SendVolume to
+		// send the next changes.
+		ForgetSendVolume(volume string, dst Pooler) error
+	}
+)
+
+// MigrationKeywords returns the keywords of a vol object using a new
+// volume of the <p> pool, named <name> like the volume to migrate so the
+// vol object can adopt these keywords once the data is copied.
+func MigrationKeywords(p Pooler, name string, size int64, format bool, acs volaccess.T, shared bool, nodes []string) ([]string, error) {
+	kws, err := translate(p, name, size, format, shared)
+	if err != nil {
+		return nil, err
+	}
+	return volumeKeywords(p, kws, size, acs, nodes), nil
+}
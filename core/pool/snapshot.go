@@ -0,0 +1,88 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/opensvc/om3/core/keyop"
+	"github.com/opensvc/om3/core/volaccess"
+)
+
+type (
+	// Snapshot is a point-in-time copy of a pool volume.
+	Snapshot struct {
+		Name      string    `json:"name"`
+		Volume    string    `json:"volume"`
+		CreatedAt time.Time `json:"created_at"`
+
+		// Used is the space consumed by the snapshot, in bytes. It is
+		// zero if the driver can not report it.
+		Used int64 `json:"used"`
+	}
+	Snapshots []Snapshot
+
+	// Snapshotter is implemented by the pool drivers able to take
+	// point-in-time snapshots of their volumes. The <volume> arguments
+	// are the volume names passed to Translate or BlkTranslate.
+	Snapshotter interface {
+		CreateSnapshot(volume, name string) error
+		ListSnapshots(volume string) (Snapshots, error)
+		DeleteSnapshot(volume, name string) error
+
+		// RollbackSnapshot restores the volume data from a snapshot. The
+		// volume must not be in use.
+		RollbackSnapshot(volume, name string) error
+	}
+
+	// Cloner is implemented by the pool drivers able to create a volume
+	// initialized with the data of another volume of the pool.
+	Cloner interface {
+		// Clone copies the <src> volume data into a new <dst> volume and
+		// returns the <dst> volume keywords, as Translate or BlkTranslate
+		// would depending on <format>.
+		Clone(src, dst string, size int64, format bool, shared bool) ([]string, error)
+	}
+)
+
+var (
+	ErrSnapshotNotFound = errors.New("snapshot not found")
+
+	snapshotNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,63}$`)
+)
+
+// ValidateSnapshotName returns an error if the snapshot name is not
+// accepted by all the pool drivers.
+func ValidateSnapshotName(s string) error {
+	if !snapshotNameRegexp.MatchString(s) {
+		return fmt.Errorf("invalid snapshot name %q: expected 1 to 64 letters, digits, '_', '.' or '-', not starting with a '_', '.' or '-'", s)
+	}
+	return nil
+}
+
+// Find returns the snapshot named <name>.
+func (t Snapshots) Find(name string) (Snapshot, error) {
+	for _, e := range t {
+		if e.Name == name {
+			return e, nil
+		}
+	}
+	return Snapshot{}, fmt.Errorf("%w: %s", ErrSnapshotNotFound, name)
+}
+
+// CloneVolume copies the <src> volume data into a new volume of the same
+// pool, and configures the <dst> vol object to use it.
+func CloneVolume(p Pooler, src string, dst volumer, size int64, format bool, acs volaccess.T, shared bool, nodes []string) error {
+	o, ok := p.(Cloner)
+	if !ok {
+		return fmt.Errorf("pool %s does not support volume clones", p.Name())
+	}
+	kws, err := o.Clone(src, dst.FQDN(), size, format, shared)
+	if err != nil {
+		return err
+	}
+	kws = volumeKeywords(p, kws, size, acs, nodes)
+	return dst.Set(context.Background(), keyop.ParseOps(kws)...)
+}
@@ -0,0 +1,38 @@
+package pool
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSnapshotName(t *testing.T) {
+	cases := map[string]bool{
+		"snap1":                 true,
+		"s":                     true,
+		"0":                     true,
+		"before-upgrade_2.0":    true,
+		strings.Repeat("a", 64): true,
+		strings.Repeat("a", 65): false,
+		"":                      false,
+		"-snap":                 false,
+		"_snap":                 false,
+		".snap":                 false,
+		"..":                    false,
+		"snap/1":                false,
+		"snap 1":                false,
+		"snap@1":                false,
+		"snap:1":                false,
+	}
+	for name, valid := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateSnapshotName(name)
+			if valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
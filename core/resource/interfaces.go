@@ -75,6 +75,16 @@ type (
 		NetNSPath() (string, error)
 	}
 
+	// Resizer is implemented by the disk and fs drivers able to follow
+	// the growth of the pool volume backing their object. CanResize is
+	// called on every resource of the volume before any change, and
+	// Resize must be a no-op if the device or filesystem already has
+	// the requested size in bytes.
+	Resizer interface {
+		CanResize() error
+		Resize(ctx context.Context, size int64) error
+	}
+
 	// PIDer exposes a PID method a resource can call to
 	// get the head pid of the head process started by the resource.
 	// Typically a container resource PID() returns the pid of the
@@ -0,0 +1,318 @@
+package xconfig
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cvaroqui/ini"
+	"github.com/iancoleman/orderedmap"
+	"gopkg.in/yaml.v3"
+
+	"github.com/opensvc/om3/util/converters"
+	"github.com/opensvc/om3/util/key"
+)
+
+// A structured document is a json or yaml representation of a
+// configuration, lossless relative to the ini representation:
+//
+//	DEFAULT:
+//	  nodes: [n1, n2]
+//	  orchestrate: ha
+//	app#1:
+//	  start:
+//	    default: /srv/app start
+//	    "@n2": |
+//	      /srv/app start
+//	      --standby
+//
+// Sections are maps of keywords. The values of the keywords using a list
+// converter are arrays, the other values are strings. A keyword with scoped
+// values is a map of its values indexed by "@<scope>", with the unscoped
+// value indexed by "default".
+//
+// The ini-style flat keys, like "start@n2", are also accepted on load.
+
+const (
+	// DocumentFormatJSON is the json structured document format.
+	DocumentFormatJSON = "json"
+
+	// DocumentFormatYAML is the yaml structured document format.
+	DocumentFormatYAML = "yaml"
+
+	// documentUnscoped is the index of the unscoped value of a keyword
+	// with scoped values.
+	documentUnscoped = "default"
+)
+
+// IsDocument returns true if b is a json or yaml structured document,
+// false if b is an ini configuration.
+func IsDocument(b []byte) bool {
+	for _, line := range strings.Split(string(b), "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "":
+			continue
+		case line[0] == '#' || line[0] == ';':
+			continue
+		case line[0] == '[':
+			return false
+		}
+		_, err := documentToIni(b)
+		return err == nil
+	}
+	return false
+}
+
+// DocumentToIni returns the ini configuration represented by the
+// structured document b.
+func DocumentToIni(b []byte) ([]byte, error) {
+	f, err := documentToIni(b)
+	if err != nil {
+		return nil, err
+	}
+	ini.DefaultHeader = true
+	var buff bytes.Buffer
+	if _, err := f.WriteTo(&buff); err != nil {
+		return nil, err
+	}
+	return buff.Bytes(), nil
+}
+
+// SubDocuments returns the top-level keys of the structured document b,
+// and the documents they index, in order. It is used to split the
+// multi-objects documents indexed by object path.
+func SubDocuments(b []byte) ([]string, [][]byte, error) {
+	root, err := documentRoot(b)
+	if err != nil {
+		return nil, nil, err
+	}
+	keys := make([]string, 0, len(root.Content)/2)
+	docs := make([][]byte, 0, len(root.Content)/2)
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		sub, err := yaml.Marshal(resolveAlias(root.Content[i+1]))
+		if err != nil {
+			return nil, nil, err
+		}
+		keys = append(keys, root.Content[i].Value)
+		docs = append(docs, sub)
+	}
+	return keys, docs, nil
+}
+
+// MarshalDocument returns the json or yaml representation of a structured
+// document, as returned by T.Document. Many documents can be indexed in a
+// parent ordered map, like the object documents by object path.
+func MarshalDocument(doc *orderedmap.OrderedMap, format string) ([]byte, error) {
+	switch format {
+	case DocumentFormatJSON:
+		return json.MarshalIndent(doc, "", "    ")
+	case DocumentFormatYAML:
+		var buff bytes.Buffer
+		enc := yaml.NewEncoder(&buff)
+		enc.SetIndent(2)
+		if err := enc.Encode(documentNode(doc)); err != nil {
+			return nil, err
+		}
+		if err := enc.Close(); err != nil {
+			return nil, err
+		}
+		return buff.Bytes(), nil
+	default:
+		return nil, fmt.Errorf("unsupported document format: %s", format)
+	}
+}
+
+// Document returns the configuration as a structured document: the ordered
+// map of sections, each an ordered map of keyword values.
+func (t *T) Document() *orderedmap.OrderedMap {
+	doc := orderedmap.New()
+	for _, s := range t.file.Sections() {
+		if len(s.Keys()) == 0 {
+			continue
+		}
+		section := orderedmap.New()
+		scoped := make(map[string]bool)
+		for _, k := range s.Keys() {
+			if i := strings.Index(k.Name(), "@"); i > 0 {
+				scoped[k.Name()[:i]] = true
+			}
+		}
+		for _, k := range s.Keys() {
+			option, scope := k.Name(), ""
+			if i := strings.Index(option, "@"); i > 0 {
+				option, scope = option[:i], option[i:]
+			}
+			v := t.documentValue(key.New(s.Name(), option), k.Value())
+			if !scoped[option] {
+				section.Set(option, v)
+				continue
+			}
+			var scopes *orderedmap.OrderedMap
+			if i, ok := section.Get(option); ok {
+				scopes = i.(*orderedmap.OrderedMap)
+			} else {
+				scopes = orderedmap.New()
+				section.Set(option, scopes)
+			}
+			if scope == "" {
+				scope = documentUnscoped
+			}
+			scopes.Set(scope, v)
+		}
+		doc.Set(s.Name(), section)
+	}
+	return doc
+}
+
+// documentValue returns the document representation of the k keyword
+// value: an array if the keyword uses a list converter, the string value
+// otherwise.
+func (t *T) documentValue(k key.T, v string) any {
+	kw, err := getKeyword(k, t.sectionType(k), t.Referrer)
+	if err != nil {
+		return v
+	}
+	switch kw.Converter.(type) {
+	case converters.TList, converters.TListLowercase, converters.TSet, TNodesConverter, TOtherNodesConverter:
+		return strings.Fields(v)
+	default:
+		return v
+	}
+}
+
+// documentNode returns the yaml node of a document value, preserving the
+// ordering of the maps.
+func documentNode(i any) *yaml.Node {
+	switch v := i.(type) {
+	case *orderedmap.OrderedMap:
+		n := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		for _, k := range v.Keys() {
+			value, _ := v.Get(k)
+			n.Content = append(n.Content, documentNode(k), documentNode(value))
+		}
+		return n
+	case []string:
+		n := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq", Style: yaml.FlowStyle}
+		for _, s := range v {
+			n.Content = append(n.Content, documentNode(s))
+		}
+		return n
+	default:
+		s := fmt.Sprint(v)
+		n := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: s}
+		switch {
+		case strings.Contains(s, "\n"):
+			n.Style = yaml.LiteralStyle
+		case isYAML11Keyword(s):
+			// Quote the values yaml 1.1 parsers would load as
+			// booleans or null.
+			n.Style = yaml.DoubleQuotedStyle
+		}
+		return n
+	}
+}
+
+func isYAML11Keyword(s string) bool {
+	switch strings.ToLower(s) {
+	case "y", "n", "yes", "no", "on", "off", "true", "false", "null", "~":
+		return true
+	default:
+		return false
+	}
+}
+
+func resolveAlias(n *yaml.Node) *yaml.Node {
+	for n.Kind == yaml.AliasNode && n.Alias != nil {
+		n = n.Alias
+	}
+	return n
+}
+
+// documentRoot returns the top-level map node of the structured document b.
+func documentRoot(b []byte) (*yaml.Node, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(b, &doc); err != nil {
+		return nil, err
+	}
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) != 1 {
+		return nil, fmt.Errorf("not a structured document")
+	}
+	root := resolveAlias(doc.Content[0])
+	if root.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("not a structured document: the top-level value is not a map")
+	}
+	return root, nil
+}
+
+func documentToIni(b []byte) (*ini.File, error) {
+	root, err := documentRoot(b)
+	if err != nil {
+		return nil, err
+	}
+	f := ini.Empty()
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		name := root.Content[i].Value
+		node := resolveAlias(root.Content[i+1])
+		section := f.Section(name)
+		switch {
+		case node.Kind == yaml.ScalarNode && node.Tag == "!!null":
+			continue
+		case node.Kind != yaml.MappingNode:
+			return nil, fmt.Errorf("section %s: the value is not a map", name)
+		}
+		for j := 0; j+1 < len(node.Content); j += 2 {
+			option := node.Content[j].Value
+			value := resolveAlias(node.Content[j+1])
+			if value.Kind != yaml.MappingNode {
+				s, err := documentString(value)
+				if err != nil {
+					return nil, fmt.Errorf("%s.%s: %w", name, option, err)
+				}
+				section.Key(option).SetValue(s)
+				continue
+			}
+			for k := 0; k+1 < len(value.Content); k += 2 {
+				scope := value.Content[k].Value
+				s, err := documentString(resolveAlias(value.Content[k+1]))
+				if err != nil {
+					return nil, fmt.Errorf("%s.%s: %w", name, option, err)
+				}
+				switch {
+				case scope == documentUnscoped:
+					section.Key(option).SetValue(s)
+				case strings.HasPrefix(scope, "@") && len(scope) > 1:
+					section.Key(option + scope).SetValue(s)
+				default:
+					return nil, fmt.Errorf("%s.%s: invalid scope %q: must be %q or start with @", name, option, scope, documentUnscoped)
+				}
+			}
+		}
+	}
+	return f, nil
+}
+
+// documentString returns the ini value of a scalar or array document
+// value.
+func documentString(n *yaml.Node) (string, error) {
+	switch n.Kind {
+	case yaml.ScalarNode:
+		if n.Tag == "!!null" {
+			return "", nil
+		}
+		return n.Value, nil
+	case yaml.SequenceNode:
+		l := make([]string, len(n.Content))
+		for i, e := range n.Content {
+			e = resolveAlias(e)
+			if e.Kind != yaml.ScalarNode {
+				return "", fmt.Errorf("the array elements must be scalars")
+			}
+			l[i] = e.Value
+		}
+		return strings.Join(l, " "), nil
+	default:
+		return "", fmt.Errorf("unsupported value type")
+	}
+}
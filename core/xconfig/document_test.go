@@ -0,0 +1,90 @@
+package xconfig
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/util/key"
+)
+
+func TestIsDocument(t *testing.T) {
+	cases := map[string]bool{
+		"":                               false,
+		"# comment\n\n[DEFAULT]\nid = 1": false,
+		"nodes = n1\n":                   false,
+		`{"DEFAULT": {"nodes": "n1"}}`:   true,
+		"# comment\nDEFAULT:\n  id: 1\n": true,
+		"DEFAULT: n1\n":                  false,
+	}
+	for s, expected := range cases {
+		assert.Equalf(t, expected, IsDocument([]byte(s)), "%q", s)
+	}
+}
+
+func TestDocument(t *testing.T) {
+	yamlDoc := `DEFAULT:
+  nodes: [n1, n2]
+  orchestrate: no
+app#1:
+  start:
+    default: /srv/app start
+    "@n2": |-
+      /srv/app start
+      --standby
+  stop@n1: /srv/app stop
+  timeout: 10
+  check:
+`
+	c, err := NewObject("", []byte(yamlDoc))
+	require.NoError(t, err)
+	assert.Equal(t, "n1 n2", c.Get(key.New("DEFAULT", "nodes")))
+	assert.Equal(t, "no", c.Get(key.New("DEFAULT", "orchestrate")), "yaml 1.1 booleans are not converted")
+	assert.Equal(t, "/srv/app start", c.Get(key.New("app#1", "start")))
+	assert.Equal(t, "/srv/app start\n--standby", c.Get(key.New("app#1", "start@n2")))
+	assert.Equal(t, "/srv/app stop", c.Get(key.New("app#1", "stop@n1")))
+	assert.Equal(t, "10", c.Get(key.New("app#1", "timeout")))
+	assert.Equal(t, "", c.Get(key.New("app#1", "check")))
+
+	t.Run("ini round trip", func(t *testing.T) {
+		b, err := c.Bytes()
+		require.NoError(t, err)
+		other, err := NewObject("", b)
+		require.NoError(t, err)
+		assert.True(t, c.Equal(other), "ini:\n%s", b)
+	})
+
+	for _, format := range []string{DocumentFormatJSON, DocumentFormatYAML} {
+		t.Run(format+" round trip", func(t *testing.T) {
+			b, err := MarshalDocument(c.Document(), format)
+			require.NoError(t, err)
+			assert.True(t, IsDocument(b))
+			other, err := NewObject("", b)
+			require.NoError(t, err)
+			assert.True(t, c.Equal(other), "%s:\n%s", format, b)
+		})
+	}
+
+	t.Run("scoped keywords are nested", func(t *testing.T) {
+		b, err := MarshalDocument(c.Document(), DocumentFormatYAML)
+		require.NoError(t, err)
+		assert.Contains(t, string(b), "  stop:\n    '@n1': /srv/app stop\n")
+		assert.Contains(t, string(b), "orchestrate: \"no\"")
+	})
+
+	t.Run("invalid scope", func(t *testing.T) {
+		_, err := DocumentToIni([]byte("DEFAULT:\n  nodes:\n    n1: n1\n"))
+		assert.ErrorContains(t, err, "invalid scope")
+	})
+}
+
+func TestSubDocuments(t *testing.T) {
+	keys, docs, err := SubDocuments([]byte(`{"svc1": {"DEFAULT": {"nodes": ["n1"]}}, "cfg/c1": {"data": {"k": "v"}}}`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"svc1", "cfg/c1"}, keys)
+	require.Len(t, docs, 2)
+	c, err := NewObject("", docs[1])
+	require.NoError(t, err)
+	assert.Equal(t, "v", c.Get(key.New("data", "k")))
+}
@@ -0,0 +1,92 @@
+package xconfig
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cvaroqui/ini"
+)
+
+type (
+	// layers holds the template configurations extended by a configuration,
+	// and the cached merged view of the templates and the local
+	// configuration.
+	layers struct {
+		sources []any
+		merged  *ini.File
+	}
+)
+
+// Extend layers the template configurations under the local configuration.
+// The accepted sources are the same as NewObject's. The templates are
+// applied in order, so a template overrides the keywords of the preceding
+// ones, and the local configuration overrides all the templates.
+//
+// The evaluations and the validation use the merged configuration, while
+// the commits only write the local configuration.
+func (t *T) Extend(sources ...any) error {
+	l := &layers{
+		sources: make([]any, 0, len(sources)),
+	}
+	for _, source := range sources {
+		src, err := toIniSource(source)
+		if err != nil {
+			return err
+		}
+		l.sources = append(l.sources, src)
+	}
+	if len(l.sources) == 0 {
+		t.layers = nil
+		return nil
+	}
+	t.layers = l
+	if _, err := t.merge(); err != nil {
+		t.layers = nil
+		return err
+	}
+	return nil
+}
+
+// IsExtended returns true if the configuration extends templates.
+func (t T) IsExtended() bool {
+	return t.layers != nil
+}
+
+// view returns the configuration to read from: the merged configuration
+// if templates are extended, the local configuration otherwise.
+func (t T) view() *ini.File {
+	if t.layers == nil {
+		return t.file
+	}
+	if t.layers.merged != nil {
+		return t.layers.merged
+	}
+	if f, err := t.merge(); err == nil {
+		return f
+	}
+	return t.file
+}
+
+// merge loads the templates and the local configuration in a new ini file,
+// and caches the result.
+func (t T) merge() (*ini.File, error) {
+	var b bytes.Buffer
+	if _, err := t.file.WriteTo(&b); err != nil {
+		return nil, err
+	}
+	sources := append(append([]any{}, t.layers.sources[1:]...), b.Bytes())
+	f, err := ini.LoadSources(loadOptions, t.layers.sources[0], sources...)
+	if err != nil {
+		return nil, fmt.Errorf("load extended config sources error: %w", err)
+	}
+	t.layers.merged = f
+	return f, nil
+}
+
+// invalidate drops the cached merged configuration after a change of the
+// local configuration.
+func (t T) invalidate() {
+	if t.layers != nil {
+		t.layers.merged = nil
+	}
+}
@@ -1,12 +1,14 @@
 package xconfig
 
 import (
+	"bytes"
 	"crypto/md5"
 	"encoding/hex"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
 	"regexp"
 	"strings"
 	"time"
@@ -37,7 +39,8 @@ type (
 		Referrer       Referrer
 		NodeReferrer   Referrer
 		file           *ini.File
-		postCommit     func() error
+		layers         *layers
+		postCommits    []func() error
 		changed        bool
 	}
 
@@ -84,6 +87,7 @@ func NewErrPostponedRef(ref string, rid string) ErrPostponedRef {
 }
 
 func (t T) Reload() error {
+	defer t.invalidate()
 	return t.file.Reload()
 }
 
@@ -94,14 +98,16 @@ func (t T) Changed() bool {
 // Keys returns the key names available in a section
 func (t *T) Keys(section string) []string {
 	data := make([]string, 0)
-	for _, s := range t.file.Section(section).Keys() {
+	for _, s := range t.view().Section(section).Keys() {
 		data = append(data, s.Name())
 	}
 	return data
 }
 
+// RegisterPostCommit adds a function to the list of functions executed, in
+// registration order, after a successful commit.
 func (t *T) RegisterPostCommit(fn func() error) {
-	t.postCommit = fn
+	t.postCommits = append(t.postCommits, fn)
 }
 
 // keysLike returns the slice of key.T if
@@ -325,11 +331,11 @@ func (t *T) HasKey(k key.T) bool {
 	if t == nil {
 		return false
 	}
-	return t.file.Section(k.Section).HasKey(k.Option)
+	return t.view().Section(k.Section).HasKey(k.Option)
 }
 
 func (t *T) Get(k key.T) string {
-	if section := t.file.Section(k.Section); section == nil {
+	if section := t.view().Section(k.Section); section == nil {
 		return ""
 	} else if fk := section.Key(k.Option); fk == nil {
 		return ""
@@ -339,7 +345,7 @@ func (t *T) Get(k key.T) string {
 }
 
 func (t *T) GetStrict(k key.T) (string, error) {
-	section := t.file.Section(k.Section)
+	section := t.view().Section(k.Section)
 	if section.HasKey(k.Option) {
 		return section.Key(k.Option).Value(), nil
 	}
@@ -438,6 +444,7 @@ func (t *T) PrepareUnset(ks ...key.T) error {
 			continue
 		}
 		t.file.Section(k.Section).DeleteKey(k.Option)
+		t.invalidate()
 		t.changed = true
 	}
 	return nil
@@ -460,7 +467,7 @@ func (t *T) Set(op keyop.T) error {
 
 func (t *T) DriverGroupSet(op keyop.T) error {
 	prefix := op.Key.Section + "#"
-	for _, section := range t.file.SectionStrings() {
+	for _, section := range t.view().SectionStrings() {
 		if !strings.HasPrefix(section, prefix) {
 			continue
 		}
@@ -473,8 +480,9 @@ func (t *T) DriverGroupSet(op keyop.T) error {
 }
 
 func (t *T) set(op keyop.T) error {
+	defer t.invalidate()
 	setSet := func(op keyop.T) error {
-		current := t.file.Section(op.Key.Section).Key(op.Key.Option).Value()
+		current := t.view().Section(op.Key.Section).Key(op.Key.Option).Value()
 		if current == op.Value {
 			return nil
 		}
@@ -483,7 +491,7 @@ func (t *T) set(op keyop.T) error {
 		return nil
 	}
 	setAppend := func(op keyop.T) error {
-		current := t.file.Section(op.Key.Section).Key(op.Key.Option).Value()
+		current := t.view().Section(op.Key.Section).Key(op.Key.Option).Value()
 		target := ""
 		if current == "" {
 			target = op.Value
@@ -495,7 +503,7 @@ func (t *T) set(op keyop.T) error {
 		return nil
 	}
 	setMerge := func(op keyop.T) error {
-		current := strings.Fields(t.file.Section(op.Key.Section).Key(op.Key.Option).Value())
+		current := strings.Fields(t.view().Section(op.Key.Section).Key(op.Key.Option).Value())
 		currentSet := set.New()
 		for _, e := range current {
 			currentSet.Insert(e)
@@ -507,7 +515,7 @@ func (t *T) set(op keyop.T) error {
 	}
 
 	setRemove := func(op keyop.T) error {
-		current := strings.Fields(t.file.Section(op.Key.Section).Key(op.Key.Option).Value())
+		current := strings.Fields(t.view().Section(op.Key.Section).Key(op.Key.Option).Value())
 		target := []string{}
 		removed := 0
 		for _, e := range current {
@@ -526,7 +534,7 @@ func (t *T) set(op keyop.T) error {
 	}
 
 	setToggle := func(op keyop.T) error {
-		current := strings.Fields(t.file.Section(op.Key.Section).Key(op.Key.Option).Value())
+		current := strings.Fields(t.view().Section(op.Key.Section).Key(op.Key.Option).Value())
 		hasValue := false
 		for _, e := range current {
 			if e == op.Value {
@@ -541,7 +549,7 @@ func (t *T) set(op keyop.T) error {
 	}
 
 	setInsert := func(op keyop.T) error {
-		current := strings.Fields(t.file.Section(op.Key.Section).Key(op.Key.Option).Value())
+		current := strings.Fields(t.view().Section(op.Key.Section).Key(op.Key.Option).Value())
 		target := []string{}
 		target = append(target, current[:op.Index]...)
 		target = append(target, op.Value)
@@ -760,7 +768,7 @@ func (t *T) replaceReferences(v string, section string, impersonate string) (str
 }
 
 func (t T) SectionSig(section string) string {
-	s, err := t.file.GetSection(section)
+	s, err := t.view().GetSection(section)
 	if err != nil {
 		return ""
 	}
@@ -772,7 +780,7 @@ func (t T) SectionSig(section string) string {
 }
 
 func (t T) SectionMap(section string) map[string]string {
-	s, err := t.file.GetSection(section)
+	s, err := t.view().GetSection(section)
 	if err != nil {
 		return map[string]string{}
 	}
@@ -780,13 +788,41 @@ func (t T) SectionMap(section string) map[string]string {
 }
 
 func (t T) SectionMapStrict(section string) (map[string]string, error) {
-	s, err := t.file.GetSection(section)
+	s, err := t.view().GetSection(section)
 	if err != nil {
 		return nil, fmt.Errorf("%w: section '%s'", ErrExist, section)
 	}
 	return s.KeysHash(), nil
 }
 
+// Bytes returns the configuration file content, formatted as a commit
+// would write it.
+func (t T) Bytes() ([]byte, error) {
+	ini.DefaultHeader = true
+	var b bytes.Buffer
+	if _, err := t.file.WriteTo(&b); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// Equal returns true if the other configuration has the same sections and
+// keys, with the same values. The comments, the key ordering and the
+// metadata section are ignored.
+func (t T) Equal(other *T) bool {
+	sections := func(c T) map[string]map[string]string {
+		m := make(map[string]map[string]string)
+		for _, s := range c.file.Sections() {
+			if s.Name() == "metadata" || len(s.Keys()) == 0 {
+				continue
+			}
+			m[s.Name()] = s.KeysHash()
+		}
+		return m
+	}
+	return reflect.DeepEqual(sections(t), sections(*other))
+}
+
 func (t *T) descope(k key.T, kw keywords.Keyword, impersonate string) (string, error) {
 	if impersonate == "" {
 		impersonate = hostname.Hostname()
@@ -836,13 +872,14 @@ func (t *T) descope(k key.T, kw keywords.Keyword, impersonate string) (string, e
 	return "", fmt.Errorf("%w: key '%s' not found (all scopes tried)", ErrExist, k)
 }
 
+// Raw returns the local configuration, without the extended templates.
 func (t T) Raw() rawconfig.T {
 	r := rawconfig.T{}
 	r.Data = orderedmap.New()
 	for _, s := range t.file.Sections() {
 		sectionMap := *orderedmap.New()
-		for k, v := range s.KeysHash() {
-			sectionMap.Set(k, v)
+		for _, k := range s.Keys() {
+			sectionMap.Set(k.Name(), k.Value())
 		}
 		r.Data.Set(s.Name(), sectionMap)
 	}
@@ -853,7 +890,7 @@ func (t T) Raw() rawconfig.T {
 // This format is used by the volume pools framework.
 func (t T) Ops() []string {
 	l := make([]string, 0)
-	for _, s := range t.file.Sections() {
+	for _, s := range t.view().Sections() {
 		for k, v := range s.KeysHash() {
 			op := fmt.Sprintf("%s.%s=%s", s.Name(), k, v)
 			l = append(l, op)
@@ -868,7 +905,7 @@ func (t T) RawEvaluated() (rawconfig.T, error) {
 
 func (t T) RawEvaluatedAs(impersonate string) (rawconfig.T, error) {
 	r := rawconfig.New()
-	for _, s := range t.file.Sections() {
+	for _, s := range t.view().Sections() {
 		sectionMap := *orderedmap.New()
 		for k := range s.KeysHash() {
 			_k := key.New(s.Name(), k)
@@ -895,7 +932,7 @@ func (t T) HasSectionString(s string) bool {
 
 // SectionStrings returns list of section names.
 func (t T) SectionStrings() []string {
-	return t.file.SectionStrings()
+	return t.view().SectionStrings()
 }
 
 func (t *T) IsInNodes(impersonate string) (bool, error) {
@@ -1027,7 +1064,7 @@ func (t T) dereferenceKey(ref string, section string, impersonate string) (strin
 	if refKey.Section == "" {
 		refKey.Section = section
 	}
-	key, err := t.file.Section(refKey.Section).GetKey(refKey.Option)
+	key, err := t.view().Section(refKey.Section).GetKey(refKey.Option)
 	if err != nil {
 		return "", err
 	}
@@ -1081,6 +1118,7 @@ func (t *T) LoadRaw(configData rawconfig.T) error {
 	file := ini.Empty()
 	if configData.Data == nil {
 		t.file = file
+		t.invalidate()
 		return nil
 	}
 	for _, section := range configData.Data.Keys() {
@@ -1101,6 +1139,7 @@ func (t *T) LoadRaw(configData rawconfig.T) error {
 		}
 	}
 	t.file = file
+	t.invalidate()
 	return nil
 }
 
@@ -1109,6 +1148,7 @@ func (t T) deleteSection(section string) {
 		return
 	}
 	t.file.DeleteSection(section)
+	t.invalidate()
 }
 
 func (t T) initDefaultSection() error {
@@ -1124,6 +1164,7 @@ func (t T) initDefaultSection() error {
 		if err != nil {
 			return err
 		}
+		t.invalidate()
 	}
 	return nil
 }
@@ -1158,10 +1199,11 @@ func (t *T) rawCommit(configData rawconfig.T, configPath string, validate bool)
 		}
 	}
 	//t.clearRefCache()
-	if t.postCommit != nil {
-		return t.postCommit()
+	var errs error
+	for _, fn := range t.postCommits {
+		errs = errors.Join(errs, fn())
 	}
-	return nil
+	return errs
 }
 
 func (t *T) Recommit() error {
@@ -1220,6 +1262,7 @@ func (t *T) PrepareDeleteSections(sections ...string) error {
 			continue
 		}
 		t.file.DeleteSection(section)
+		t.invalidate()
 		t.changed = true
 	}
 	return nil
@@ -10,6 +10,14 @@ import (
 	"github.com/iancoleman/orderedmap"
 )
 
+var (
+	loadOptions = ini.LoadOptions{
+		Loose:                      true,
+		AllowPythonMultilineValues: true,
+		SpaceBeforeInlineComment:   true,
+	}
+)
+
 // NewObject configures and returns a T instance pointer.
 // The first argument is the path of the configuration file to write to.
 //
@@ -20,11 +28,6 @@ func NewObject(p string, sources ...any) (*T, error) {
 	t := &T{
 		ConfigFilePath: filepath.FromSlash(p),
 	}
-	loadOptions := ini.LoadOptions{
-		Loose:                      true,
-		AllowPythonMultilineValues: true,
-		SpaceBeforeInlineComment:   true,
-	}
 	for i, source := range sources {
 		src, err := toIniSource(source)
 		if err != nil {
@@ -77,6 +80,9 @@ func toIniSource(i any) (any, error) {
 	}
 	switch data := i.(type) {
 	case []byte:
+		if IsDocument(data) {
+			return DocumentToIni(data)
+		}
 		return data, nil
 	case string:
 		return data, nil
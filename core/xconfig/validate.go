@@ -40,6 +40,7 @@ const (
 	validateAlertKindCandidates
 	validateAlertKindDeprecated
 	validateAlertKindCapabilities
+	validateAlertKindExtends
 )
 
 var (
@@ -60,6 +61,7 @@ var (
 	validateAlertKindCandidatesStr    = "keyword value is not in allowed candidates"
 	validateAlertKindDeprecatedStr    = "keyword is deprecated"
 	validateAlertKindCapabilitiesStr  = "driver is not in node capabilities"
+	validateAlertKindExtendsStr       = "template can not be extended"
 	validateAlertKindNames            = map[ValidateAlertKind]string{
 		validateAlertKindScoping:       validateAlertKindScopingStr,
 		validateAlertKindUnknown:       validateAlertKindUnknownStr,
@@ -68,6 +70,7 @@ var (
 		validateAlertKindCandidates:    validateAlertKindCandidatesStr,
 		validateAlertKindDeprecated:    validateAlertKindDeprecatedStr,
 		validateAlertKindCapabilities:  validateAlertKindCapabilitiesStr,
+		validateAlertKindExtends:       validateAlertKindExtendsStr,
 	}
 	validateAlertKindFromNames = map[string]ValidateAlertKind{
 		validateAlertKindScopingStr:       validateAlertKindScoping,
@@ -77,6 +80,7 @@ var (
 		validateAlertKindCandidatesStr:    validateAlertKindCandidates,
 		validateAlertKindDeprecatedStr:    validateAlertKindDeprecated,
 		validateAlertKindCapabilitiesStr:  validateAlertKindCapabilities,
+		validateAlertKindExtendsStr:       validateAlertKindExtends,
 	}
 )
 
@@ -156,6 +160,19 @@ func (t T) NewValidateAlertCapabilities(k key.T, did driver.ID) ValidateAlert {
 	}
 }
 
+// NewValidateAlertExtends returns the alert reporting a template listed in
+// the DEFAULT.extends keyword that can not be layered under the
+// configuration.
+func (t T) NewValidateAlertExtends(comment string) ValidateAlert {
+	return ValidateAlert{
+		Path:    t.Path,
+		Kind:    validateAlertKindExtends,
+		Level:   validateAlertLevelError,
+		Key:     key.New("DEFAULT", "extends"),
+		Comment: comment,
+	}
+}
+
 func (t ValidateAlertKind) String() string {
 	if s, ok := validateAlertKindNames[t]; ok {
 		return s
@@ -268,7 +285,7 @@ func (t ValidateAlerts) Tree() *tree.Tree {
 
 func (t T) Validate() (ValidateAlerts, error) {
 	alerts := make(ValidateAlerts, 0)
-	for _, s := range t.file.Sections() {
+	for _, s := range t.view().Sections() {
 		var did driver.ID
 		section := s.Name()
 		sectionType := t.GetString(key.New(section, "type"))
@@ -21,9 +21,18 @@ import (
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
+	// (POST /auth/refresh)
+	PostAuthRefresh(ctx echo.Context, params PostAuthRefreshParams) error
+
+	// (GET /auth/token)
+	GetAuthTokens(ctx echo.Context) error
+
 	// (POST /auth/token)
 	PostAuthToken(ctx echo.Context, params PostAuthTokenParams) error
 
+	// (DELETE /auth/token/{id})
+	DeleteAuthToken(ctx echo.Context, id string) error
+
 	// (POST /cluster/action/abort)
 	PostClusterActionAbort(ctx echo.Context) error
 
@@ -72,6 +81,12 @@ type ServerInterface interface {
 	// (GET /node)
 	GetNodes(ctx echo.Context, params GetNodesParams) error
 
+	// (GET /node/asset)
+	GetNodesAsset(ctx echo.Context, params GetNodesAssetParams) error
+
+	// (POST /node/checks)
+	PostNodeChecks(ctx echo.Context) error
+
 	// (POST /node/clear)
 	PostNodeClear(ctx echo.Context) error
 
@@ -82,7 +97,7 @@ type ServerInterface interface {
 	PostPeerActionAbort(ctx echo.Context, nodename InPathNodeName) error
 
 	// (POST /node/name/{nodename}/action/drain)
-	PostPeerActionDrain(ctx echo.Context, nodename InPathNodeName) error
+	PostPeerActionDrain(ctx echo.Context, nodename InPathNodeName, params PostPeerActionDrainParams) error
 
 	// (POST /node/name/{nodename}/action/freeze)
 	PostPeerActionFreeze(ctx echo.Context, nodename InPathNodeName, params PostPeerActionFreezeParams) error
@@ -108,6 +123,9 @@ type ServerInterface interface {
 	// (POST /node/name/{nodename}/action/unfreeze)
 	PostPeerActionUnfreeze(ctx echo.Context, nodename InPathNodeName, params PostPeerActionUnfreezeParams) error
 
+	// (GET /node/name/{nodename}/asset)
+	GetNodeAsset(ctx echo.Context, nodename InPathNodeName) error
+
 	// (GET /node/name/{nodename}/capabilities)
 	GetNodeCapabilities(ctx echo.Context, nodename InPathNodeName) error
 
@@ -186,6 +204,12 @@ type ServerInterface interface {
 	// (GET /node/name/{nodename}/schedule)
 	GetNodeSchedule(ctx echo.Context, nodename InPathNodeName) error
 
+	// (GET /node/name/{nodename}/sysreport/diff)
+	GetNodeSysreportDiff(ctx echo.Context, nodename InPathNodeName, params GetNodeSysreportDiffParams) error
+
+	// (GET /node/name/{nodename}/sysreport/snapshot)
+	GetNodeSysreportSnapshots(ctx echo.Context, nodename InPathNodeName) error
+
 	// (GET /object)
 	GetObjects(ctx echo.Context, params GetObjectsParams) error
 
@@ -205,7 +229,7 @@ type ServerInterface interface {
 	PostObjectActionFreeze(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName) error
 
 	// (POST /object/path/{namespace}/{kind}/{name}/action/giveback)
-	PostObjectActionGiveback(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName) error
+	PostObjectActionGiveback(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params PostObjectActionGivebackParams) error
 
 	// (POST /object/path/{namespace}/{kind}/{name}/action/provision)
 	PostObjectActionProvision(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName) error
@@ -214,13 +238,13 @@ type ServerInterface interface {
 	PostObjectActionPurge(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName) error
 
 	// (POST /object/path/{namespace}/{kind}/{name}/action/start)
-	PostObjectActionStart(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName) error
+	PostObjectActionStart(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params PostObjectActionStartParams) error
 
 	// (POST /object/path/{namespace}/{kind}/{name}/action/stop)
-	PostObjectActionStop(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName) error
+	PostObjectActionStop(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params PostObjectActionStopParams) error
 
 	// (POST /object/path/{namespace}/{kind}/{name}/action/switch)
-	PostObjectActionSwitch(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName) error
+	PostObjectActionSwitch(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params PostObjectActionSwitchParams) error
 
 	// (POST /object/path/{namespace}/{kind}/{name}/action/unfreeze)
 	PostObjectActionUnfreeze(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName) error
@@ -228,6 +252,9 @@ type ServerInterface interface {
 	// (POST /object/path/{namespace}/{kind}/{name}/action/unprovision)
 	PostObjectActionUnprovision(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName) error
 
+	// (POST /object/path/{namespace}/{kind}/{name}/clone)
+	PostVolumeClone(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params PostVolumeCloneParams) error
+
 	// (GET /object/path/{namespace}/{kind}/{name}/config)
 	GetObjectConfig(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params GetObjectConfigParams) error
 
@@ -243,9 +270,39 @@ type ServerInterface interface {
 	// (GET /object/path/{namespace}/{kind}/{name}/config/get)
 	GetObjectConfigGet(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params GetObjectConfigGetParams) error
 
+	// (GET /object/path/{namespace}/{kind}/{name}/config/history)
+	GetObjectConfigHistory(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName) error
+
+	// (GET /object/path/{namespace}/{kind}/{name}/config/history/{rev})
+	GetObjectConfigRevision(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName, rev InPathRevision) error
+
+	// (POST /object/path/{namespace}/{kind}/{name}/config/rollback)
+	PostObjectConfigRollback(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params PostObjectConfigRollbackParams) error
+
 	// (POST /object/path/{namespace}/{kind}/{name}/config/update)
 	PostObjectConfigUpdate(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params PostObjectConfigUpdateParams) error
 
+	// (POST /object/path/{namespace}/{kind}/{name}/migrate/commit)
+	PostVolumeMigrateCommit(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params PostVolumeMigrateCommitParams) error
+
+	// (POST /object/path/{namespace}/{kind}/{name}/migrate/prepare)
+	PostVolumeMigratePrepare(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params PostVolumeMigratePrepareParams) error
+
+	// (POST /object/path/{namespace}/{kind}/{name}/resize)
+	PostVolumeResize(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params PostVolumeResizeParams) error
+
+	// (DELETE /object/path/{namespace}/{kind}/{name}/snapshot)
+	DeleteVolumeSnapshot(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params DeleteVolumeSnapshotParams) error
+
+	// (GET /object/path/{namespace}/{kind}/{name}/snapshot)
+	GetVolumeSnapshots(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName) error
+
+	// (POST /object/path/{namespace}/{kind}/{name}/snapshot)
+	PostVolumeSnapshot(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params PostVolumeSnapshotParams) error
+
+	// (POST /object/path/{namespace}/{kind}/{name}/snapshot/rollback)
+	PostVolumeSnapshotRollback(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName, params PostVolumeSnapshotRollbackParams) error
+
 	// (GET /pool)
 	GetPools(ctx echo.Context, params GetPoolsParams) error
 
@@ -255,6 +312,9 @@ type ServerInterface interface {
 	// (GET /public/openapi)
 	GetSwagger(ctx echo.Context) error
 
+	// (GET /public/schema/{name})
+	GetSchema(ctx echo.Context, name GetSchemaParamsName) error
+
 	// (GET /relay/message)
 	GetRelayMessage(ctx echo.Context, params GetRelayMessageParams) error
 
@@ -270,6 +330,39 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// PostAuthRefresh converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthRefresh(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostAuthRefreshParams
+	// ------------- Optional query parameter "duration" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "duration", ctx.QueryParams(), &params.Duration)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter duration: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuthRefresh(ctx, params)
+	return err
+}
+
+// GetAuthTokens converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAuthTokens(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAuthTokens(ctx)
+	return err
+}
+
 // PostAuthToken converts echo context to params.
 func (w *ServerInterfaceWrapper) PostAuthToken(ctx echo.Context) error {
 	var err error
@@ -294,11 +387,52 @@ func (w *ServerInterfaceWrapper) PostAuthToken(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter duration: %s", err))
 	}
 
+	// ------------- Optional query parameter "totp" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "totp", ctx.QueryParams(), &params.Totp)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter totp: %s", err))
+	}
+
+	// ------------- Optional query parameter "refresh" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "refresh", ctx.QueryParams(), &params.Refresh)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter refresh: %s", err))
+	}
+
+	// ------------- Optional query parameter "refresh_duration" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "refresh_duration", ctx.QueryParams(), &params.RefreshDuration)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter refresh_duration: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.PostAuthToken(ctx, params)
 	return err
 }
 
+// DeleteAuthToken converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAuthToken(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteAuthToken(ctx, id)
+	return err
+}
+
 // PostClusterActionAbort converts echo context to params.
 func (w *ServerInterfaceWrapper) PostClusterActionAbort(ctx echo.Context) error {
 	var err error
@@ -630,6 +764,41 @@ func (w *ServerInterfaceWrapper) GetNodes(ctx echo.Context) error {
 	return err
 }
 
+// GetNodesAsset converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodesAsset(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNodesAssetParams
+	// ------------- Optional query parameter "node" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "node", ctx.QueryParams(), &params.Node)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter node: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNodesAsset(ctx, params)
+	return err
+}
+
+// PostNodeChecks converts echo context to params.
+func (w *ServerInterfaceWrapper) PostNodeChecks(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostNodeChecks(ctx)
+	return err
+}
+
 // PostNodeClear converts echo context to params.
 func (w *ServerInterfaceWrapper) PostNodeClear(ctx echo.Context) error {
 	var err error
@@ -691,8 +860,17 @@ func (w *ServerInterfaceWrapper) PostPeerActionDrain(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPeerActionDrainParams
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", ctx.QueryParams(), &params.DryRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dry_run: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostPeerActionDrain(ctx, nodename)
+	err = w.Handler.PostPeerActionDrain(ctx, nodename, params)
 	return err
 }
 
@@ -935,6 +1113,26 @@ func (w *ServerInterfaceWrapper) PostPeerActionUnfreeze(ctx echo.Context) error
 	return err
 }
 
+// GetNodeAsset converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodeAsset(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "nodename" -------------
+	var nodename InPathNodeName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "nodename", runtime.ParamLocationPath, ctx.Param("nodename"), &nodename)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter nodename: %s", err))
+	}
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNodeAsset(ctx, nodename)
+	return err
+}
+
 // GetNodeCapabilities converts echo context to params.
 func (w *ServerInterfaceWrapper) GetNodeCapabilities(ctx echo.Context) error {
 	var err error
@@ -1085,6 +1283,13 @@ func (w *ServerInterfaceWrapper) PostDaemonShutdown(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter duration: %s", err))
 	}
 
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", ctx.QueryParams(), &params.DryRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dry_run: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.PostDaemonShutdown(ctx, nodename, params)
 	return err
@@ -2310,6 +2515,62 @@ func (w *ServerInterfaceWrapper) GetNodeSchedule(ctx echo.Context) error {
 	return err
 }
 
+// GetNodeSysreportDiff converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodeSysreportDiff(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "nodename" -------------
+	var nodename InPathNodeName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "nodename", runtime.ParamLocationPath, ctx.Param("nodename"), &nodename)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter nodename: %s", err))
+	}
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNodeSysreportDiffParams
+	// ------------- Optional query parameter "rev" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "rev", ctx.QueryParams(), &params.Rev)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rev: %s", err))
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", ctx.QueryParams(), &params.Since)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNodeSysreportDiff(ctx, nodename, params)
+	return err
+}
+
+// GetNodeSysreportSnapshots converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNodeSysreportSnapshots(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "nodename" -------------
+	var nodename InPathNodeName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "nodename", runtime.ParamLocationPath, ctx.Param("nodename"), &nodename)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter nodename: %s", err))
+	}
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetNodeSysreportSnapshots(ctx, nodename)
+	return err
+}
+
 // GetObjects converts echo context to params.
 func (w *ServerInterfaceWrapper) GetObjects(ctx echo.Context) error {
 	var err error
@@ -2529,8 +2790,17 @@ func (w *ServerInterfaceWrapper) PostObjectActionGiveback(ctx echo.Context) erro
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostObjectActionGivebackParams
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", ctx.QueryParams(), &params.DryRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dry_run: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostObjectActionGiveback(ctx, namespace, kind, name)
+	err = w.Handler.PostObjectActionGiveback(ctx, namespace, kind, name, params)
 	return err
 }
 
@@ -2637,8 +2907,17 @@ func (w *ServerInterfaceWrapper) PostObjectActionStart(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostObjectActionStartParams
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", ctx.QueryParams(), &params.DryRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dry_run: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostObjectActionStart(ctx, namespace, kind, name)
+	err = w.Handler.PostObjectActionStart(ctx, namespace, kind, name, params)
 	return err
 }
 
@@ -2673,8 +2952,17 @@ func (w *ServerInterfaceWrapper) PostObjectActionStop(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostObjectActionStopParams
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", ctx.QueryParams(), &params.DryRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dry_run: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostObjectActionStop(ctx, namespace, kind, name)
+	err = w.Handler.PostObjectActionStop(ctx, namespace, kind, name, params)
 	return err
 }
 
@@ -2709,8 +2997,17 @@ func (w *ServerInterfaceWrapper) PostObjectActionSwitch(ctx echo.Context) error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostObjectActionSwitchParams
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", ctx.QueryParams(), &params.DryRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dry_run: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostObjectActionSwitch(ctx, namespace, kind, name)
+	err = w.Handler.PostObjectActionSwitch(ctx, namespace, kind, name, params)
 	return err
 }
 
@@ -2786,6 +3083,58 @@ func (w *ServerInterfaceWrapper) PostObjectActionUnprovision(ctx echo.Context) e
 	return err
 }
 
+// PostVolumeClone converts echo context to params.
+func (w *ServerInterfaceWrapper) PostVolumeClone(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace InPathNamespace
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "kind" -------------
+	var kind InPathKind
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kind", runtime.ParamLocationPath, ctx.Param("kind"), &kind)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kind: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name InPathName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostVolumeCloneParams
+	// ------------- Required query parameter "target" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "target", ctx.QueryParams(), &params.Target)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter target: %s", err))
+	}
+
+	// ------------- Optional query parameter "requester_sid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "requester_sid", ctx.QueryParams(), &params.RequesterSid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter requester_sid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostVolumeClone(ctx, namespace, kind, name, params)
+	return err
+}
+
 // GetObjectConfig converts echo context to params.
 func (w *ServerInterfaceWrapper) GetObjectConfig(ctx echo.Context) error {
 	var err error
@@ -3005,8 +3354,8 @@ func (w *ServerInterfaceWrapper) GetObjectConfigGet(ctx echo.Context) error {
 	return err
 }
 
-// PostObjectConfigUpdate converts echo context to params.
-func (w *ServerInterfaceWrapper) PostObjectConfigUpdate(ctx echo.Context) error {
+// GetObjectConfigHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetObjectConfigHistory(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "namespace" -------------
 	var namespace InPathNamespace
@@ -3036,20 +3385,145 @@ func (w *ServerInterfaceWrapper) PostObjectConfigUpdate(ctx echo.Context) error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params PostObjectConfigUpdateParams
-	// ------------- Optional query parameter "delete" -------------
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetObjectConfigHistory(ctx, namespace, kind, name)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "delete", ctx.QueryParams(), &params.Delete)
+// GetObjectConfigRevision converts echo context to params.
+func (w *ServerInterfaceWrapper) GetObjectConfigRevision(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace InPathNamespace
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter delete: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
 	}
 
-	// ------------- Optional query parameter "unset" -------------
+	// ------------- Path parameter "kind" -------------
+	var kind InPathKind
 
-	err = runtime.BindQueryParameter("form", true, false, "unset", ctx.QueryParams(), &params.Unset)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kind", runtime.ParamLocationPath, ctx.Param("kind"), &kind)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter unset: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kind: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name InPathName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// ------------- Path parameter "rev" -------------
+	var rev InPathRevision
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "rev", runtime.ParamLocationPath, ctx.Param("rev"), &rev)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rev: %s", err))
+	}
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetObjectConfigRevision(ctx, namespace, kind, name, rev)
+	return err
+}
+
+// PostObjectConfigRollback converts echo context to params.
+func (w *ServerInterfaceWrapper) PostObjectConfigRollback(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace InPathNamespace
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "kind" -------------
+	var kind InPathKind
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kind", runtime.ParamLocationPath, ctx.Param("kind"), &kind)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kind: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name InPathName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostObjectConfigRollbackParams
+	// ------------- Required query parameter "rev" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "rev", ctx.QueryParams(), &params.Rev)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rev: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostObjectConfigRollback(ctx, namespace, kind, name, params)
+	return err
+}
+
+// PostObjectConfigUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) PostObjectConfigUpdate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace InPathNamespace
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "kind" -------------
+	var kind InPathKind
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kind", runtime.ParamLocationPath, ctx.Param("kind"), &kind)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kind: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name InPathName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostObjectConfigUpdateParams
+	// ------------- Optional query parameter "delete" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "delete", ctx.QueryParams(), &params.Delete)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter delete: %s", err))
+	}
+
+	// ------------- Optional query parameter "unset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "unset", ctx.QueryParams(), &params.Unset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter unset: %s", err))
 	}
 
 	// ------------- Optional query parameter "set" -------------
@@ -3064,6 +3538,319 @@ func (w *ServerInterfaceWrapper) PostObjectConfigUpdate(ctx echo.Context) error
 	return err
 }
 
+// PostVolumeMigrateCommit converts echo context to params.
+func (w *ServerInterfaceWrapper) PostVolumeMigrateCommit(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace InPathNamespace
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "kind" -------------
+	var kind InPathKind
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kind", runtime.ParamLocationPath, ctx.Param("kind"), &kind)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kind: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name InPathName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostVolumeMigrateCommitParams
+	// ------------- Optional query parameter "requester_sid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "requester_sid", ctx.QueryParams(), &params.RequesterSid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter requester_sid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostVolumeMigrateCommit(ctx, namespace, kind, name, params)
+	return err
+}
+
+// PostVolumeMigratePrepare converts echo context to params.
+func (w *ServerInterfaceWrapper) PostVolumeMigratePrepare(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace InPathNamespace
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "kind" -------------
+	var kind InPathKind
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kind", runtime.ParamLocationPath, ctx.Param("kind"), &kind)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kind: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name InPathName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostVolumeMigratePrepareParams
+	// ------------- Required query parameter "pool" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "pool", ctx.QueryParams(), &params.Pool)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pool: %s", err))
+	}
+
+	// ------------- Optional query parameter "requester_sid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "requester_sid", ctx.QueryParams(), &params.RequesterSid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter requester_sid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostVolumeMigratePrepare(ctx, namespace, kind, name, params)
+	return err
+}
+
+// PostVolumeResize converts echo context to params.
+func (w *ServerInterfaceWrapper) PostVolumeResize(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace InPathNamespace
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "kind" -------------
+	var kind InPathKind
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kind", runtime.ParamLocationPath, ctx.Param("kind"), &kind)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kind: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name InPathName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostVolumeResizeParams
+	// ------------- Required query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "size", ctx.QueryParams(), &params.Size)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter size: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostVolumeResize(ctx, namespace, kind, name, params)
+	return err
+}
+
+// DeleteVolumeSnapshot converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteVolumeSnapshot(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace InPathNamespace
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "kind" -------------
+	var kind InPathKind
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kind", runtime.ParamLocationPath, ctx.Param("kind"), &kind)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kind: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name InPathName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteVolumeSnapshotParams
+	// ------------- Required query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "name", ctx.QueryParams(), &params.Name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteVolumeSnapshot(ctx, namespace, kind, name, params)
+	return err
+}
+
+// GetVolumeSnapshots converts echo context to params.
+func (w *ServerInterfaceWrapper) GetVolumeSnapshots(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace InPathNamespace
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "kind" -------------
+	var kind InPathKind
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kind", runtime.ParamLocationPath, ctx.Param("kind"), &kind)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kind: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name InPathName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetVolumeSnapshots(ctx, namespace, kind, name)
+	return err
+}
+
+// PostVolumeSnapshot converts echo context to params.
+func (w *ServerInterfaceWrapper) PostVolumeSnapshot(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace InPathNamespace
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "kind" -------------
+	var kind InPathKind
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kind", runtime.ParamLocationPath, ctx.Param("kind"), &kind)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kind: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name InPathName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostVolumeSnapshotParams
+	// ------------- Required query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "name", ctx.QueryParams(), &params.Name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostVolumeSnapshot(ctx, namespace, kind, name, params)
+	return err
+}
+
+// PostVolumeSnapshotRollback converts echo context to params.
+func (w *ServerInterfaceWrapper) PostVolumeSnapshotRollback(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "namespace" -------------
+	var namespace InPathNamespace
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, ctx.Param("namespace"), &namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Path parameter "kind" -------------
+	var kind InPathKind
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "kind", runtime.ParamLocationPath, ctx.Param("kind"), &kind)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kind: %s", err))
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name InPathName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostVolumeSnapshotRollbackParams
+	// ------------- Required query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "name", ctx.QueryParams(), &params.Name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostVolumeSnapshotRollback(ctx, namespace, kind, name, params)
+	return err
+}
+
 // GetPools converts echo context to params.
 func (w *ServerInterfaceWrapper) GetPools(ctx echo.Context) error {
 	var err error
@@ -3117,6 +3904,22 @@ func (w *ServerInterfaceWrapper) GetSwagger(ctx echo.Context) error {
 	return err
 }
 
+// GetSchema converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSchema(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name GetSchemaParamsName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSchema(ctx, name)
+	return err
+}
+
 // GetRelayMessage converts echo context to params.
 func (w *ServerInterfaceWrapper) GetRelayMessage(ctx echo.Context) error {
 	var err error
@@ -3223,7 +4026,10 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.POST(baseURL+"/auth/refresh", wrapper.PostAuthRefresh)
+	router.GET(baseURL+"/auth/token", wrapper.GetAuthTokens)
 	router.POST(baseURL+"/auth/token", wrapper.PostAuthToken)
+	router.DELETE(baseURL+"/auth/token/:id", wrapper.DeleteAuthToken)
 	router.POST(baseURL+"/cluster/action/abort", wrapper.PostClusterActionAbort)
 	router.POST(baseURL+"/cluster/action/freeze", wrapper.PostClusterActionFreeze)
 	router.POST(baseURL+"/cluster/action/unfreeze", wrapper.PostClusterActionUnfreeze)
@@ -3240,6 +4046,8 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/network", wrapper.GetNetworks)
 	router.GET(baseURL+"/network/ip", wrapper.GetNetworkIP)
 	router.GET(baseURL+"/node", wrapper.GetNodes)
+	router.GET(baseURL+"/node/asset", wrapper.GetNodesAsset)
+	router.POST(baseURL+"/node/checks", wrapper.PostNodeChecks)
 	router.POST(baseURL+"/node/clear", wrapper.PostNodeClear)
 	router.GET(baseURL+"/node/info", wrapper.GetNodesInfo)
 	router.POST(baseURL+"/node/name/:nodename/action/abort", wrapper.PostPeerActionAbort)
@@ -3252,6 +4060,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.POST(baseURL+"/node/name/:nodename/action/scan/capabilities", wrapper.PostNodeActionScanCapabilities)
 	router.POST(baseURL+"/node/name/:nodename/action/sysreport", wrapper.PostNodeActionSysreport)
 	router.POST(baseURL+"/node/name/:nodename/action/unfreeze", wrapper.PostPeerActionUnfreeze)
+	router.GET(baseURL+"/node/name/:nodename/asset", wrapper.GetNodeAsset)
 	router.GET(baseURL+"/node/name/:nodename/capabilities", wrapper.GetNodeCapabilities)
 	router.GET(baseURL+"/node/name/:nodename/config/get", wrapper.GetNodeConfigGet)
 	router.POST(baseURL+"/node/name/:nodename/config/update", wrapper.PostNodeConfigUpdate)
@@ -3278,6 +4087,8 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/node/name/:nodename/object/path/:namespace/:kind/:name/log", wrapper.GetInstanceLogs)
 	router.GET(baseURL+"/node/name/:nodename/object/path/:namespace/:kind/:name/schedule", wrapper.GetObjectSchedule)
 	router.GET(baseURL+"/node/name/:nodename/schedule", wrapper.GetNodeSchedule)
+	router.GET(baseURL+"/node/name/:nodename/sysreport/diff", wrapper.GetNodeSysreportDiff)
+	router.GET(baseURL+"/node/name/:nodename/sysreport/snapshot", wrapper.GetNodeSysreportSnapshots)
 	router.GET(baseURL+"/object", wrapper.GetObjects)
 	router.GET(baseURL+"/object/path", wrapper.GetObjectPaths)
 	router.GET(baseURL+"/object/path/:namespace/:kind/:name", wrapper.GetObject)
@@ -3292,15 +4103,27 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.POST(baseURL+"/object/path/:namespace/:kind/:name/action/switch", wrapper.PostObjectActionSwitch)
 	router.POST(baseURL+"/object/path/:namespace/:kind/:name/action/unfreeze", wrapper.PostObjectActionUnfreeze)
 	router.POST(baseURL+"/object/path/:namespace/:kind/:name/action/unprovision", wrapper.PostObjectActionUnprovision)
+	router.POST(baseURL+"/object/path/:namespace/:kind/:name/clone", wrapper.PostVolumeClone)
 	router.GET(baseURL+"/object/path/:namespace/:kind/:name/config", wrapper.GetObjectConfig)
 	router.GET(baseURL+"/object/path/:namespace/:kind/:name/config/file", wrapper.GetObjectConfigFile)
 	router.POST(baseURL+"/object/path/:namespace/:kind/:name/config/file", wrapper.PostObjectConfigFile)
 	router.PUT(baseURL+"/object/path/:namespace/:kind/:name/config/file", wrapper.PutObjectConfigFile)
 	router.GET(baseURL+"/object/path/:namespace/:kind/:name/config/get", wrapper.GetObjectConfigGet)
+	router.GET(baseURL+"/object/path/:namespace/:kind/:name/config/history", wrapper.GetObjectConfigHistory)
+	router.GET(baseURL+"/object/path/:namespace/:kind/:name/config/history/:rev", wrapper.GetObjectConfigRevision)
+	router.POST(baseURL+"/object/path/:namespace/:kind/:name/config/rollback", wrapper.PostObjectConfigRollback)
 	router.POST(baseURL+"/object/path/:namespace/:kind/:name/config/update", wrapper.PostObjectConfigUpdate)
+	router.POST(baseURL+"/object/path/:namespace/:kind/:name/migrate/commit", wrapper.PostVolumeMigrateCommit)
+	router.POST(baseURL+"/object/path/:namespace/:kind/:name/migrate/prepare", wrapper.PostVolumeMigratePrepare)
+	router.POST(baseURL+"/object/path/:namespace/:kind/:name/resize", wrapper.PostVolumeResize)
+	router.DELETE(baseURL+"/object/path/:namespace/:kind/:name/snapshot", wrapper.DeleteVolumeSnapshot)
+	router.GET(baseURL+"/object/path/:namespace/:kind/:name/snapshot", wrapper.GetVolumeSnapshots)
+	router.POST(baseURL+"/object/path/:namespace/:kind/:name/snapshot", wrapper.PostVolumeSnapshot)
+	router.POST(baseURL+"/object/path/:namespace/:kind/:name/snapshot/rollback", wrapper.PostVolumeSnapshotRollback)
 	router.GET(baseURL+"/pool", wrapper.GetPools)
 	router.GET(baseURL+"/pool/volume", wrapper.GetPoolVolumes)
 	router.GET(baseURL+"/public/openapi", wrapper.GetSwagger)
+	router.GET(baseURL+"/public/schema/:name", wrapper.GetSchema)
 	router.GET(baseURL+"/relay/message", wrapper.GetRelayMessage)
 	router.POST(baseURL+"/relay/message", wrapper.PostRelayMessage)
 	router.GET(baseURL+"/resource", wrapper.GetResources)
@@ -3310,175 +4133,231 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9/XPbtrLov4LReTNt75PtOEl7z8mb/JDGTU9O0yTXTu6duXXGA5ErCccUwAKgbLfj",
-	"//0NvkiQBChSkp3U4S9NLeJjsdhd7AL78eckYaucUaBSTJ79OckxxyuQwPVfJ6c/nrxkdE4Wb/EK1C8p",
-	"iISTXBJGJ88mcgloXmQZyrFcIjZH+geSASICpZAWCaRoztlKf6BqjOmEqJ6/F8BvJtOJ/u3ZxH7i8HtB",
-	"OKSTZ5IXMJ2IZAkrrOaVN7lqJyQndDG5vZ1OTgqODRhNqFb4GqXua3g+73M1B1zjVZ6pz9+LyTQw5U9r",
-	"nBVYBhAB7kt4Ou9za0kzxjLA1E4AVL4imQTeniMjQiocg2qksKxahecrP1azEQkr0R7UtERwnXMQgjD6",
-	"DP12SWj66bdphmeQPVeQw6f/OFeoqhD0bvZvSOSZxLIQH/MUS0inigaezxlro678AXOOb/RKX69y4ILR",
-	"IDZJ9VETjkUfYRRhgShLY3j2Ok66qecNWREZwvGKSKRxhRJWUBmZSLcLE8/xdDJnfIWlgofKH55W+CBU",
-	"wgK4AYAtNm10xhb72maMAhvtbXB9tw8PD2u7LUj6/B/47/DoKfxwMEuOHx88fQI/HPz9SXp8MIfjR+n3",
-	"T354Avg/e+28WjjLMnYVIEb9u97yjC1EbNWm9wZWesMWbwiFAC445IxLJJdEIFqsZsAVsnMsJMr0f9gC",
-	"AZWcgIjuPtXfWgD4G6wkpshxAu/0xDhrQ0Jdkw6p6L53EfNblnbNwlJAAjJIJPMJ4DA2q+GvkFykj6f4",
-	"j+dQHAfF43ssl+3pmRYVQwBQgqTzMKgASmfH0yuY/UcUnjhatoZrKzhEnM0tIGp0gSRDAmiq6R/NGe8A",
-	"RfRhfG/wOkuvk+MpEuvkcS+mPYUM37zMCiGBvz4JKwKJ+YxIikqdwukEImNSfWBU/8nVcJGl2WEuSNpF",
-	"9dPJ9cGCHdg+FWQOVsUSNKqzUPt1J0DdIBuY85SkcSLkIFjBk0H86fpESHEu/nY8JXmQEk9ZBh2UiHOC",
-	"OMti4sh+CtDc/+Ewnzyb/O2oUiaPTDNxpOYM0tSZXXIcOw4pEXi8z10bQKhiwF8ITTXMtOJkO47Sdzrl",
-	"Tdfy9LjVNE5PDkyzhY5bjWmOgfjA7pjoIzQlCBkkDzsdS6FrGRXZ95ksYwnOliw643+pPT2BDKShzKC2",
-	"rj/3EXcfrJmhqRkJSLTSKBkyQ0zRFZFLVkg04zi5BCnqio7E4vJvBb3CVELaSzC6BRCBZxmcsiyb4eQy",
-	"uhDT7IK7dhuUGDu6b3f0Ni/qiDkBDnPgQBOYIpGwHBCmSlDTNWhlCNAl3FwxniKOr5DWAw8rDASAesV4",
-	"EoVozngCPVfXMAWG6PWBzVfKjqYAyZBvR1wtgZaGBF0g7NZ7iM5A6p9qzS2dONQ+VxSOOMiCU4Ew+hGn",
-	"6BR+L0BIBJwzfthF37+YqaIEfnnVh7hflFskGeKwYmuoky/Q9eE21PsGcGoMkaC2a77220yLE+BnJI0N",
-	"yF2bC9E45EvDqShIewX1M7+ayRyX6vD34OiYvlOzaExSH/UMZHQPBchhm8hyMJcQ7syHVJm358WjR0+S",
-	"yyv9L/xm/iQ0hWvzyyfzC8vNn+Yvza/mByPjEMtRRi4BPUf/9zk6eN4mFMDy+ZwXRIohpHJWzNRCYzgw",
-	"XzcdanqkD3gRG0biRc8xWHQI1m+Ej1R07GlBe+6qf+5oNbI6eQyj7vvkuVWnr8gZFebYfPzokfonYVQC",
-	"1fuD8zwjiSawo38Lc0/WT6V5z9ksg5WZpb7Od78oWB4/etpGwVuGXtrZb6eTp/cDjyeGzazH9zHrR4oL",
-	"uWSc/AGpmfbJZ1ns0/uY9S2T6BUrqF3p3+9jTneyfiArYIVd7T/uY+aXjM4zkugpv78fGn5NJXCKM3QG",
-	"fA0c/aTUCTP/vZCVmpYkgD5SvMYkUwqqlpC2qxr5BZ8RybFk3Fz56pcCrg4wSYz8EeXvXVDY3rfTScGz",
-	"sFyubIrfdKOpG/pTKQPNnYYa5UUhlx/YJdA2QHCdq2EusKwpFimWcCCJNl/aMtYN1Q2WN7TrEwLuJc7x",
-	"jGRE3rShc1cT3RPpVt1Dv5awag+fYrmRIjzwbqfGBlZoo8VKTd2Y4VMAWyvYPImyJX9V7ZpLsza3HmNq",
-	"4N28UNH70qEBfuv89Ed+Q4Rso3CLaUQ3IvU8n6Yb9twixkwfRIm562pDnOiHuo0gm+7mVU+Np697+3VS",
-	"u6m6WGD6dXpXQt5PRthuTlQ00GMXOXW31BaUTilRX3LFda0Wby0qYt/fleuOtajEY6vFyduzU0gYTwM7",
-	"l2EhgpecjpFbHyICZDqRMgs9SDi09BI5tvHUAmYG7eDSk7dn/8so9GabChUBxjw5/fHkRZaxpHzd3V2w",
-	"G2NwiHVprpJXhDIeRmfOuIy8/Pj41M3cQNP60UHSMDbLR/e4YC+XMruRMNkkU+Ibh2EVwnHCMnu1umkn",
-	"9QAvy+aKZKno1+vk7Zlqv5z1a/7PmWqdESGBQk/A3rjWai8ZJb1X9KttrBDJCukeEttkoLqlRdYXoLOy",
-	"eVuwZeVttkKhRoy33moBHkj+/PH9fenvJs6yd/PJs996QVvMxI2QsHLC+FM5ptq8/Y32z1mbBlcsHXAS",
-	"u3F+tUdUU6oIyQGvho93pvsFb0T83XPDTy3Y8c2wIAaXG36sUgMhNkfLGVqBEHgBqBCQotmNue2E6wRy",
-	"aS44P6i2RKAcy2SpfuKAiFS/qJHMr78XUADKgC70tX77aAlCgsv3PXvyRm4oQitYAuZyBliWC9Br8lex",
-	"UYbZRiuvbReS7b7tSqLTIVSikD+wy3tQouBTC3D9e1snFRcKiwo9gUvY6STDQg44FxsY9kavhtqM4w92",
-	"2+ugholhOUOGU5BVMLoh2rDLb7yzYE+C6NfqkNjTiGf+AbGvMSNGeFKZBj00bKNfOgVgM0DtI8uOUw4T",
-	"36pyMS8y4AFry8qFoEYrYA3cWtHd9OJG8fr0ACmGTaxAHXpiNBYaOImMBVNokPvqrwkHLLfSeTfruMpw",
-	"6mEYWGzU4K8Bpmd0w4XQrp0bnbHdcoPQV9llE3ViYeT8EoRQK6x0XkKxvqVvrfk1FRLTBLY1jl3/yjru",
-	"qTW6jp7e2M/adR09c7eFONfmhX5TeJGo4x4CZqQwD2EXw62d+hNaTbGpxvzUAVrMXsF5HuTpZAnJpShW",
-	"kY8kS7m5iStZb8ODyHSS8jx8KgJdBweYZ3B9scLXYcXefCW046vEfAEy3MDSzQVOnBUb1LOiZjzjyRKE",
-	"5JY3u0jonddUGaaYO2fu/sjLreteq2Ge4QRWQOVFzjKS3Gy8Z3bt35vm2lJmWXhsDhc98JRzwhry30O0",
-	"84Yy5JamxDgTva+RYad/kh2g4vkWlWt/iWEIFeQPqHFhzBV4ah9NN9+dm2YemCxnGVts3JIPrt3tdFIY",
-	"X+3tlUTF0B77esxqONCwm8dcHifV2abFI0GCmPr+Rj5TTJ1bkqP3AK16tOMTitvQCvUeMms4aok8KzbL",
-	"TTTS7/Clu5Usvx6QlbshMrw1WRC5LGaHCVsdsRyoWCdHbPXkKGEcjtxAxjXe/rH91X55DAbuo2ujb3ut",
-	"Xx53O1zt+4D017Jq4Af4zn3f4VK/DlgHCvtd6Js57ShdiPgV59vKMH/D4+PbjW28RNXvvL0TqHnZHVlf",
-	"yRl6pM4FVrpUQznzDvxW70XGZji7gOs8DE6jxQXTSqXYPNbFcGE4VUbyEl9kpcNUW90gYtPnnIMAvoY0",
-	"YsWzpHu9foOtFlGXsRdwDUkxdIxKFlcqZ5eK+c5vbyyPxhDiIrWPCG2ceEpNa1P3pgF4yntbBajp1j11",
-	"6bhxZb9stXs7n+F1jurgihhr+UTeYIkG+caJNUBBMYqoYd/hNIDBTsJucF5dH6gNUikUpVzqqwf8Wl7R",
-	"71MRiF5QrDHJ+rtcJIwq1JI6H3lMlsQMsjlnfwAdKidrYi6FOS4yOXk2x5mApguKa6ovqnkBiMxNfJYx",
-	"otFSh/1JNAOgyG4WSgvt5IvPaXW/nLIrqkBCCVsDN9fLGK3UooEqXKIcOGHp4TnV9+VyCYGvCGgqpsaR",
-	"zwAglqzIUjQDVNBkiekC0uk5xTRFJehXJMtUAwFSgaXXeajDJiMXtUJiPljqerES/XZd4QFnAzrknK2J",
-	"4jazcRucisqm+xTEFTBtYV9Qau+/BxhjCc4gbD7ubhBpJqxzl2Uln3Hae+5tZrVLLSnl70ZdZjlMuOVt",
-	"ZbhYTO9HXlkv9xMc0jO1h7L6Hx8OTNtPaaZhSI+0429vF/kABvR6f/xtLSM7xi6GkQdGf6PFhz3AAfbz",
-	"DlZRDao48vbk5OSjse0BYmMy0gsc5n8iLso24aPO+uBH7+X2aBFVkzUAm9YXEkRDA8linUymkzXTAmeu",
-	"eR/UL4VQOgcV5rdE/fMpcuNrf6R4Reji8BezEVtyvxmkCrfuutq3Dba82H8L8orxywAtcM74wMvOOYfI",
-	"aRC9jqXV/G1N3l719Xa5UvtVI8yY81DdGcvBoLqbVy69EDuahSNERRZ5r98HWD/f+Ej0vrH+TndPN5Pb",
-	"ry52it45881G1mngqULHvFqWcyHk2mBwwHfiZpi4rVAaoK/y4w7itgFXQODWZ9n9Gqq1d32dlru5Yxu3",
-	"wz4bts12dWzWHrZqw0bta5uC3kP9njdV38FPm9qFe+izpurU9aSpvn+Bz5kegtonTeQZ0TMfLxYcJ3Bh",
-	"jMi6PVHlG2qLO8DpzfBO/2aEbjehyDMi4y9uzWgH/Z4TXWUD/jBkjTk3WCdKhu/8pKIPApcc5TWds/aO",
-	"6pQ3oXQI+nfnNOd0Fe1rZz4dlrprDzZ4o7qEJA+Npqgo01NYEPzIQg2GcezT0BlY9U2ETiCCuc5qQehC",
-	"J946DBFAHk5IYgYILVsyJCTjeAFIg48Epma+3qg4e/FW54fZ5ElpN6X27mfg7UM15WbviW62NjVdqETr",
-	"MHCjfq6IGgfAgPPNgRw6PUsCj2oL7ZRHJYmpjpq4g1Ra3hjUR9A/14doZivoVjLiFwx6NTsoAiVqIxu/",
-	"RxVg0GNeyJU2OnDskW7oO9w2Txt3//R1v89WX+mr0ed8Aup/CaoPjJ1fbGrnRfSlZmEDeFvbgnMS/r2M",
-	"uN36Nr0VtBtSxFU/LMPUu8Wzz8I84sfADdx3EHEhcsCXsffySklrwb4i9EJfv1+sYBVxwyubiCuc97hy",
-	"MTtl9qW+CyWu6tf6C+36VAelNW9tmeWa+tDnrhf0NfIUTg/uf5ypDs1zP6J3iT0pXibKclMEXJsaNE1u",
-	"J9v0oG6I0Mnow/SKZLB1ZN69wBl+gRn4XHyn7rGGg8KX9/ZJqbfT0684381jdvgT6R6cYsshysO+1whn",
-	"0rn5xr1qu9xlt3/dvVsn2O2cWS9KYrkw2Xp7vPH2e87t479qidgn2aaPavWuG3JObdBAzV21/vDrHFZr",
-	"bqqt1XdrP6V42N6q9cRLwMTxRt/WujVD7GLfVkD0N9w8wANUbL7uYBf6IEXRtifb0ENgC9iB75fx4cuE",
-	"tv1Fwbu6dC7dgSaUaZ9xg4ol1hc/RrPnMkhGNYvsvwooQnfHITNvyA1yy+xroqg5fghZTbHfWLc2a8LZ",
-	"el1HVMoKhyGdodogRxlWOEV47dJhCMS48cGzgwulWOnLVw5Yi5ElmYex2jhgonmES8icyHKAsVySlfZl",
-	"oYweeH8dqT0taArz8MT2HGu8Z7isKU0+20houzwI9zinlgqRgxJyDDkEN70X9xhjzbJiBfHjsPPhbWnI",
-	"pIb9xpC9X53Vxg4Tw5oUAtujft9B/FaABIRvOfbuolcN9d8aVd0e7v3pkogLxvMlpjGn6FjQVkw37E2L",
-	"rSwm2uXEvqp7IT8VhBsowSBmOD1YhEaownzdkTZ80CIU4s2zDzoR0gaws4V4yajkIRGYwRqy+plBjIHt",
-	"IEthViy0+ql/vsJcF+nQ6eOmkzmW2GwaJYk7EzZCb2btBvusmL1Iwsl5qkdFByQHd1pV/7I8eBSIYhZ4",
-	"lTKx5V6m9ozoZNLl/lZJNJezvx0f8ute+UxrSr+LNdMQxBbv7M33nC04CBHMz5BjLgnO+lwHb/mi3Tti",
-	"O3BjGlvaW5ZClXbI5ZgMRMS7tExbLKHK6WRWsV0qozoIHXaAWpbRVg2tnl0RmSwDZg8ISWiZayounFeE",
-	"WvF1vIGc/CFjoOkiBb9WWQ+CiRx6vBvUKh+4blFdZCUWQ0ORwzkfDPJr85nRvbGCS7dpKAPbIO3tVDNd",
-	"8rJYYXqgFFg8ywDBdZ5hg1wkckjInCRIMhMuwJKk4DrJuX0uP6e5mbHmiV9/Tyki+Xz/+eHDe+f/n7AU",
-	"0Le/nb56+Z+Pnxx/mqIzm+D3h+/QAihwHZEwuzFzMk4WhCJh8nnOGY9Ah0LA+fogkRmEcCKWjMtpEzWi",
-	"WK0wv2kMrlOtHCL0WqKzf777+ObknL599wGZKAZTnMsDTLI4mFObauicqiXlBc+ZUEbHHOkXHvKH2ZVv",
-	"4XBxOEWFIHShuirpugZk05ieUwoLJolu+/+QAEABtD45fPpdcMtarCbNfY1w9+QGZxHa86++mqUmdBLU",
-	"KXJ3KohxVFbn8C5jmoaPjWdZkWutDmtzR/ICQgdcN9PjNOXhfAhfjjTYR8CEWuZ0iCDZeK3l49Upg/1q",
-	"k/gbEtAx/e8imrVm0DQavkjiGhFZna33sqXXXzvBQU/Pv0BcZD/vv2Ycz23HqmLvPERc2GoZaTSQ1q6j",
-	"o4Xi5XR2E/7uVNNYXgn18SLVFYD6uda1E2qVS2jAWwNu6inJ9Wn7hvE0kLmfcB436PbXxSXlBsyq2ujb",
-	"XheXFLrDhbEPiBggOTzwg5LDfN/BNq0D1oHCPdml5XBsMRjGN2zxE5X8phMVrk3c1A0QQSwvWNBurTp0",
-	"LXBfiRC2jlRo5i7mpHtHoj5ZngQbIMhPba8mWG60oVJnv1HPEWAD3ruDkh2qpa4wofXkibELr6ptdypE",
-	"B2ypW8Y8gAa9+nsmfu8H2lZCUqOlxl0FGod0W8CboyuQxJFQKWx1PKsfkwVlHATCWWb0YyQ5poKYkq2J",
-	"yxoQys2VGLecRgFYmpIES1DTYNmYS6AlpmlW2ltIDyKKTNtg2oFH2OBsA1eK7BjLm1yp+YJxpOVFJDqb",
-	"WC+ZOkyXcHNgfE9zTLgwNkGq60ZRCVzb/Or/zQbb4i82q++5wgUcXJEUEJ6xwlYfdWvy4ag2KHN+tQEv",
-	"yMUAwdzQ+BrpWSHLzGbaezYyR0S6eHfJyWIBHGFkB7CbiVzw/Dn194UyiYo8glUWLfPnYcLZ23ix4LDQ",
-	"G0qoZOideUfX1hngVNmcL9aYZJW5ZjoenlNdPkMgQpGbsRo9ZfQbiYRkOcIxQo2AP8BxIiYUNqmcnrIa",
-	"qxJptgVnV/hG6GwE+VTXg0Z4LvU+6bUNW9nQ0h2iLAEVKKzp10DS7eqUrsM/hSALZUnLcKlovBj4fNcv",
-	"xMzJMyd0yntzw2eGq/ws2154fisKv7rSthp8ef9QlsDS64hlKq6fqA47O3v7laVBXbFPX13EqXFimWU4",
-	"ucyIkO6HRWEKQpZpNCbTyb+Z/pQBXutKj4zp9f5eYClrycarbXERHm1tlxJJcA+D047wumyvycF5kfXo",
-	"+cE0bqm+5YDleKETsTV94Fyyn1z8wZIJiYQS6y4iBgFNc0aoPDR00zsiAqMrxrNUnxEFJb/rg8YbD5EU",
-	"qCRzArxeOoz8Tg8fP3r09OD4kaKKw2JWUFk8e3T8DH6YpU/xk9n33z8dkLDbphA3J6udW98h1mcViSBB",
-	"EziG1w/lTjYm1L+7KRtxRl8Eav9xcHysUWsZ7lDw9bMU1o/p8aGF99Cs4vB4OKLxPlFts013vcy1wLuE",
-	"m7CaoRRfXgzzAC87zUkG8WFFkSQgRLwVhevhk1umv6jVrwhd/JhmjTM9cDR66Nxwq1k+IbouBq91LDbR",
-	"E0JGfemhNYUX8KmDHLa/wikJ6q6ucPaRStJfZv/7lRpyAmqF+77DFU4dsAAKa3PsfoVT2XJugkIpOim7",
-	"opWDgO+RpRSXdHaDdDPzv7px8HzXmk3svjbHSkGHLFIetuZN4pr2zlLkz7yfW4Z6Nt3e++kDEiCZD54X",
-	"c+W2McckY2uTKD/kVOc59bpt87rMM7gOk4aApOBE3igiWpldmGFBkheF0cI01Hoj1K+VvFxKqT3mZ4A5",
-	"cNfa/PXKydl//c8HV/hUD6G/Nse49axl+1g5sXg3hjgycTRr4MIs+cnh8ePDx8YeBKqjnyZPDh8dPpp4",
-	"YclHuJDLo7IaX85CiWVe6tTzOvLZlGVGGP3r7N1b9D8wQ7oooD5Xk4woOBJMUSEAYXXevrDVO7GxipY6",
-	"daGyGIkUaM6yjF0pW97WKVZG5Tn9oO8gTMnhVFfJN7HWsJpBmprSvRh9s+CYym9QkmGyUrb0CstkqQZT",
-	"sBSCn1PXxJWf1lZZWQv4dTp5ph0EqtKGOiMhXoEELqK1I6omR6bI/+20ibAVvkYap8i9YEzRCl+TVbEy",
-	"EbTo8dNlpNS+9+YRKvJ+/GgV0E8+3WGN2go90Sq1tgRtaJQSrCPVqCocu6ntsVftdVPbJ14J0e623xsY",
-	"vu8zrmrk874mCI/rf/ukNt7n7N8+qY0wVvVvE8VZk09qhCP76HpkVJcjPHOiNMhuL9RncxNnEs+5ekD2",
-	"VsvcC9X8ojXfnIK5BLCZC9w9kokeRSYo1JIfo/oCUbsyx9jCvnDb3CEz5h7N7obKQr7mXzS92fq8m9r+",
-	"3auou6ntP4bR8Q606erJBMlzzgGM82qYPl/p75qAjNivilUZAf6ew1qfSFmGrLeHo0aBUki0Hi2m2l3H",
-	"SjbXTiCJL0Gdx3okHezopQQ1cUBoBnPG1YF0U0spWpV5Z+ZEMvVhpufUg/NKHSXaTwjQClO8UAdKRbb9",
-	"2MGgYOSHr4IfCrqJIz7aFh08cQpCKpqN8oMifC3ryyq22zCIgtVnkQzw2ulEJnDFXV/HGMcwi+UcNIBx",
-	"pkgwVFAsJVClpTnFGhFxToFq5wyEF5jQXizmcDoy2cNkMvMI5nhMX4LHNaI0RRhRuCpzJPlMZh9BKpMB",
-	"50Q3bFkTHK0KIRWfaMsAUt3xG86Y/EaR9jcKjG+MyVF2zjlLQGgXSzuTauXGNM8sNzRZckZZUXXTPq0O",
-	"eaqVUEdimQq7NoY5LpdYmLTbeTHLiFiCslg+LImw34kwSXgg1at7fl48evQkwTm5UH/qv+ySmTWtnEtp",
-	"B/xTbaupXytrzEw3J5kycqbn9AD9ixF6Zq7OptG5p1hZZ/ZT9TP6Vgsft3nlKnVr/XLrC8vv3HSvzYNy",
-	"x3RqGQfe5+iUV8pgzHTyNFQvrlnOpp8yt5wLU6QjQYw7rzL5FBKNI2VtNh1P8V1E+JmAj3+Zx6CGGdr2",
-	"mHZ8gNM2CiOGpXWFqa6HJC8gbGRSuLpwVUAJfWPqlz573Nvu/OvbiDuIOe2jQHEWlHPmlS8q6E5hQYQ5",
-	"n3XLUkJIhjis2BoaBIxWsJppXWCQnHujBt8s6OowbCnp6oPcs6irTd5P1mncbBZ2ZjtC4q4u5my7sKDT",
-	"c22WdHoVMfGjp7MuIQHppqfYJN46J9infHtjX7k3CjinuDZKIe8q2FgKB1eSHZhd+Tzybe+yJWOLo8QL",
-	"brSiJboHXiykQRsI+SNLb/amV4fnCmjWAqTzhsrYAjnX0vpW3oY3oRvTj91J8pWcOq4usE8XXgUP6w5Q",
-	"J4mfwe7SaVngYidTq/UYFbWkHhiiK7+ubjyXTkfDnhne4hWIHCfwzvlJxUqfe53OwLxhV33u8pGgtr6v",
-	"aOOL2VHl7rFJ8FbR3HctdquZAnvhHhCoE72imFVB32KUv7tTBxVHabHKPYlQ34KTYpXX7jBO3p6hPxgt",
-	"YzdDV2RKjLw9U13v8k7s5O3Z/zIKD5WJqbB7RLwC5jGp/drLNjZMZL/HcjlEWr9lKdyPpK6VVA1ssnYQ",
-	"dK7eJuxxWvmk09S6f39lJr2llTrpHOVYLo/+pO54vj3685LQ9Nb8dHuU++kromdDK9nFUFojVFFbqST0",
-	"ITfTRZck6t1aTWBJ826OrhYiAtT50sTSK9FZEqkjTuuLzxCHeaa9fcyVgB4MsbkLj/FjMFKSasPZVos7",
-	"7Hv4jTdclRXalx0qLXkzM2ypKT8EVmigIMAECn3IerSV0RAj2Q4kW6+UUuz8t/WExKYLKz8ep7qCm+Hk",
-	"EmiKqmpiwdsrk3ehZUXfi2+XXzDpYSp8ZYErf8+PSN5j21+/f+j7biuafRU7b2POo3tuX84G3szcm9pe",
-	"FjWJqOz6/n1U10VVdaXc9qMkA8w73JvVZ2HeOAT61nO6mWonFki/Q4S2HSu1vnkYfOzQ1c30rOPdyfD9",
-	"cn7unbxqSyDcKcOZSR6oeGwgXZ1HR3+6TFC3UV/lNrG/h6aX8FZKO0vB06tHj68H4PHVk8ZSjgntS2Mn",
-	"uvFIYyONDaKxnk7t7pAPH+sVFZYO4LuRYZ8Lh/9SdsOp8+w5I+ndK5qNerEPJO7n7oksL8TyCAubsyPm",
-	"4jXnIJZGN1dmovNmdUHH+i89CEqJSNga+E1cyzRb9b4QyxfCZMP4yinyK6GylIjLXYlMjTGMxk7UrCOJ",
-	"fR0klmOXxnsHGstxcokXMIzM3uuZRzr7SujscvF5qOxyMdLYw6cxkWB61Kxy1E1s5VWf3w0lOFnC4Tl9",
-	"WUboITU2BW7yE5g0hVWyxETHqJrcDFT/Coo0vRx5nJg4Pj0ittOooQphPMZN2BxiHNmcamgOWBYcBJph",
-	"1cYGupo08tIF8tGFDeCzd5QRl+yKUs4SXC2LgBj54ivgixvBIe/MRPDSCNlK+JogvLLnJil7Vk5xb/T0",
-	"ivFkNKwfGq0OCMHue4PjxRePdzgjqd22VISg4+6pyX+kz1xfN7DhZjbq+EFoCPahba9qwV0SfYX0TU4N",
-	"I8EbgtfeAkeWzoPk/jNIRVqwxlmhU4C5XFq1B3EzkM1bheYkCwhfR0266c/3eVH5i4FYDOjyk13vgC6v",
-	"VzlwwajudZdkbpcz0vgQGjfBqnHt4QQykICEqYklpqigApzeKx3Ri8FUX/qC6LYfDRT3RvlmVUMI/6Na",
-	"9pAOZ7r5p9HLZXdSrScK8NLXx+4qdAPfmV0f9ToS3Mt9omlVZ4mkKVqzKo+/QCnTvu+2Pp1TBGwyAHCB",
-	"6lrdoEwnbdblAFjBa9mDjN+90PdyN+iK6MRy8pxKfqNv62y+oiqDkY0gt7Um1CoOO4PGT8ss8HeifYxk",
-	"Gg1k60GoYllIncg2Sqlny0LqXLdleqw4TeqMU9TUVqgo22SVa1FkjSrrGa1y4ISl0zpVSn5zToMUiQUS",
-	"jFH1r1wC4V5tQptJzq7SAvSNOKcu74L6uZt+zxyKhhLwicsF2j8yYST2OyV2yfIOQg9Q7VYieGcBrKhT",
-	"Bui8oJJkNoNb2f9iwXECF4ZlFEXDdU44pBuIWqFilMhfEpHqXDZRW05ZDEANMVqFQXcQkVBj3eSntQ0J",
-	"v2uVdYioe0NWRPZpqKF/pXP73FXKBAnX0iD+QEgOeNXfktPQjXZcXxrns/SoqkneeWWhZTCfpfb+DK0I",
-	"ZRzRYjXTN3E0RTnj0ksKZ4atbsusBh27xTg5/fGkKrL+Rd+KNUDdC6V9GeaSooeqLG+QFl6BTJam8DY2",
-	"gg8bumjb7mjO8WIVz7xQr9t/HwKxnOx+iMSVWhivlAzhTWNKnnVC6U1QqrFW+LKs6yH+8xPX3UT119dm",
-	"38BCZFaVgxtDmXcVjj1D8vvkPrmX68r7jtq/49wqps7RmFtlSG4VdKRMZB0sXf6w1hkKvR+S+aL+g4BG",
-	"l0LwPTCGs/dnjHVcwf7ImNE1bS6GsopSUMg74jAP8z+aMo8PirW29ITo321Qa1NBakCHD3gxpDW7H1ky",
-	"+nEMFBj74/5Uv59tfDXcUgKY3qMMuHNvqJGT9nH0tk7a1lm836N3QMDmFsx3j/GbI/ONzPdZj7GydG2c",
-	"mcr6+dvyUznAV8tSJ8b98pRl2Qwnl3fosv5GF9Ec1e1RTj0sOdXPk0R7YWwppbZ2xHgoQmq36JhRcoyS",
-	"48uUHN2ukmelo+Q2MmMvroejVjOKmlHUPBRRo3qks5stJA4iFNneaBWNUgxIoDM75SiIRkE0CqJREPV1",
-	"Rd5K49mDZ+9oIY3SYpQWX4y0GJhAYQupca/5FMY3lZGfPjM/9XhV+Vg12p6r8q/+ZWV8HxnP8K9a5vSp",
-	"X4HKKt3fnk9MZLipXXE+QV5Fi7KSRbh6WsxB3e2+q2nxNXgEj1R9b165GYvH8JwBX4NOO5exhYgH57xh",
-	"i/uIU3zDFv0DClVjlmXsqmfjN4T2S9ehoBZ3HJ6o4Xm41aE2RFAYXW2TZN6VcMtirPdDvF+YfncfvPS5",
-	"WWQ8L+7gvOjHnGqX0iKDPsn8XFskTTq9uWewRSJC3+mPZ26SMUyqN9s4nI28s6sFsTuJb4h03xt5j/T0",
-	"OVQbI8A6U4LopEymXZnfzlYy7RR8u9bEv9MKXBrCkRw0OVga8AlCn5xdwb8GgcbK2Gab72N7rQ30IK2T",
-	"2J5tF8pt8PUXKKZ+p/QSC8p+MORS6g/ln0bzLv80enfVGGqNK527n3rdv0Cowf8eSoQ+AFIca0PeY23I",
-	"z8kX7eDhbsbYLRx45IyRM/4qnNH2ROnmjN1idUfOGDljX5yxBbEvyBq0w3Jvcv/Z9RgJfiT4+yb4LSg8",
-	"6AjVTeI7R4uPND7S+GcU6nnBFwMUmPe6+UjqI6n/9Ui9FVrbTeo7RcuOpD6S+n1qLs0Qqk2kvX1Y1EjZ",
-	"I2XfK2VfkUbt/g20bdp/+dR9NzmqA6jQtN0rEfXIXl8de4Wi6boZbNfouPEAGSn8M1oBkXC3TTSfj/c8",
-	"I9n/Fcm+VVcm4lOzW8GOL8UPf0i543urc1xD8OjF91kebG315DmpeRh38sIrksHX7mnmYWIk3D0S7nSj",
-	"4vGXosH927L9ya/BAU/7VN18+uWoKE/7tH360D1vppO8CLFDMXLDyA1fHTcMVmusOhOtIso4AqsZI4wu",
-	"4eaK8dSFyNjJ23X/DuMhM4YUfwb5VzcYbM6RXwxKxIAuQ0wN2+XeLA67nDFs6LNzZpGnuEfhJQH6nklM",
-	"UUEFSFNBD6RjVbEFrzZVyY8GkofBrwZtQ9j1o8LrkA5nuvlY1v3+GSxnLOsy0t8zlgWC+eqMpSOC8UpH",
-	"B2M0w8kl6KqUjOMFID3FdEJUy9/Vbk+mE9V68sz8M/UErbzJ1e9CckIXk9s7FdxqaQ84VYlGe7XJR2uW",
-	"FSvYtNf/rVs94B03C/xK9r2YZSQ5YjlQnJOurT+7wosFmCrKOyDfbqaNQ/2y8VviSyPJYoxDhm+OViAE",
-	"XnTyyqlq+KttN/Sc153f2nQMfY5J3eGlCa9/fXK3+qy/socaKK23ecPVYGOH78qJpDZNANsKQFc1PsUS",
-	"K011ztkKYaRXgZaAuZwBln1LoI/6VIgUDPcLVvBkA+ObNrsmstjM9EpADGl/StL7yZPhUBA7RBcgkUPl",
-	"WK/ckZklrU+3t7e3/z8AAP//l3rNttVrAQA=",
+	"H4sIAAAAAAAC/+y9+3MbN5I4/q+guN+q7N6NJfmRvV1/Kz94rc2uN47tk5S7qotcKnCmSWI1BCYARjKT",
+	"8v/+qcZjHhxgOENSsiLzlzji4NFodDcajX78NknFshAcuFaTl79NCirpEjRI89fp2d9OXws+Y/N3dAn4",
+	"SwYqlazQTPDJy4leAJmVeU4KqhdEzIj5geVAmCIZZGUKGZlJsTQfOI6RTBj2/KUEuZokE/Pby4n7JOGX",
+	"kknIJi+1LCGZqHQBS4rz6lWB7ZSWjM8nnz8nk9NSUgvGOlRL+olk/mt4vsbneg74RJdFjp+/VZMkMOXf",
+	"b2heUh1ABPgv4ekanztLmgqRA+VuAuD6e5ZrkN05cqY04hiwEZnZVuH5qo/1bEzDUnUHtS0JfCokKMUE",
+	"f0l+vmY8+/hzktMp5N8h5PDxPy4RVTWC3k//Dak+11SX6qcioxqyBGngu5kQXdRVP1Ap6cqs9M2yAKkE",
+	"D2KT1R8N4Tj0McEJVYSLLIbnRsdJP/W8ZUumQzheMk0MrkgqSq4jE5l2YeJ5mkxmQi6pRni4/vOLGh+M",
+	"a5iDtACI+aaNzsV8X9tMSWCjGxvc3u2jo6PWbiuWffdX+hc4eQF/fjJNnz578uI5/PnJX55nT5/M4OlJ",
+	"9u3zPz8H+l+Ddh4XLvJc3AaI0fxutjwXcxVbte29gZXeivlbxiGACwmFkJroBVOEl8spSER2QZUmufmP",
+	"mBPgWjJQ0d3noEIANDcYJaYqaArvzcQ070LCfZMeqei/9xHzO5H1zSIyIApySLVoEsBRbFaRtSesCYE/",
+	"S+iv30H5NCgeP1C96E4vjKgYAwAKkt7DoAYomz5NbmH6H1F44mjZGq6t4FBxNneA4OiKaEEU8MzQP5kJ",
+	"2QOKGsL4jcHbLH2TPk2IukmfDWLaM8jp6nVeKg3yzWlYEUjtZ8IyUukUXidQudD4QXDzp8ThIktzw1yx",
+	"rI/qk8mnJ3PxxPWpIfOwIkvwqM7C3dedAPWDbGDOM5bFiVCCEqVMR/Gn7xMhxZn6w9OEFUFKPBM59FAi",
+	"LRiRIo+JI/cpQHP/n4TZ5OXkD8e1Mnlsm6ljnDNIU+duyXHseKRE4Gl87tsAxpEBf2A8MzDzmpPdOKjv",
+	"9MqbvuWZcetpvJ4cmGYLHbce0x4D8YH9MTFEaGpQepLEpxMZ9C2jJvshk+UipflC9M54BjdMBVX5VyQ1",
+	"1w+nrhPpWhKWJURIQknJ2S8lkELCjH0yRNxsVPFQew0SbkbvxH8j8b3OBYcLKucQUB4vFtC6CN2I3At3",
+	"LUgqgWqIMbW2Y24F1CnkoC1fB+865vOQw+LCXdIsGhWkBudaEDtEQm6ZXohSk6mk6TVo1VYTNVXXfyj5",
+	"LeUaskHHil8AU3Saw5nI8ylNr6MLsc2upG+3QQX0o8vVWRmgrTPQpbRiXsh0AUo7Iityyqul/lJCyfi8",
+	"2yy2k5lcXcmSDwSueaUcfHNsr+MUJMxAAk8hISoVBRDK8QzmN2D0XCDXsLoVMiOS3hIc0JBhHKjvhUyj",
+	"EM2ETGHg6tZueWOubAHKRD3WkKcWpNGN3C6AV3dEPifUr/eInIM2P7Wau511PeA7ZDQiDTUoQsnfaEbO",
+	"4JcSlCYgpZBHEdFllviDnSrKfde3QzjvVbVFWhAJS3EDbd4CfnO0DWu9BZqBjAGX26/DNvNHNpdUwwch",
+	"8n7RgRRXCJHjWnAlXhiWZuui2jWOu5X8c5sF8pxlsZVK3+ZKrSmW1WW9LFkXtW09s57JqmhvTttwKPYr",
+	"nLNfIYIfuPVowIYJoVMl8lIDydk1kGcnczzSUOXU7MZQuVGr3QEImell2/7nt/MYHrHRtni882O4sytb",
+	"nsNnPTvde3FY28/2Bp6DjvKxAj2OkUUBDltOpYcMrVeX5cnJ8/T61vwLP9s/Gc/gk/3lo/1FFPZP+5eR",
+	"2fYHewgTUVhC+I7853fkyXddYQFUfzeTJdNqjLg457RQC6HD5t5XFfm6Zoblj/Zn0/VQlFNlFazgTtiv",
+	"w0ZaKWvuOYOb0HqU/14vqSJptzWZ+ReOjtp/1x0k5XM4IsjhLFMkpZxMoc0QoPo5YMxKzhlPA3vzWiwL",
+	"Kq2szanGw6uC0EmSnLZ+pNeAkM6E6YUG+x79VJlZg3ITuz3RzGx2FPwLOo/tp6bzYSi4ENEhxLARfuKq",
+	"h8VLPpDJ1w+7hqZsz+59a8qfk4kEVQiurJr/7OQE/0kF18ANo9CiyFlq5M3xv5WV4cMusB+kmOawtLO0",
+	"1/n+B4Tl2cmLLgreCfLazf45mby4H3gampmd9el9zPoTp6VeCMl+hcxO+/yLLPbFfcz6TmjyvSi5W+lf",
+	"7mNOr2xfsCWI0q32r/cxM75t5iy1Uz67lykvhCA/Ur7ye6tw7m/vh3/ecA2S05ycg7wBSf4upZB2/nsh",
+	"aZyWpUB+4vSGshwv80Y6u6448is5ZVpSLaR9XMTfCikKkJpZ2aeq3/ugcL0/J5NS5uEzoVZMfjaNEj/0",
+	"x0r+WusNjvJKKdA991KK3wnj+DYr5MqqAyqlOZWkht/qbN6CllxyBZLRHPUNoa7wN4JnuJ1WWU3PXJ5Q",
+	"/0sI04popnN7wWdaXXJnPv5jIcUUEqem43gZzGiZ6z9ZSBZUZrdUQkJyyhOymNLkklubk0pIiXoLDjnH",
+	"/2mAi8CgcVgdmfffNlacKu1+NAg4OqWaNj88YUvUWcwumtehyZzpRTk9SsXyWBTA1U16LJbPj0vN8mMz",
+	"xuSzR/cbDcvu/mc4xYbdf2UHSqxp9+VvE+DlEve5Hvdj4NRdwuah0Tr6I7ZbJyFnRTZjJBbKKCG98XrF",
+	"IAt6DXRHM3DjvWVKdzE1bnAVxZcZ/WOygYXc+u2kwZWXenEhroF3IYVPBQ5zRfVQzRLnnklQi6td+moP",
+	"Tn+LK5YFG8W793RbQ1sDfD9gL/LCTLENEuaSOqefilA26KHJJIIIplQ5cnbXZboKDihuOcjgl4JK4AFJ",
+	"rM31y2vkbvuIQSgp8e6NRkOc03w3v4cp40Zcj1yK/aFmGpqmoNSkIqLNvFMbnvzSq+1pIreJtaS55xsp",
+	"ZoQ8aHYLChzfYBeh04YtJHhas2xGoBnHDRPCxmta0CnLmV51QfbvxP1TmFb9Q29/YDXACyBjbYYvdXS1",
+	"wRi+22vgB2iqbrEDUa2D14vIPZ1prxeQXp+BKvMA1JlkNxEptmBcBz8wrjR1Bp6A7NOL4IdaJ44Kp86H",
+	"krMwCEbZbEm/Hje2JracAHPLTvyba7UkN6efoQLboSOIX+vY0cWtVXQ3koTtbl1YcTzj2zSsE3ILdnHA",
+	"DOv0voJ82DXFdfO3lTWEukUm3iWrUrx7LirtJb/8LdrinUNF7Pv7at2xFufrVFe3OH13fgapkFlg53Kq",
+	"wqTqBWXnQ0RAJxOt85D3XZTqQyK9OngtYHbQHil4+u78/wSHwWKpRkVA8KGD9ascfSS8K/Pueh3LWm0H",
+	"PGtZv6kl40KG0envcBsEgGnmB0raqi0Ln8u1h3n84KyWMl3psJG5CUR84ygsQzhORe78iDbtpBngddUc",
+	"SZarYb1O350buT8d1vyfU2yNV29wivDmPm99a9xLwdngFf3oGiMiRam912yXDLBbVuZDATqvmncFW165",
+	"biEKDWIa660X0ACpOX98f183d5Pm+fvZ5OXPg6Atp2qlNCwr09Eoajg379efP3YBsV+6diz8+SqDIuQ0",
+	"a5wUK9/kGUBGUprnyrijgHFPzJi6TsgtZcbBYSakey12s+J9ZwpEAk0XaGgjdE4Z33yIN8GKYxnJeVf8",
+	"1qj657SLnqXIRuh+fpwf3aG9LmeVlkCX48c7N/2CL6YtpLnhEwd2HHEOxOByw2SAAyERLKZkCUrROdj7",
+	"7HRlLY/wKYVCWyeYC2zLFHqhpQv8SQLBeAZlfTLMr4aCSA58brSz7mEbhIRW7r1OF4kqmt0VLIBKPQWq",
+	"qwWYNTVXsVGqu0bLRts+JLt9uxcR4GdD5I/s8gFAthmh8Xv3FqSuEIuInoCjTjLB990RmsL6FboevR5q",
+	"M44v3La3QQ0Tw2JKLKcQp3L1Q7Rhl982Tsc9CaIf62NzTyOeN4/MfY0ZeRlJ68vSgDuH1bi9SrQZoO4h",
+	"7sapholvVbWYVznIwE3ZyYXw3RZuQDq7TT+9+FEafQaAFMMmRVDHnhhrCw2cRLU713CN3roOb3ML2Kz1",
+	"K+2cM/tx67DRgr8FWGKNmHa4ENpNbKM373SiIIxvQ9UETyxKfFiCUrjC+hbAODVuG501v2kYT7YxF/j+",
+	"tb1goB7tOzY06WH3f9+xYQDoIM63eWWcTF6leNxD4GKtrE/i1fj7X9ubsaXY1GN+7AEtdoOjRRHk6RSk",
+	"dg83VxldqU0KMLapXZWMw433rMeh2AyfqlEVKphcBXRcNyMX+orOgjGXG0c13lFNMuznWLQLqnIZXv2C",
+	"5ZkE3pItG59eMlmEj33gN8EBZjl8ulrST+G7nP3KeM9XXcU5dBs4xriiqTdcBBXJqOWmdqXfaI9732ha",
+	"PQCNfLeKWk6LnKawBK6vCpGzdLXRu8G3/2Cb4xDuetcdW8LVADwVkom1A66BaB/tZfkpy5gNlvrQ4rPe",
+	"+Cs3QC3UOmxsggbGIVQ5L+eNNuLE+Wtu9tiwzRpgikLkYr5xSy58OzRqF5k/kLbTglFiNdi3wayWAy27",
+	"NZirwUlttunwSJAgkmY8VZMpKvO5p/cArTZop0kofkNr1DeQ2cLRx36fDm+5P3rtDdHjHTtSIeHYD2RD",
+	"/90f27+WvamG6z7xtEbf9qWsOs93eC1rAjJcjWyBH+A7/32Hd7I2YD0o3NPTa4VMWmwrw5obHh/fbeza",
+	"4277maNxAq2/b0TWV3GGGal3gbWyuKZ9Ng78Tu95LqY0R20oDM5aiythVBa1eayr8cIwQSvAgl7lVdRQ",
+	"V91gatPnQoICeQNZuIWJCO1bb7PBVotoy9gr+ARpOXaMVqzf1ebb1Ptm+zengSHUVebejbo4aSg1nU3d",
+	"mwbQuJ10JmlfHgZeFuK3R/dlq93b+Qxvc1QPV8RYq0nkayyxRr5xYg1QUIwiWtj3OA1gsJew1zivrQ+0",
+	"BqkVikouDdUDfqxeZfapCEQtMOgkPNzRNxUcUcvafNRgsjR2IZtJ8SvwsXKyJeacu+3k5YzmCtYdn31T",
+	"Y4mXJRA2syE31kpAFiatkSZTQG81u1kkK00MIL3ktQE9E7ccQSKpuAFp7eeULHHRwBGXpADJRHZ0yc2D",
+	"AF5ru18J8Ewl5qMDQC1EmWc2bildUD6HLLnk6BJcgX7L8hwbKDABRWadLbfgdUu00lSOlrqNXBDDdh3x",
+	"QPMRHQopbFwiZJs6fWg03acgroHpCvuSc2fgH3EZS2kO4evj7hciw4Rt7nKs1GSc7p43NrPepY6Uau5G",
+	"W2Z5TPjlbXVxOfceRvuQVy7U+5SG9MzKa6oJB+Xdt0LbMKRHuvG3vxc1AQzo9c3xt70ZuTF2uRg1wBh+",
+	"aWnCHuAA93mHW1ELqjjy9uQ32ERjB16fmCC7oiribn1VtQkfdS4IOWqX2+ONqJ5sDbCkvZAgGtaQrG7S",
+	"STK5MYkA0pnhfcBfSiVxOmV/S/GfjxGTtvuR0yXj86Mf7EZsyf12kDqdXN/bhWuw5cvFO9C3Ql4HaEFK",
+	"IUcaO2cSIqdB1BzL6/k737ypb7CXHe4XZKEuvf53Hgbsbp/xzELcaA6OEBU55L35EGD9YuMr2Ie19fd6",
+	"UPuZ3P/0slPU5ixZNjQxQcvQUtQs51PkmQuDB74XN+PEbdUtRF/Vxx3E7RpcAYHbnmV3M1Rn74bGAfRz",
+	"xzaepkM2bJvt6tmsPWzVho3a1zaJbOv3W+w7+u3WREWMfbfFTn1vtvj9Ab7XNhDUASf2jNi4Pl7NJU3h",
+	"yl4i2/eJOp9yZwAJNFuN7/Rvwfh2E6oiZzr+4raGMvueE13lGvxhyNbm3HA7QRm+85MKN56Obk/f8Jno",
+	"7qhJ6RtK92h+916BXlfBAW1KYJusZJBoEBm8xS4hycOjKTj9Fw9CM5eGAcN6LhroLKzGEmESpFJpsnYa",
+	"n1cplkchAijCCVftAKFla0GUFhJdEw34RFFu5xuMivNX70z+202uom5TWu9+Ft4hVFNt9p7oZuurpo+O",
+	"6RwGftQvFaTmARhxvnmQQ6dnReBRbaGb0rkiMexYpUiKx1m1RzA/t4dYT9nXr2TEDQxmNTsoAhVqIxu/",
+	"RxVg1GNeyFc4OnDskW7sO9w2Txt3//R1v89WX+mr0Zd8AhpuBDUHxs4vNq3zIvpSM4dIPCstWPj3Ks/L",
+	"1tb0TqqYkCKO/agOU+8Wzz5z4H3gBuwdTF2pAuh17L28VtI6sC8ZvzLm96slLCNueFUTdUuLASYXu1N2",
+	"X9q7UOGqbdbHFa+D0pm3tcxqTUPoc1cDfYs8ldeDhx9n2GH93I/oXWpPipcNrN0U9NilBkOT28k2M6gf",
+	"InQyNmH6nuWBG7jNgRbOgGS/eQ0+nCo0wTfPay5u+RF5M+dC2ii2W8l0WD3yiOhOxzhrT2JzHGGaKsx8",
+	"tKLLnCgty1SbrKmZSMsl7ry5O9BcCULdpbwFwYYw03vcgWYi1tgu7CVIIPoiPiRzjTmpHEBroQBm3A3s",
+	"b3dwwZTJl1UteWv+ao3XYTQ//vY3nuAGBRTh6Kxf0hkxBtRw5T+6rMCtKdR2h3tHHPiB6N/TvcQOHX7+",
+	"Heur8tgDIO40+MDqJ735Uwa7lKJX6k7xCOMdUPYQclANUV2lBo1wrh3QPTELfcEI2/vO3G2IwXahAlcV",
+	"sVzZWm8DPGiGOcsMiQ5wRNwk2fUIgNprJuT6v0YDrWCAtluNDwdoBQF0Vt9/t6zk364naMw7pTH6tiel",
+	"HWL3c3Kbk7H/LNz59Ntw3u31hAvb3kZ6h8SHr8qhDRcF79vSuXK2nHAxSSpULKgxq1u7idRBMmrZuz7k",
+	"NKBjS5h5/4VhG7Q+5BkOQINvIkpDobYf+VxDERpW91Qkcge6NWcRa6XCa5J97xFp/aPJEWFq31BVVUfJ",
+	"MLXIZttzJdbsCpMKi0E6iGFsePRG3LkCqBry7FcVGTTNB0FpsN/V/NJwFVpzMTbfzBNAQgQ3j1wzCYDF",
+	"P9Si1OjOmxBDrfiPKHBjSm6bHPVl59Bdw28Gkeu5pPy6SlWvoSCMV3t9RHBVjVTDyrzF4WCmkoEsOTYv",
+	"KB5EkB8Fj98iWPixQXzYwFRVgGWhVyZZDbUEaDF0FHk3HrSTduWVT4zzkIk9xa5v7H+bhDoBcRcwpI95",
+	"ow8Y1gsnbkaxfGC5a4CFlriuM64JTWNxDheK9B1JpWh48WrLRxjJijZvmhF645PTKeJ3wQ2uUiHNv4UE",
+	"anSQBZuFRfKadhotYVlB5vUdD5goNFsaN2Mu+JPGX8fU5BzMYBaeOJiXKfU5ItcP6c0R2lTTqwJk6ozh",
+	"9U1JlNO8cU2y9zrssot73wC9eIG4D425BE23ADcezn0DMhXLJRs60hjlfpOX4YAxbN2auJrf6661sBTc",
+	"Ioy1IQf7KiLNjVMvP5i8Yl0ywN93UCtrQAJKZTX27iolDvU/BlX9cZHD6Z+pKyGLBeWxULpYqH/szjuY",
+	"FjvpDo2jsjt3GoHiNYQbKMEiZjw92H4xqrBfd6SNJmgRCmnMsw86UdrldRJzzAKgZUg653ADefs4Y/ZZ",
+	"xkOWwbScTxL/8y2VfOIELbIp1dRuGmepP642Qm9n7Qf7vJy+SsNZPGsV0QMpwR+k9b+iCJ5SGMPfPRRt",
+	"yqVG/eIct6Hhq1QXG1pM//D0SH4aVAasuegqQ4GBILZ4b0f7IMVcglLBtGUFlZrRfIgTwZZ+kIMTGQXe",
+	"2WNLM96SJhNEd1EZzCUNJcpzH2y9UGu/8UF2TgWv7C5E3HJbr3Jq0jRCRkziCdLImNxFlzTZpkekxW6k",
+	"qN605X5V9Sx9uKlzt/o6RV3Kr3LbbrG9dWJcu8Pb5YNtg9Bj+8FlWQuF5ePzW6bTRWjrlWa8StgbP7iW",
+	"zL+xPN2I93rIGGimrPmPdaK0YO63AZ44rVrpvltUsVuq+djkPuE0cRb5rfns6I2xgkt35YQC26Ddk8t6",
+	"Ub9FuaT8Cd47TOJT+IQXL/sarQpI8VnDlsRjiog0LaUEnnoH1Ete2Blbsa2hVOvd2+4/Ly4+eGZP8Xb7",
+	"x5/Pvn/9X8+eP/2YkHNXJO7PfyJz4CCNbWW6snMKyeaME2XrMtlkriHoSAi4pq7MdB4s3KgWAm0Ma6hR",
+	"5XJJ5WptcJOd8YiQN5qc//P9T29PL/m79xfExgUbr9smYFrEwUxcdtJLjksqSlkIBUYQGsMT+9Xuyh/h",
+	"aH6UkFKhNCykwJPnBogrR3XJOcyFZqbt/08UAAmg9fnRiz8Ft6zDatra6Kvk8xZnEdprPnesF6c3xayS",
+	"Slrb4q2uIFPDAL9+X3UR4kv2CTJ/S9WyhNDh38/0NMsivgAPRxrsIwQZl5mMESQbnzKaePWK8qDTtNkx",
+	"pH83v6toostR0xj4IrkuVWR1lgq3jaPppgwbGEsTyDQyLJ5mPTL+c8+qYp5T6EtpK8Rn0dQ0bh09LZCX",
+	"s+kq/N2r7bFMbfjxKsO9Gxis0s3BWy1hDd4WcEnjAtGedmhg/Boy9xMg7wfd/onQjxC6crZG3/aJsKLQ",
+	"HR4Jm4CoEZKj7hWWHPb7Dvf2NmA9KNzTnb0aTsxHw/hWzP/OtVz1osK3iZsBAkQQSyUcvNPXHfoWuK/U",
+	"YlvH/q4XgJGsf0eiUQ4NCTZCkJ+5Xutg+dHGSp395hGKANslmnH50XGpGBbYzrceMwbWbfuzp3tgK90y",
+	"5lM/ytu0Yf4Y7JTTqWFgtdS4i+raId0V8PboCuR9Z1wrV5fP6cfMuAAr4s0jQLSkXBmvevcoqILmD+Ap",
+	"LbpTMJ4ZZzWchuq1uTDBEs/y6r5FzCCqzM0dzLjEK5fuiDnXZDfGYlWgmq+EJEZeRPIdMed33obpGlZP",
+	"bDRXQZlU9k6Q4R0HiUiaOz/+v91gV0DcFfC4RFzAk1uGb6RTrCRuLoR+TYFyrD6WIBJXNB8hmNc0vvaq",
+	"NGAdEkSOs0GyGdaYcBmktGTzOeDzrhvAv4H7dFSXvLkvXGhSFhGsNpNBre12jQl/36bzuYS52VDGtSDv",
+	"ne0NJQVQUxjyFXpn1dc12/HokpsyyAqfuf2M9eiZ4N9o+zRPY4QaAX+Es1xMKGxSORvKaicricOO3Raa",
+	"31oPUlIWCYEb4MT4gZpFiWLkysaWYLZpaCM+rs06+qZdm9KRSqhSbM5NDc+gFZvORz6hDkva4OWZFzrV",
+	"m4LlM8tVzVJFjYRXnbxWtbnfafCV/cFhx60jVtykfaJ67OwcPyMrhRsFvMjbhUwz67g4zWl6jQ8M/oe5",
+	"sfYmkyox3SSZYFQ84gToDeCShTDr/aWkWrcqNtXb4mOmu9ouZ5rRARdON8Kbqn3LGWpAzwvbuKP6VgNW",
+	"44VOxM70gXPJffIRvQuhNFEo1n2MOQGeFYJxfTRJ1vDQH2NMya2QeWbOiJKzX0poj0dYBhy9uME4cNUv",
+	"QuwXfvTs5OTFk6cnSBVH5bTkunx58vQl/HmavaDPp99++2JEjR9XdcierG5uY0Nsz6pSxYJX4BheLyJu",
+	"bXZH/JRrkfsPArV/ffL0qUGtY7gjJW9eZnDzjD89cvAe2VUcPR2PaLpPVLsCNX2vlh3wriFcttkovrIc",
+	"F1NZdZqxHOLDqtKUVY634vBp/OSO6a9aRQBDhh/bbO1M7zZUDXRusGpWz6u+i8VrG4vr6Akho7300JrC",
+	"C/jYQw7bm3D8CHdmwtlHPFRzmcPtK81eIbXCf9/BhNMGLIDC1hy7m3Dqu5yfoCwQceKW184TTUe6ZKJ0",
+	"Nl2Rsqj+1zQOnu9Gs4nZa71PaYiROs66rungvJ/NmfdjZWjXpxi8n01AQiSzUhIQpNfmdW24ezHNMsgS",
+	"9yiX2benpbBJVbuxtGw2C6vfJcdDLCPYwqvhKFRwQHTowzuxKHVR6tC4+Ba4qRS/yDOQRHFaqIUIjhI+",
+	"iitIzMFblbc0IIVG0WITJBxuN0ASPnbX8LH5vcpLdoN2h6WkcioUfQUM1+hhBzHcHigoSgJzfam0PQFY",
+	"RgjNbucBvLaLlA5BuxnDe0oeW4167kk58M5nSHVjXGmbxZWPDOjySDOSdIdA8y4svtROZQNwU/vX/6DY",
+	"YTlsXJppNGhBw0rlhaLfKzSH4t89nIO2cA+M7ofqJcTWfF+c2ZvQbMF9rcX0MbxvuA+Wb8M8BNd7YvuL",
+	"RpBr7f06oyxHP8NYQEcj5tMD2OiCIalBMrC+vT+yuYxUpl+Ycz3iCGUz7XkFwACinHsP/mJd5xOXdq9o",
+	"BrbbIIJW1r3NgcJ3kEjTLy/ZlFLTIqpHEm8hLeOuNAqyMMZVQVPEHlflsn7n8GIPnZQIekuqI/J/IIWt",
+	"PwEEPdhJJtmNi/ZCc7ylXWI3YXBoRYQOcHzbIJYHL5LDzg66JlajAYXtTdhelq5tZoC5AzN9yXtsF5zh",
+	"Eq3bN8Rc7VY7CNAQqBsRvJdbrhERaSmZXuHteWmBnlLF0lelNT8bIM0NFH+tqXShtQm4nQKVIH1r+9f3",
+	"njf+9b8Xk6QxhPm6PsbnxjOh89KcuAunfYEkNiXXDUib+mfy/Ojps6Nn9iEMOH7F306OTiaNDKfHmIDn",
+	"WMJMgjKwFSKUpf61YSOTRlWCLiUnlPzr/P078r8wJRfi2pWKSXOGkKAoKBUQiqbGVybDj3PVNLVo8D7H",
+	"OGFakZnIc3GLcl1aF3CV1KGkc0m5ro4BByPRONslN9XftTC5o4Brm2nEtjMDHV3yS/6e56t2R5fEyedv",
+	"MnKuet+V1gEUSdO6jWeTl8Z3Gtdw5nCUGGvCErQ5vX5ex9SSfrIzEe+1lZAl/cSW5dLm4STPXizMU9Dk",
+	"5eSXEky6FXecNPy8LNW3AzGeniwD8u9jUtUQMDv67OTEeeppF5hHiyJn1oX9+N8uJLYevzdFXqkXZnct",
+	"/bVX+v4HpK0XJyexUSqwjrGRaft0SNuntu3zIW2fY9tvh8CAjZqsbPauyZc/f/z80T2i4SUcf/uIHSyL",
+	"mD3FWYIvCqZ0A1KfIzKmVFkfo87ZlNhIV2QiPCht+p7MECoedqUC2QiklkJoywKGnxSAcXaoJ7EFmIRe",
+	"gLR9L7lAeseWegFMunYhov4H6GpvbbT9XVOQ80KNUtGDo4xavP/88XMygFSSLy47K1JyP0BGpMjByjxY",
+	"TgFtfnbkbwxlfUPSnLIlqnNLqtOFV7BLJS+5b+LKs6zRKXCJzwOZjYQAcvEeYxcgFTwjM5rii+WyVNo5",
+	"rTjl3Hj560tughusXGeyKcLR36OmtSPyysZBMFWLbEPjgqfgPG6oAcjWGePGI0YLQZaUr8itFHxuRjBp",
+	"u3ORXoOxVbgMArcLlkOfzLeyryPxQ+RUNznGZ3DUTb740ZAEDSZ2E+yGIW4S4nUhr9YbhLIhexyBVgtd",
+	"xCB99vzFt38eAqzJtJg65mmf4ubqh1xipOWH9+cXpK3IhMGqv9aQdV8OQtvWmj26fX89yfpnvtqwjc9P",
+	"ssMJH5LjL579dUDbZ38dJ/Ox7fMhbZ/v43xoqxLHv7Hssz0scgilrTiDG3EN9XmfmBOkq2MYFmDaudGZ",
+	"131HbZfctLTZTSXcuDBGYryjLME4HTrPu3rKQL1EWjBR3HrWDGolmzSSU4OGHqHblWSWGVmW4JE2EyXP",
+	"/NH4b83syebZ0ed2sdzIsknzAqhlCQGRcL/892X0oxcnL4a0fXHfuhTyiqPGY/scdkyn/uE1qGW9ws+W",
+	"QG3hR0/NzgfWDEJayW8MhZ+BdRl0lUO812k73ZUV7+jWmOcVdwS1BhcP52r3GJDvkHpCmYgethw/+cuQ",
+	"tn+xbYfI/JO/3httOoIKk6fNuRWnz+/N9x5R+0HCjTHjoEe7jQ311KhIBqnxulGJUV2dxuDbKaLpNaBJ",
+	"3oxkko03SvLaTJHeSo6SulnSt6JhpG8ETa2UhmVyyRtw3uINwr2hLymn6DLQINth7GBRcOCHr4IffBa6",
+	"OEf85Fr08ASG4ghZ0XmXH5Dwjaz3uZVW2zBIydssgj7I/irsbDbO2T3GOJe8wTlkBOMkRAlScqo1cLyc",
+	"+7c1wtQlB25COQmdU8YHsZjH6YHJHieT2ZAZz2PGZT6uEWVoHOFwW9UoazJZZVX3liJaMNOwY0Ryhpyp",
+	"M8iDvYR8g/eAb5C0v0EwvrGWpqpzIUUKyrzYupmwlR/TBmWseLqQgouy7mYyYHjkYSuFR2JVir41hj0u",
+	"sfS9KXtflNOcqYUxqF6gTd9+R7sPhltAZlb33WV5cvI8pQW7wj/NX27JwlnUiN4If2KMD/hrbYSz081Y",
+	"rkFiNNgT8i/B+Ll1tE2icyfGEc99qn8mf3QeZ3bzqlWa1riXLWH5Jz+dq4zRMx0u40njc3RKTOJKc1O8",
+	"kNDWdNVsJvBpy7koJ4DdbfIPNKkgEm3ahdZsJjPVnyLCz6bO+pdgGy+MF17Dx/tu1kVhxHDjAmfjl8Xa",
+	"eMPh9so1XzL+FvgcufnZYHvOI3xdGS7mTEQjp3lQztmYoKigO4M5U/Z8Ni0rCaGFc2pdI2CyhOXU6AKj",
+	"5NxbHHyzoGvDsKWkaw9yz6KuNfkwWWdws1nYOR/jgDxoiznXLizozFybJZ1ZRUz8cPesgA9xAelmptgk",
+	"3non2Kd8e+ti4jYKOK+4Nsffg2ATGTy51eKJ3ZUvI9/2LltyMT9OG2kinWiJ7kEjq6RFGyj9N5Gt9qZX",
+	"h+cKaNYKtI+dzsWc+EQU7a38HN6Efkw/8yfJV3LqWCy26cKZDxtP/Z2nc7tLZ67hjletzgPUY3wiDyG6",
+	"jgLvx3MVojzuFfYdXYLxanzvo6o/Jxs7nYONeKv73KXxv7W+r2jjy+lxHZa0SfDWeXHvWuzWMwX2wj8g",
+	"cC96VTmt0+eqg/zdnTq4Os7KZRF1sjotl0XLhnH67pz8KniV6THi6XT67hy73qVN7PTd+f8JDo+Vibly",
+	"e1RFNPZI7TeNelTjRDbG7I+R1mjHvR9J7dcUe6U16QRcG1dbNKkz2PDMJYv5yq70jlbapHOMb/HHv3F/",
+	"PH8+/g39rj/bnz4fF81E4NGzoZM2fCytMY7UVikJQ8jNdvmB8Wx4a5zAkebdHF0dRASo00YPtpKHV8Tp",
+	"MvcIdBrJqypObjA0CNiDr5WxKWPWg9UkpYHsaOjhd7Bw1bfQoexQa8mbmWFLTfkxsMIaCgJMgOjz9aSq",
+	"3EkHsh1Jthz0rZDXfef/O9tEDXHh8tm7ahPclKbXgB7ybqKI9Qr/+VI+W26Bj9ij3SO/tefHrBiw7W8+",
+	"PPZ9f/Ph69l5l6E2uufu5WykZebe1HacqU9lN/b3g7quqhTB9bYfU+VyLgbv4mc2kgV52TQkjOObkKiL",
+	"QShjSoOs49ZzUXnV2HBhXIDJakolmJ8YT/MygyxynTdE90rZnIcPlfIMfJvkxFdKWWldEyn4nosqrc/A",
+	"W+Z1BKjlVtO5jhJl6FslQWHAfaPq7FHwOa1RkenubmSNSWJqaGMlfpEHZXQLZdRQUw5U9kQu42dl32IV",
+	"+WPDOTAxznaQ/ckneWk5gJt7cQ8VmVkPNt7x3O+D2Ht1ijfcJMq9U8XATvJI1bg1pKPefPybr2/zORpT",
+	"0SX2D7AezbCVcUFk0Lj/HzxTH4Fn6kAayyRlfCiNnZrGu9LYEIvUf+PF8lSuzkp+IMqvjigHRut4rSCs",
+	"B9RkW0W23BPdnnmXxXOW3f0N2ol/F4B/uNAMJbKiVIv6Gh3zXbWB5cbogPYv76bvcy83rtgZUynGhqzi",
+	"aqndqg+lWmx3QX50FPmVUFnG1PWuRIZjjKOxU5z1QGJfB4kV1Fcz3oHGCppe0zmMI7MPZuYDnX0ldHY9",
+	"/zJUdj0/0NjjpzGVUn5chQv7UgK9xFbbnhvdSErTBYbTvPY/rgiOzUHafFsu8VWdBM4E39tMjdz8Ckia",
+	"jVJhJo+qH5G6aXAon+vQxgNjLJIrLUVmQHUpQZEpVSY3VjPPlo9Q5nMXmeyMmpFYk5pSzlPKXzdRdOCL",
+	"x88XPut0jyndCtla+Nro4qrnJilbJba+P3r6Xsj0cLF+bLQ6IrfEUAtOI3HCwYZzILXPO7s/cJNZkbq4",
+	"3o6a6pQC1HjtANje+dr2+D3sx6pzcHx4GDS2roZuIrVm+yadPRYt1BH5XlXPu6T1GukHgh9G8MbV7nje",
+	"I1n/ARpJyzvyEOrzD7e8NOxALpmqKdVzFKUm0/Qf92kM/8FCrEZ0+btb74gub5YFSCU41XdM5m45Bxof",
+	"Q+M200NcQ7X5TomC1OUBK7kCK5TxX0f0ajTVVw5Kpu1PFor7e1A3qxpD+D/hssd0ODfNPx5cr3Yn1XaW",
+	"nUal+Jg9zDRoRoKZo54pr4XUzr3E1CXhGVbvqYLOFMmE8fC1VScrRcB2K6AukWA9gW1pSTEjopSt1Hum",
+	"I1FGqV6RW2aysupLruXKqNou2V+d/s+lX3FOpbiKo96MK2dVwfU70T4OZBqNAh9AqGpRalMzNkqp54tS",
+	"m7KyVW7JOE2adI3cFhKrKdumZO1QZIsq2+kgC5BMZEmbKrVcXfIgReLNUAiO/1ovZg9QlYbVrdIB9I26",
+	"5D5pEf7cT7/nrvNoAj51p8uIsL4v53YlOLyfRVdV+WRLMc1NcaotHLU+BhWeI/K/6H6eyRVWzU7a+1Xk",
+	"1OVYx4sbZla0edsvuROaLu0UUlxhoxwO3N7D7VoUPZweYNutzqCdTyBkTx1g9JJrlrv8r1X/q7mkKVxZ",
+	"mYEEURcD6uNqRMXhSHpIRGoy4fWWhQJuidExv+mgIolKTJO/37iEMnets4+R9W/ZkukhDQ3035vMgHeV",
+	"cEnDJ20R/0RpCXQ5/CproDtcZIfSuJxmxzTPXeGQXpuNkcFymvlyoEvGhXTllG3Ns0JI3Ugpa4etzYXu",
+	"ChEz45ye/e30VQ3KQzYLroG6F0p7GPdFpAdrhIjSwveg0wWZSbEk1Ao+aumia7wgM0nny3jeJr/t1pRx",
+	"HwKxmux+iMQt7CCKHOHFKuidO0+vwQSFjY3Cl+d93i5fnrjuLgK1ns49NIfIzAU7HmJP9yAcByb0GZI5",
+	"7V7stfed8+eOM7PZ+tOHzGxjMrORY7wiT5LmDzcib/+QzubtHxSsdSmV3ANj+Pv+VIgeG/TfhLC6psvk",
+	"5AcPP4R44rDeL9j3sbHWlu5Gw7uNan1eThXoER0u6HxMa3E/suTgLDVSYOyP++tqmL3PpltKANv7IAPu",
+	"3OXwwEn7OHo7J23nLN7v0TsiKnoL5rvHIOkD8x2Y74seY8btUq1luF/LcuWbbMtP1QBfLUudWv/TM5Hn",
+	"mD7yDuNC3gLNQI7ocFC3D3LqdyCnhrnSYIttpdTWniiPRUjtFoJ2kBwHyfEwJUe/r+h55Sm6jczYi+/l",
+	"Qas5iJqDqHksogZ7ZNPVFhIHQyhcb7KMhgIHJNC5m/IgiA6C6CCIDoJoqCvyVhrPHjx7Dzekg7Q4SIsH",
+	"Iy1GZinZQmrca9KSw5vKgZ++MD8NeFX5qW60PVcVX/3LyuF95HCGf9UyZ0hVGUK5rStD/ng5saHxtqLM",
+	"5YQ06sxU9WXCtVdjDup+932lma/BI/hA1ffmlZuLeAzPOcgbMLkdczFX8eCct2J+H3GKb8V8eEAhNhZ5",
+	"Lm4HNn7L+LB8JQi1uuPwRAPP460tuSGCwupqmyTzroRblXK/H+J9YPrdffDSl2aRw3lxB+fFMObEXcrK",
+	"HIZkM/Rtibb5BGeNC1skIvS9+XjuJzmESQ1mG4+zA+/seoPYncQ3RLrvjbwP9PRgVJsqR/pxxmazIaRj",
+	"4pkxPjEVyyX+K0pdlD5tjSJT0LcAnOhbQTDVQF7nYSeK00IthMZqy5ioSJSaVHRisxWZblSDarQ2SW8Q",
+	"PVT2Fl+u8rif4lruLbdgNe0Z3GzT7Zzx9K65ws/12uzSw2eOFycvhrR98QAZyZPtEGaKMkg7Wzf+n8gz",
+	"ZIoZk0oP4YFzP9TDFtfr4B7k9jq5WcWzN5WTySZo21WJWV11+16FdTxtIGXcT6F6C+GBHAw5OBpoEoS5",
+	"8fQlbbAItNahbbb5PrbXQvc4rUqxPdsuBYfF17ay/Pd/T7TrjyXTeDTkUt37qj+txaT609pL6sbQalzb",
+	"SoaZRYaXW7f430PB9UdAiofC2fdYOPtL8kU36UM/Y+yWxuHAGQfO+L1wRteDsJ8zdsuxcOCMA2fsizO2",
+	"IPY5uwETaDKY3P/hezxogr/3vPgHDnmQHLIFSwQ9Xvt5Yue0IIdT4EDjX/AUKEo5H6HxfDDND6R+IPXf",
+	"H6l3cij0k/pOaREOqs6BNx60qrMeXLuJF7YPmD2wwoEVHjYr3DKdLkYwg23/CNnhbuojBHBnmGFQEYQD",
+	"P351/BiK5O7nyF0jsw8XkwOFf8GLSSTUehPNFwfT04Hsf49kn+aC9+TpeC2Baqw7hYVE3ST47AAZma6w",
+	"KhXckkKIHL+XSyCMM81ozn6FjNxioVhboFRT627KlGt4RC6MB6HgQGTJK59CHHsuRcmzxP9UFZNVoJDD",
+	"wokM/scM+9qs5nFog2YtF1TOQW+dXeAQo//Anb6359v1WoQRf77dirw9FJb4+w3NS6oHtX2zLEAqwU3z",
+	"u3cYPFQy/JLOIpYNjjFkaCgvfM9y+Nq9XBuYOBDuHgk32Xhh+F3R4P5tUMPJb40DXgyp1P7i96hnPGav",
+	"v2RSlCF2KA/ccOCGr44bRqs1Tp2JVp4XkoDTjAkl17C6FTLz92Y3ebdW9FE8XM+S4j/gsby1/2BRokZ0",
+	"GXPVcF3u7cbhlnMIWfzinLlgSgu56o+ZjXKhBGssVoHA603M+U838+EGYwq8W0Qe0g38Hrnn+DcJN597",
+	"T7jegwxtyrRipo2c44nl93+2YetqNQdzwYHJQkwmffGS+OOOWC7ZKB4jVLlHnzjXrds6qiIqj0Ol7OO7",
+	"x3ste/TcUhYZHVB9XYF57VIJKbkCbdJU4b/u7qW2uHyt88tPFpJH4tJm0Dbm/vUT4nVMh3PTPHIIbmLG",
+	"k8ON6a4ZbMnmkmrAFS2Z7juNipVNWUVVnexNC/Obcy8oJJgUbZ7NtHkeNx4ICbG+o765Z0Etmn4HhmHL",
+	"9UoJedZyS3BNl6XShAtNpqaQWqncZ7uQfbot/GhRZM/jR3NOHhwRvgZHBM/ejjWHVH2nnsMqDyFFl0AU",
+	"+9Xy50zIJdVBHqcmLaQTFG4U4150u0BltfErUy2mteA588tIzjUDeFQTmitBcqa0apz0RlApLQoyhZmQ",
+	"FhIrKAYw/geHu8fB+X5RQuQPUl40UW8U+YOguA9BIQEZPC4f/iHFbZOB9UKKcm7lg2X+jKlrKyBYDmql",
+	"NCxJTlcglRUMVoc3HYws8Up5HweeWagey5mLiznHBR1upw+G8JvpWut8T8HrZXUy+j5d0rUtLfH6lKaP",
+	"hHz9cmJvCAcC3icBJxtertYocehbVZsy1df7TtVGxOGFand6DesNF/QaBaenU5/XunE56Dv/DyL0IELv",
+	"UQcY8CpzBkr7G2TzhjuTYtmg866pagrmBlpANoTgH9mzzIHw75vw8U7W53OP9//A8d8mdiRy5BD7zIj0",
+	"CPiiooWkc3vtMxVqJi8nv+AuT5IJtp68tP8kjaNYrwr8XWnJOIZj3OXRjkt7xNXqCtHa5GMrZDbttRUv",
+	"j3jH7QK/kn0vpzlLj0UBnBasb+vPb+l8DnKyI/LdZlo588DxW+HLIKmNMbuibrr9aIEWRAWxvZolWdpP",
+	"x0mz4Eb7EzoBm4/tH2ct47ThNVRIji75xaK2jJFMpOUSuLa9mDKP2dRatguJm60ZKNKA3prarJ1ciVKm",
+	"9aO4qaJkm04hw2EEB7+2AiTJJLsBSXCvI1VDzk3jrgwxAgF1rI48aGcuacoH4OWyqnSSTFL7rGmfPu2L",
+	"p/vFvG+aZ81k70JlMF3/zp6ggzwgIaer4yUoRee958UZNvzRtRurgJrO71xdpCEqounw2jLPm9O7vfU3",
+	"V/ZY646Ybd4Q7ba2w3eVz6g1TQDbCCChVqLiLQrFm7tImVWQBVCpp0D1ZGASpINHSYgULPfb86Cf8W2b",
+	"XetCbWZ6FBBj2p+x7H7KTnkUxBRJfOyujlZ7qidV3Xzjb6apLtVXRmaOtD5+/vz58/8bAIIlyfx61QEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
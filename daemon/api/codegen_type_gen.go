@@ -7,10 +7,12 @@ import (
 	"time"
 
 	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/opensvc/om3/core/confighistory"
 	"github.com/opensvc/om3/core/instance"
 	"github.com/opensvc/om3/core/naming"
 	"github.com/opensvc/om3/core/node"
 	"github.com/opensvc/om3/core/resource"
+	"github.com/opensvc/om3/util/asset"
 )
 
 const (
@@ -18,6 +20,27 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for AssetItemKind.
+const (
+	AssetItemKindAssetItem AssetItemKind = "AssetItem"
+)
+
+// Defines values for AssetListKind.
+const (
+	AssetListKindAssetList AssetListKind = "AssetList"
+)
+
+// Defines values for AuthTokenItemType.
+const (
+	Access  AuthTokenItemType = "access"
+	Refresh AuthTokenItemType = "refresh"
+)
+
+// Defines values for AuthTokenListKind.
+const (
+	AuthTokenListKindAuthTokenList AuthTokenListKind = "AuthTokenList"
+)
+
 // Defines values for CapabilityItemKind.
 const (
 	CapabilityItemKindCapabilityItem CapabilityItemKind = "CapabilityItem"
@@ -68,6 +91,16 @@ const (
 	NodeListKindNodeList NodeListKind = "NodeList"
 )
 
+// Defines values for ObjectConfigRevisionItemKind.
+const (
+	ObjectConfigRevisionItemKindObjectConfigRevisionItem ObjectConfigRevisionItemKind = "ObjectConfigRevisionItem"
+)
+
+// Defines values for ObjectConfigRevisionListKind.
+const (
+	ObjectConfigRevisionListKindObjectConfigRevisionList ObjectConfigRevisionListKind = "ObjectConfigRevisionList"
+)
+
 // Defines values for ObjectItemKind.
 const (
 	ObjectItemKindObjectItem ObjectItemKind = "ObjectItem"
@@ -183,24 +216,131 @@ const (
 	Warn      Status = "warn"
 )
 
+// Defines values for SysreportChangeItemKind.
+const (
+	SysreportChangeItemKindSysreportChangeItem SysreportChangeItemKind = "SysreportChangeItem"
+)
+
+// Defines values for SysreportChangeListKind.
+const (
+	SysreportChangeListKindSysreportChangeList SysreportChangeListKind = "SysreportChangeList"
+)
+
+// Defines values for SysreportSnapshotItemKind.
+const (
+	SysreportSnapshotItemKindSysreportSnapshotItem SysreportSnapshotItemKind = "SysreportSnapshotItem"
+)
+
+// Defines values for SysreportSnapshotListKind.
+const (
+	SysreportSnapshotListKindSysreportSnapshotList SysreportSnapshotListKind = "SysreportSnapshotList"
+)
+
 // Defines values for Topology.
 const (
 	Failover Topology = "failover"
 	Flex     Topology = "flex"
 )
 
+// Defines values for VolumeSnapshotItemKind.
+const (
+	VolumeSnapshotItemKindVolumeSnapshotItem VolumeSnapshotItemKind = "VolumeSnapshotItem"
+)
+
+// Defines values for VolumeSnapshotListKind.
+const (
+	VolumeSnapshotListKindVolumeSnapshotList VolumeSnapshotListKind = "VolumeSnapshotList"
+)
+
+// Defines values for GetSchemaParamsName.
+const (
+	GetSchemaParamsNameCcfg GetSchemaParamsName = "ccfg"
+	GetSchemaParamsNameCfg  GetSchemaParamsName = "cfg"
+	GetSchemaParamsNameNode GetSchemaParamsName = "node"
+	GetSchemaParamsNameSec  GetSchemaParamsName = "sec"
+	GetSchemaParamsNameSvc  GetSchemaParamsName = "svc"
+	GetSchemaParamsNameUsr  GetSchemaParamsName = "usr"
+	GetSchemaParamsNameVol  GetSchemaParamsName = "vol"
+)
+
 // ArbitratorStatus defines model for ArbitratorStatus.
 type ArbitratorStatus struct {
 	Status Status `json:"status"`
 	Url    string `json:"url"`
 }
 
+// Asset The node asset inventory. The scalar properties like nodename,
+// serial or os_name are objects with the value, its title and its
+// source (probe, config or default). The hardware, lan, hba,
+// targets, uids and gids properties are lists.
+type Asset = asset.Data
+
+// AssetItem defines model for AssetItem.
+type AssetItem struct {
+	// Data The node asset inventory. The scalar properties like nodename,
+	// serial or os_name are objects with the value, its title and its
+	// source (probe, config or default). The hardware, lan, hba,
+	// targets, uids and gids properties are lists.
+	Data Asset         `json:"data"`
+	Kind AssetItemKind `json:"kind"`
+	Meta NodeMeta      `json:"meta"`
+}
+
+// AssetItemKind defines model for AssetItem.Kind.
+type AssetItemKind string
+
+// AssetItems defines model for AssetItems.
+type AssetItems = []AssetItem
+
+// AssetList defines model for AssetList.
+type AssetList struct {
+	Items AssetItems    `json:"items"`
+	Kind  AssetListKind `json:"kind"`
+}
+
+// AssetListKind defines model for AssetList.Kind.
+type AssetListKind string
+
 // AuthToken defines model for AuthToken.
 type AuthToken struct {
+	ExpiredAt        time.Time  `json:"expired_at"`
+	RefreshExpiredAt *time.Time `json:"refresh_expired_at,omitempty"`
+	RefreshToken     *string    `json:"refresh_token,omitempty"`
+	RefreshTokenId   *string    `json:"refresh_token_id,omitempty"`
+	Token            string     `json:"token"`
+	TokenId          *string    `json:"token_id,omitempty"`
+}
+
+// AuthTokenItem defines model for AuthTokenItem.
+type AuthTokenItem struct {
 	ExpiredAt time.Time `json:"expired_at"`
-	Token     string    `json:"token"`
+	Grants    []string  `json:"grants"`
+	Id        string    `json:"id"`
+	IssuedAt  time.Time `json:"issued_at"`
+	IssuedBy  string    `json:"issued_by"`
+	Owner     string    `json:"owner"`
+
+	// Parent the id of the refresh token used to issue the token
+	Parent    *string           `json:"parent,omitempty"`
+	RevokedAt *time.Time        `json:"revoked_at,omitempty"`
+	Type      AuthTokenItemType `json:"type"`
+}
+
+// AuthTokenItemType defines model for AuthTokenItem.Type.
+type AuthTokenItemType string
+
+// AuthTokenItems defines model for AuthTokenItems.
+type AuthTokenItems = []AuthTokenItem
+
+// AuthTokenList defines model for AuthTokenList.
+type AuthTokenList struct {
+	Items AuthTokenItems    `json:"items"`
+	Kind  AuthTokenListKind `json:"kind"`
 }
 
+// AuthTokenListKind defines model for AuthTokenList.Kind.
+type AuthTokenListKind string
+
 // Capability defines model for Capability.
 type Capability struct {
 	Name string `json:"name"`
@@ -228,6 +368,18 @@ type CapabilityList struct {
 // CapabilityListKind defines model for CapabilityList.Kind.
 type CapabilityListKind string
 
+// CheckResult defines model for CheckResult.
+type CheckResult struct {
+	Driver   string `json:"driver"`
+	Hint     string `json:"hint"`
+	Instance string `json:"instance"`
+	Path     string `json:"path"`
+	Status   string `json:"status"`
+	Type     string `json:"type"`
+	Unit     string `json:"unit"`
+	Value    int64  `json:"value"`
+}
+
 // Cluster defines model for Cluster.
 type Cluster struct {
 	Config ClusterConfig `json:"config"`
@@ -285,7 +437,22 @@ type Daemon struct {
 }
 
 // DaemonCollector defines model for DaemonCollector.
-type DaemonCollector = DaemonSubsystemStatus
+type DaemonCollector struct {
+	Alerts     []DaemonSubsystemAlert `json:"alerts"`
+	Configured time.Time              `json:"configured"`
+	CreatedAt  time.Time              `json:"created_at"`
+	ID         string                 `json:"id"`
+
+	// SpoolDepth the number of feed calls queued on disk, waiting for the collector to be reachable again
+	SpoolDepth int    `json:"spool_depth"`
+	State      string `json:"state"`
+}
+
+// DaemonCollectorSpool defines model for DaemonCollectorSpool.
+type DaemonCollectorSpool struct {
+	// SpoolDepth the number of feed calls queued on disk, waiting for the collector to be reachable again
+	SpoolDepth int `json:"spool_depth"`
+}
 
 // DaemonDNS defines model for DaemonDNS.
 type DaemonDNS = DaemonSubsystemStatus
@@ -583,20 +750,55 @@ type ObjectConfig struct {
 
 // ObjectConfigFile defines model for ObjectConfigFile.
 type ObjectConfigFile struct {
+	// Author The author of the configuration revision, if known. Ignored on write.
+	Author *string `json:"author,omitempty"`
+
+	// Data The ini configuration. The json or yaml structured documents are also accepted on write.
 	Data  []byte    `json:"data"`
 	Mtime time.Time `json:"mtime"`
 }
 
+// ObjectConfigRevision defines model for ObjectConfigRevision.
+type ObjectConfigRevision = confighistory.Revision
+
+// ObjectConfigRevisionItem defines model for ObjectConfigRevisionItem.
+type ObjectConfigRevisionItem struct {
+	Data ObjectConfigRevision         `json:"data"`
+	Kind ObjectConfigRevisionItemKind `json:"kind"`
+	Meta InstanceMeta                 `json:"meta"`
+}
+
+// ObjectConfigRevisionItemKind defines model for ObjectConfigRevisionItem.Kind.
+type ObjectConfigRevisionItemKind string
+
+// ObjectConfigRevisionItems defines model for ObjectConfigRevisionItems.
+type ObjectConfigRevisionItems = []ObjectConfigRevisionItem
+
+// ObjectConfigRevisionList defines model for ObjectConfigRevisionList.
+type ObjectConfigRevisionList struct {
+	Items ObjectConfigRevisionItems    `json:"items"`
+	Kind  ObjectConfigRevisionListKind `json:"kind"`
+}
+
+// ObjectConfigRevisionListKind defines model for ObjectConfigRevisionList.Kind.
+type ObjectConfigRevisionListKind string
+
 // ObjectData defines model for ObjectData.
 type ObjectData struct {
-	Avail       Status      `json:"avail"`
-	FlexMax     int         `json:"flex_max"`
-	FlexMin     int         `json:"flex_min"`
-	FlexTarget  int         `json:"flex_target"`
-	Frozen      string      `json:"frozen"`
-	Instances   InstanceMap `json:"instances"`
-	Orchestrate Orchestrate `json:"orchestrate"`
-	Overall     Status      `json:"overall"`
+	Avail Status `json:"avail"`
+
+	// CertExpireDays the number of days before the sec object certificate expiry
+	CertExpireDays *int `json:"cert_expire_days,omitempty"`
+
+	// CertNotAfter the sec object certificate expiry date
+	CertNotAfter *time.Time  `json:"cert_not_after,omitempty"`
+	FlexMax      int         `json:"flex_max"`
+	FlexMin      int         `json:"flex_min"`
+	FlexTarget   int         `json:"flex_target"`
+	Frozen       string      `json:"frozen"`
+	Instances    InstanceMap `json:"instances"`
+	Orchestrate  Orchestrate `json:"orchestrate"`
+	Overall      Status      `json:"overall"`
 
 	// PlacementPolicy object placement policy
 	PlacementPolicy PlacementPolicy `json:"placement_policy"`
@@ -650,9 +852,40 @@ type ObjectPaths = []string
 // Orchestrate defines model for Orchestrate.
 type Orchestrate string
 
+// OrchestrationPlan defines model for OrchestrationPlan.
+type OrchestrationPlan struct {
+	Refused []OrchestrationPlanRefusal `json:"refused"`
+	Steps   []OrchestrationPlanStep    `json:"steps"`
+
+	// Target The object global expect or node local expect the plan was evaluated for.
+	Target string `json:"target"`
+}
+
+// OrchestrationPlanRefusal defines model for OrchestrationPlanRefusal.
+type OrchestrationPlanRefusal struct {
+	Node   *string `json:"node,omitempty"`
+	Path   string  `json:"path"`
+	Reason string  `json:"reason"`
+}
+
+// OrchestrationPlanStep defines model for OrchestrationPlanStep.
+type OrchestrationPlanStep struct {
+	// Action The action name, one of freeze, shutdown, start, stop or unfreeze.
+	Action string `json:"action"`
+	Node   string `json:"node"`
+
+	// Order The rank of the step in the plan. Steps with the same order can run in parallel.
+	Order int `json:"order"`
+
+	// Path The object path, or empty for a node action.
+	Path   string  `json:"path"`
+	Reason *string `json:"reason,omitempty"`
+}
+
 // OrchestrationQueued defines model for OrchestrationQueued.
 type OrchestrationQueued struct {
 	OrchestrationID openapi_types.UUID `json:"orchestration_id"`
+	Plan            *OrchestrationPlan `json:"plan,omitempty"`
 }
 
 // PlacementPolicy object placement policy
@@ -663,15 +896,18 @@ type PlacementState string
 
 // Pool defines model for Pool.
 type Pool struct {
-	Capabilities []string  `json:"capabilities"`
-	Errors       *[]string `json:"errors,omitempty"`
-	Free         int64     `json:"free"`
-	Head         string    `json:"head"`
-	Name         string    `json:"name"`
-	Size         int64     `json:"size"`
-	Type         string    `json:"type"`
-	Used         int64     `json:"used"`
-	VolumeCount  int       `json:"volume_count"`
+	Capabilities    []string  `json:"capabilities"`
+	DataPercent     *float64  `json:"data_percent,omitempty"`
+	Errors          *[]string `json:"errors,omitempty"`
+	Free            int64     `json:"free"`
+	Head            string    `json:"head"`
+	MetadataPercent *float64  `json:"metadata_percent,omitempty"`
+	Name            string    `json:"name"`
+	Overcommit      *float64  `json:"overcommit,omitempty"`
+	Size            int64     `json:"size"`
+	Type            string    `json:"type"`
+	Used            int64     `json:"used"`
+	VolumeCount     int       `json:"volume_count"`
 }
 
 // PoolItems defines model for PoolItems.
@@ -733,6 +969,13 @@ type PostInstanceProgress struct {
 	State     string             `json:"state"`
 }
 
+// PostNodeChecks defines model for PostNodeChecks.
+type PostNodeChecks struct {
+	// Degrade degrade the overall status of the instances owning a breached check instance
+	Degrade bool          `json:"degrade"`
+	Results []CheckResult `json:"results"`
+}
+
 // PostNodeDRBDConfigRequest defines model for PostNodeDRBDConfigRequest.
 type PostNodeDRBDConfigRequest struct {
 	AllocationID openapi_types.UUID `json:"allocation_id"`
@@ -932,9 +1175,129 @@ type SubsetConfig = instance.SubsetConfig
 // SubsetsConfig defines model for SubsetsConfig.
 type SubsetsConfig = []SubsetConfig
 
+// SysreportChange defines model for SysreportChange.
+type SysreportChange struct {
+	// Action added, changed or removed
+	Action string `json:"action"`
+
+	// Diff the unified diff of the file or command output
+	Diff string `json:"diff"`
+
+	// From the id of the older snapshot
+	From string `json:"from"`
+
+	// Name the file path or the command
+	Name string `json:"name"`
+
+	// To the id of the newer snapshot
+	To string `json:"to"`
+
+	// Type file or command
+	Type string `json:"type"`
+}
+
+// SysreportChangeItem defines model for SysreportChangeItem.
+type SysreportChangeItem struct {
+	Data SysreportChange         `json:"data"`
+	Kind SysreportChangeItemKind `json:"kind"`
+	Meta NodeMeta                `json:"meta"`
+}
+
+// SysreportChangeItemKind defines model for SysreportChangeItem.Kind.
+type SysreportChangeItemKind string
+
+// SysreportChangeItems defines model for SysreportChangeItems.
+type SysreportChangeItems = []SysreportChangeItem
+
+// SysreportChangeList defines model for SysreportChangeList.
+type SysreportChangeList struct {
+	Items SysreportChangeItems    `json:"items"`
+	Kind  SysreportChangeListKind `json:"kind"`
+}
+
+// SysreportChangeListKind defines model for SysreportChangeList.Kind.
+type SysreportChangeListKind string
+
+// SysreportSnapshot defines model for SysreportSnapshot.
+type SysreportSnapshot struct {
+	// Commands the number of command outputs in the snapshot
+	Commands  int       `json:"commands"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// Csum the checksum of the snapshot content
+	Csum string `json:"csum"`
+
+	// Files the number of files in the snapshot
+	Files int    `json:"files"`
+	ID    string `json:"id"`
+}
+
+// SysreportSnapshotItem defines model for SysreportSnapshotItem.
+type SysreportSnapshotItem struct {
+	Data SysreportSnapshot         `json:"data"`
+	Kind SysreportSnapshotItemKind `json:"kind"`
+	Meta NodeMeta                  `json:"meta"`
+}
+
+// SysreportSnapshotItemKind defines model for SysreportSnapshotItem.Kind.
+type SysreportSnapshotItemKind string
+
+// SysreportSnapshotItems defines model for SysreportSnapshotItems.
+type SysreportSnapshotItems = []SysreportSnapshotItem
+
+// SysreportSnapshotList defines model for SysreportSnapshotList.
+type SysreportSnapshotList struct {
+	Items SysreportSnapshotItems    `json:"items"`
+	Kind  SysreportSnapshotListKind `json:"kind"`
+}
+
+// SysreportSnapshotListKind defines model for SysreportSnapshotList.Kind.
+type SysreportSnapshotListKind string
+
 // Topology object topology
 type Topology string
 
+// VolumeMigration defines model for VolumeMigration.
+type VolumeMigration struct {
+	// Holders The paths of the objects using the volume, to stop before the commit.
+	Holders   []string           `json:"holders"`
+	SessionID openapi_types.UUID `json:"session_id"`
+}
+
+// VolumeSnapshot defines model for VolumeSnapshot.
+type VolumeSnapshot struct {
+	CreatedAt time.Time `json:"created_at"`
+	Name      string    `json:"name"`
+
+	// Used The space consumed by the snapshot, in bytes. Zero if the pool driver can not report it.
+	Used int64 `json:"used"`
+
+	// Volume The pool volume name.
+	Volume string `json:"volume"`
+}
+
+// VolumeSnapshotItem defines model for VolumeSnapshotItem.
+type VolumeSnapshotItem struct {
+	Data VolumeSnapshot         `json:"data"`
+	Kind VolumeSnapshotItemKind `json:"kind"`
+	Meta InstanceMeta           `json:"meta"`
+}
+
+// VolumeSnapshotItemKind defines model for VolumeSnapshotItem.Kind.
+type VolumeSnapshotItemKind string
+
+// VolumeSnapshotItems defines model for VolumeSnapshotItems.
+type VolumeSnapshotItems = []VolumeSnapshotItem
+
+// VolumeSnapshotList defines model for VolumeSnapshotList.
+type VolumeSnapshotList struct {
+	Items VolumeSnapshotItems    `json:"items"`
+	Kind  VolumeSnapshotListKind `json:"kind"`
+}
+
+// VolumeSnapshotListKind defines model for VolumeSnapshotList.Kind.
+type VolumeSnapshotListKind string
+
 // DRBDConfigName defines model for DRBDConfigName.
 type DRBDConfigName = string
 
@@ -1004,12 +1367,21 @@ type InPathNamespace = string
 // InPathNodeName defines model for inPathNodeName.
 type InPathNodeName = string
 
+// InPathRevision defines model for inPathRevision.
+type InPathRevision = string
+
+// InQueryCloneTarget defines model for inQueryCloneTarget.
+type InQueryCloneTarget = string
+
 // InQueryDeletes defines model for inQueryDeletes.
 type InQueryDeletes = []string
 
 // InQueryDisableRollback defines model for inQueryDisableRollback.
 type InQueryDisableRollback = bool
 
+// InQueryDryRun defines model for inQueryDryRun.
+type InQueryDryRun = bool
+
 // InQueryEvaluate Dereference, scope and convert the keyword raw value.
 type InQueryEvaluate = bool
 
@@ -1025,18 +1397,36 @@ type InQueryKeywords = []string
 // InQueryLeader defines model for inQueryLeader.
 type InQueryLeader = bool
 
+// InQueryMigratePool defines model for inQueryMigratePool.
+type InQueryMigratePool = string
+
 // InQueryRequesterSid defines model for inQueryRequesterSid.
 type InQueryRequesterSid = openapi_types.UUID
 
+// InQueryResizeSize defines model for inQueryResizeSize.
+type InQueryResizeSize = string
+
+// InQueryRevision defines model for inQueryRevision.
+type InQueryRevision = string
+
 // InQueryRid defines model for inQueryRid.
 type InQueryRid = string
 
 // InQuerySets defines model for inQuerySets.
 type InQuerySets = []string
 
+// InQuerySnapshotName defines model for inQuerySnapshotName.
+type InQuerySnapshotName = string
+
 // InQuerySubset defines model for inQuerySubset.
 type InQuerySubset = string
 
+// InQuerySysreportRev defines model for inQuerySysreportRev.
+type InQuerySysreportRev = string
+
+// InQuerySysreportSince defines model for inQuerySysreportSince.
+type InQuerySysreportSince = time.Time
+
 // InQueryTag defines model for inQueryTag.
 type InQueryTag = string
 
@@ -1067,12 +1457,21 @@ type N408 = Problem
 // N409 defines model for 409.
 type N409 = Problem
 
+// N429 defines model for 429.
+type N429 = Problem
+
 // N500 defines model for 500.
 type N500 = Problem
 
 // N503 defines model for 503.
 type N503 = Problem
 
+// PostAuthRefreshParams defines parameters for PostAuthRefresh.
+type PostAuthRefreshParams struct {
+	// Duration max token duration, maximum value 24h
+	Duration *string `form:"duration,omitempty" json:"duration,omitempty"`
+}
+
 // PostAuthTokenParams defines parameters for PostAuthToken.
 type PostAuthTokenParams struct {
 	// Role list of api role
@@ -1080,6 +1479,15 @@ type PostAuthTokenParams struct {
 
 	// Duration max token duration, maximum value 24h
 	Duration *string `form:"duration,omitempty" json:"duration,omitempty"`
+
+	// Totp the current TOTP code, required if the user is enrolled to the TOTP second factor
+	Totp *string `form:"totp,omitempty" json:"totp,omitempty"`
+
+	// Refresh also create a refresh token, to use with POST /auth/refresh
+	Refresh *bool `form:"refresh,omitempty" json:"refresh,omitempty"`
+
+	// RefreshDuration max refresh token duration, maximum value 90d
+	RefreshDuration *string `form:"refresh_duration,omitempty" json:"refresh_duration,omitempty"`
 }
 
 // PostDaemonJoinParams defines parameters for PostDaemonJoin.
@@ -1130,6 +1538,18 @@ type GetNodesParams struct {
 	Node *NodeOptional `form:"node,omitempty" json:"node,omitempty"`
 }
 
+// GetNodesAssetParams defines parameters for GetNodesAsset.
+type GetNodesAssetParams struct {
+	// Node node selector expression.
+	Node *NodeOptional `form:"node,omitempty" json:"node,omitempty"`
+}
+
+// PostPeerActionDrainParams defines parameters for PostPeerActionDrain.
+type PostPeerActionDrainParams struct {
+	// DryRun Return the orchestration plan without queuing the orchestration.
+	DryRun *InQueryDryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
 // PostPeerActionFreezeParams defines parameters for PostPeerActionFreeze.
 type PostPeerActionFreezeParams struct {
 	RequesterSid *InQueryRequesterSid `form:"requester_sid,omitempty" json:"requester_sid,omitempty"`
@@ -1189,6 +1609,9 @@ type PostNodeConfigUpdateParams struct {
 type PostDaemonShutdownParams struct {
 	// Duration max duration
 	Duration *Duration `form:"duration,omitempty" json:"duration,omitempty"`
+
+	// DryRun Return the orchestration plan without queuing the orchestration.
+	DryRun *InQueryDryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
 }
 
 // GetDaemonEventsParams defines parameters for GetDaemonEvents.
@@ -1334,6 +1757,15 @@ type GetInstanceLogsParams struct {
 	Lines *LogLines `form:"lines,omitempty" json:"lines,omitempty"`
 }
 
+// GetNodeSysreportDiffParams defines parameters for GetNodeSysreportDiff.
+type GetNodeSysreportDiffParams struct {
+	// Rev A sysreport snapshot id, or a <id>..<id> snapshot range. The ids can be unique prefixes.
+	Rev *InQuerySysreportRev `form:"rev,omitempty" json:"rev,omitempty"`
+
+	// Since Compare the latest snapshot to the last snapshot taken before this date.
+	Since *InQuerySysreportSince `form:"since,omitempty" json:"since,omitempty"`
+}
+
 // GetObjectsParams defines parameters for GetObjects.
 type GetObjectsParams struct {
 	// Path object selector expression.
@@ -1346,6 +1778,37 @@ type GetObjectPathsParams struct {
 	Path Path `form:"path" json:"path"`
 }
 
+// PostObjectActionGivebackParams defines parameters for PostObjectActionGiveback.
+type PostObjectActionGivebackParams struct {
+	// DryRun Return the orchestration plan without queuing the orchestration.
+	DryRun *InQueryDryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// PostObjectActionStartParams defines parameters for PostObjectActionStart.
+type PostObjectActionStartParams struct {
+	// DryRun Return the orchestration plan without queuing the orchestration.
+	DryRun *InQueryDryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// PostObjectActionStopParams defines parameters for PostObjectActionStop.
+type PostObjectActionStopParams struct {
+	// DryRun Return the orchestration plan without queuing the orchestration.
+	DryRun *InQueryDryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// PostObjectActionSwitchParams defines parameters for PostObjectActionSwitch.
+type PostObjectActionSwitchParams struct {
+	// DryRun Return the orchestration plan without queuing the orchestration.
+	DryRun *InQueryDryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// PostVolumeCloneParams defines parameters for PostVolumeClone.
+type PostVolumeCloneParams struct {
+	// Target The path of the vol object to create.
+	Target       InQueryCloneTarget   `form:"target" json:"target"`
+	RequesterSid *InQueryRequesterSid `form:"requester_sid,omitempty" json:"requester_sid,omitempty"`
+}
+
 // GetObjectConfigParams defines parameters for GetObjectConfig.
 type GetObjectConfigParams struct {
 	// Evaluate evaluate
@@ -1362,6 +1825,12 @@ type GetObjectConfigGetParams struct {
 	Impersonate *InQueryImpersonate `form:"impersonate,omitempty" json:"impersonate,omitempty"`
 }
 
+// PostObjectConfigRollbackParams defines parameters for PostObjectConfigRollback.
+type PostObjectConfigRollbackParams struct {
+	// Rev A configuration revision id, or a unique prefix of a revision id.
+	Rev InQueryRevision `form:"rev" json:"rev"`
+}
+
 // PostObjectConfigUpdateParams defines parameters for PostObjectConfigUpdate.
 type PostObjectConfigUpdateParams struct {
 	Delete *InQueryDeletes `form:"delete,omitempty" json:"delete,omitempty"`
@@ -1369,6 +1838,42 @@ type PostObjectConfigUpdateParams struct {
 	Set    *InQuerySets    `form:"set,omitempty" json:"set,omitempty"`
 }
 
+// PostVolumeMigrateCommitParams defines parameters for PostVolumeMigrateCommit.
+type PostVolumeMigrateCommitParams struct {
+	RequesterSid *InQueryRequesterSid `form:"requester_sid,omitempty" json:"requester_sid,omitempty"`
+}
+
+// PostVolumeMigratePrepareParams defines parameters for PostVolumeMigratePrepare.
+type PostVolumeMigratePrepareParams struct {
+	// Pool The name of the pool to move the volume to.
+	Pool         InQueryMigratePool   `form:"pool" json:"pool"`
+	RequesterSid *InQueryRequesterSid `form:"requester_sid,omitempty" json:"requester_sid,omitempty"`
+}
+
+// PostVolumeResizeParams defines parameters for PostVolumeResize.
+type PostVolumeResizeParams struct {
+	// Size The new volume size, absolute like 20g or relative to the configured size like +5g.
+	Size InQueryResizeSize `form:"size" json:"size"`
+}
+
+// DeleteVolumeSnapshotParams defines parameters for DeleteVolumeSnapshot.
+type DeleteVolumeSnapshotParams struct {
+	// Name A volume snapshot name.
+	Name InQuerySnapshotName `form:"name" json:"name"`
+}
+
+// PostVolumeSnapshotParams defines parameters for PostVolumeSnapshot.
+type PostVolumeSnapshotParams struct {
+	// Name A volume snapshot name.
+	Name InQuerySnapshotName `form:"name" json:"name"`
+}
+
+// PostVolumeSnapshotRollbackParams defines parameters for PostVolumeSnapshotRollback.
+type PostVolumeSnapshotRollbackParams struct {
+	// Name A volume snapshot name.
+	Name InQuerySnapshotName `form:"name" json:"name"`
+}
+
 // GetPoolsParams defines parameters for GetPools.
 type GetPoolsParams struct {
 	// Name the name of a backend storage pool
@@ -1381,6 +1886,9 @@ type GetPoolVolumesParams struct {
 	Name *string `form:"name,omitempty" json:"name,omitempty"`
 }
 
+// GetSchemaParamsName defines parameters for GetSchema.
+type GetSchemaParamsName string
+
 // GetRelayMessageParams defines parameters for GetRelayMessage.
 type GetRelayMessageParams struct {
 	// Nodename the nodename component of the slot id on the relay
@@ -1414,6 +1922,9 @@ type PostInstanceProgressJSONRequestBody = PostInstanceProgress
 // PostInstanceStatusJSONRequestBody defines body for PostInstanceStatus for application/json ContentType.
 type PostInstanceStatusJSONRequestBody = InstanceStatus
 
+// PostNodeChecksJSONRequestBody defines body for PostNodeChecks for application/json ContentType.
+type PostNodeChecksJSONRequestBody = PostNodeChecks
+
 // PostNodeDRBDConfigJSONRequestBody defines body for PostNodeDRBDConfig for application/json ContentType.
 type PostNodeDRBDConfigJSONRequestBody = PostNodeDRBDConfigRequest
 
@@ -0,0 +1,18 @@
+package api
+
+import "github.com/opensvc/om3/util/asset"
+
+// CSVHeader returns the csv column names of the asset list, the node
+// name followed by the asset scalar value names.
+func (t AssetList) CSVHeader() []string {
+	return append([]string{"node"}, asset.Data{}.CSVHeader()...)
+}
+
+// CSVRecords returns one csv record per node.
+func (t AssetList) CSVRecords() [][]string {
+	l := make([][]string, len(t.Items))
+	for i, item := range t.Items {
+		l[i] = append([]string{item.Meta.Node}, item.Data.CSVRecord()...)
+	}
+	return l
+}
@@ -1,5 +1,17 @@
 package api
 
+func (t AssetList) GetItems() any {
+	return t.Items
+}
+
+func (t AssetItem) Unstructured() map[string]any {
+	return map[string]any{
+		"kind": t.Kind,
+		"meta": t.Meta.Unstructured(),
+		"data": t.Data.Unstructured(),
+	}
+}
+
 func (t CapabilityList) GetItems() any {
 	return t.Items
 }
@@ -151,6 +163,18 @@ func (t ObjectItem) Unstructured() map[string]any {
 	}
 }
 
+func (t ObjectConfigRevisionList) GetItems() any {
+	return t.Items
+}
+
+func (t ObjectConfigRevisionItem) Unstructured() map[string]any {
+	return map[string]any{
+		"kind": t.Kind,
+		"meta": t.Meta.Unstructured(),
+		"data": t.Data.Unstructured(),
+	}
+}
+
 func (t ObjectMeta) Unstructured() map[string]any {
 	return map[string]any{
 		"object": t.Object,
@@ -274,3 +298,92 @@ func (t PoolVolume) Unstructured() map[string]any {
 		"size":      t.Size,
 	}
 }
+
+func (t AuthTokenList) GetItems() any {
+	return t.Items
+}
+
+func (t AuthTokenItem) Unstructured() map[string]any {
+	m := map[string]any{
+		"id":         t.Id,
+		"type":       t.Type,
+		"owner":      t.Owner,
+		"grants":     t.Grants,
+		"issued_at":  t.IssuedAt,
+		"issued_by":  t.IssuedBy,
+		"expired_at": t.ExpiredAt,
+	}
+	if t.RevokedAt != nil {
+		m["revoked_at"] = *t.RevokedAt
+	}
+	if t.Parent != nil {
+		m["parent"] = *t.Parent
+	}
+	return m
+}
+
+func (t SysreportChangeList) GetItems() any {
+	return t.Items
+}
+
+func (t SysreportChangeItem) Unstructured() map[string]any {
+	return map[string]any{
+		"kind": t.Kind,
+		"meta": t.Meta.Unstructured(),
+		"data": t.Data.Unstructured(),
+	}
+}
+
+func (t SysreportChange) Unstructured() map[string]any {
+	return map[string]any{
+		"action": t.Action,
+		"diff":   t.Diff,
+		"from":   t.From,
+		"name":   t.Name,
+		"to":     t.To,
+		"type":   t.Type,
+	}
+}
+
+func (t SysreportSnapshotList) GetItems() any {
+	return t.Items
+}
+
+func (t SysreportSnapshotItem) Unstructured() map[string]any {
+	return map[string]any{
+		"kind": t.Kind,
+		"meta": t.Meta.Unstructured(),
+		"data": t.Data.Unstructured(),
+	}
+}
+
+func (t SysreportSnapshot) Unstructured() map[string]any {
+	return map[string]any{
+		"id":         t.ID,
+		"commands":   t.Commands,
+		"created_at": t.CreatedAt,
+		"csum":       t.Csum,
+		"files":      t.Files,
+	}
+}
+
+func (t VolumeSnapshotList) GetItems() any {
+	return t.Items
+}
+
+func (t VolumeSnapshotItem) Unstructured() map[string]any {
+	return map[string]any{
+		"kind": t.Kind,
+		"meta": t.Meta.Unstructured(),
+		"data": t.Data.Unstructured(),
+	}
+}
+
+func (t VolumeSnapshot) Unstructured() map[string]any {
+	return map[string]any{
+		"name":       t.Name,
+		"volume":     t.Volume,
+		"created_at": t.CreatedAt,
+		"used":       t.Used,
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/opensvc/om3/core/cluster"
 	"github.com/opensvc/om3/core/collector"
 	"github.com/opensvc/om3/core/object"
 	"github.com/opensvc/om3/core/rawconfig"
@@ -29,7 +30,22 @@ type (
 		created    map[string]time.Time
 		sendTicker *time.Ticker
 		lastSend   time.Time
-		toSend     [][]string
+
+		// spool is the disk-backed queue of the feed calls not yet
+		// delivered to the collector.
+		spool *collector.Spool
+
+		// replayBackoff is the delay before the next replay attempt,
+		// doubled on each failed attempt.
+		replayBackoff time.Duration
+		replayNextAt  time.Time
+
+		// replaying is true while a spool replay runs. The replay runs out
+		// of the loop and reports its result on replayDoneC.
+		replaying   bool
+		replayDoneC chan replayResult
+
+		status cluster.DaemonCollector
 	}
 
 	// End action:
@@ -50,6 +66,13 @@ type (
 	//   "message":"done",
 	// }
 	logEntry map[string]string
+
+	// replayResult is the result of a spool replay.
+	replayResult struct {
+		depth int
+		count int
+		err   error
+	}
 )
 
 var (
@@ -66,12 +89,22 @@ var (
 	WatchDir              = filepath.Join(rawconfig.Paths.Log, "actions")
 	SubscriptionQueueSize = 1000
 	FeedPingerInterval    = time.Second * 5
+	SpoolReplayInterval   = time.Second * 5
+	SpoolReplayMinBackoff = time.Second * 10
+	SpoolReplayMaxBackoff = time.Minute * 10
 )
 
 func New(opts ...funcopt.O) *T {
 	t := &T{
-		log:       plog.NewDefaultLogger().WithPrefix("daemon: collector: ").Attr("pkg", "daemon/collector"),
-		localhost: hostname.Hostname(),
+		log:         plog.NewDefaultLogger().WithPrefix("daemon: collector: ").Attr("pkg", "daemon/collector"),
+		localhost:   hostname.Hostname(),
+		replayDoneC: make(chan replayResult),
+		status: cluster.DaemonCollector{
+			DaemonSubsystemStatus: cluster.DaemonSubsystemStatus{
+				ID:        "collector",
+				CreatedAt: time.Now(),
+			},
+		},
 	}
 	if err := funcopt.Apply(t, opts...); err != nil {
 		t.log.Errorf("init: %s", err)
@@ -81,13 +114,18 @@ func New(opts ...funcopt.O) *T {
 }
 
 func (t *T) setNodeFeedClient() error {
+	t.status.ConfiguredAt = time.Now()
 	if node, err := object.NewNode(); err != nil {
+		t.status.State = "dormant"
 		return err
 	} else if client, err := node.CollectorFeedClient(); err != nil {
+		t.status.State = "dormant"
 		return err
 	} else {
 		t.feedClient = client
 		t.feedClient.SetLogger(t.log)
+		t.spool = node.CollectorSpool()
+		t.status.State = "running"
 		return nil
 	}
 }
@@ -141,8 +179,17 @@ func (t *T) loop() {
 		}
 	}()
 
+	replayTicker := time.NewTicker(SpoolReplayInterval)
+	defer replayTicker.Stop()
+
+	t.publishStatus(true)
+
 	for {
 		select {
+		case <-replayTicker.C:
+			t.onReplayTick()
+		case r := <-t.replayDoneC:
+			t.onReplayDone(r)
 		case ev := <-sub.C:
 			switch c := ev.(type) {
 			case *msgbus.NodeConfigUpdated:
@@ -182,6 +229,74 @@ func (t *T) onConfigUpdated() {
 		time.Sleep(time.Microsecond * 10)
 		t.feedPinger.Start(t.ctx, FeedPingerInterval)
 	}
+	t.replayBackoff = 0
+	t.replayNextAt = time.Time{}
+	t.publishStatus(true)
+}
+
+// onReplayTick starts a replay of the spool queued feed calls when the
+// pinger has seen the collector alive and no replay is running. The replay
+// runs out of the loop, as it can last long with a large spool and a slow
+// collector.
+func (t *T) onReplayTick() {
+	defer t.publishStatus(false)
+	if t.feedClient == nil || t.spool == nil || t.replaying {
+		return
+	}
+	if !collector.Alive.Load() || time.Now().Before(t.replayNextAt) {
+		return
+	}
+	depth := t.spool.Len()
+	if depth == 0 {
+		return
+	}
+	t.log.Infof("replay %d queued feed calls", depth)
+	t.replaying = true
+	client, spool := t.feedClient, t.spool
+	go func() {
+		n, err := client.Replay(spool)
+		select {
+		case t.replayDoneC <- replayResult{depth: depth, count: n, err: err}:
+		case <-t.ctx.Done():
+		}
+	}()
+}
+
+// onReplayDone handles the result of a spool replay. The failed replays are
+// retried with an exponential backoff.
+func (t *T) onReplayDone(r replayResult) {
+	defer t.publishStatus(false)
+	t.replaying = false
+	if r.err != nil {
+		t.replayBackoff *= 2
+		if t.replayBackoff < SpoolReplayMinBackoff {
+			t.replayBackoff = SpoolReplayMinBackoff
+		} else if t.replayBackoff > SpoolReplayMaxBackoff {
+			t.replayBackoff = SpoolReplayMaxBackoff
+		}
+		t.replayNextAt = time.Now().Add(t.replayBackoff)
+		t.log.Warnf("replay interrupted after %d/%d queued feed calls, retry in %s: %s", r.count, r.depth, t.replayBackoff, r.err)
+		return
+	}
+	t.replayBackoff = 0
+	t.replayNextAt = time.Time{}
+	t.log.Infof("replayed %d queued feed calls", r.count)
+}
+
+// publishStatus publishes the collector routine status if the spool depth
+// changed or if force is true.
+func (t *T) publishStatus(force bool) {
+	var depth int
+	if t.spool != nil {
+		depth = t.spool.Len()
+	}
+	if !force && depth == t.status.SpoolDepth {
+		return
+	}
+	t.status.SpoolDepth = depth
+	t.bus.Pub(&msgbus.DaemonCollectorUpdated{Node: t.localhost, Value: t.status},
+		pubsub.Label{"node", t.localhost},
+	)
 }
 
 func (t *T) sendBeginAction(data []string) {
@@ -0,0 +1,50 @@
+package daemonapi
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/shaj13/go-guardian/v2/auth"
+
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/daemon/rbac"
+)
+
+// DeleteAuthToken revokes the token id, and the tokens issued with it if it
+// is a refresh token. The users without the root grant can only revoke their
+// tokens.
+func (a *DaemonAPI) DeleteAuthToken(ctx echo.Context, id string) error {
+	log := LogHandler(ctx, "DeleteAuthToken")
+	records, err := a.TokenDB.Tokens()
+	if err != nil {
+		log.Errorf("list tokens: %s", err)
+		return JSONProblemf(ctx, http.StatusInternalServerError, "Unexpected error", "%s", err)
+	}
+	username := ctx.Get("user").(auth.Info).GetUserName()
+	isRoot := grantsFromContext(ctx).HasRole(rbac.RoleRoot)
+	found := false
+	for _, r := range records {
+		if r.ID != id {
+			continue
+		}
+		if !isRoot && r.Owner != username {
+			return JSONProblemf(ctx, http.StatusForbidden, "Forbidden", "token %s is not owned by %s", id, username)
+		}
+		found = true
+		break
+	}
+	if !found {
+		return JSONProblemf(ctx, http.StatusNotFound, "Not found", "token %s", id)
+	}
+	revoked, err := a.TokenDB.RevokeToken(id)
+	if err != nil {
+		log.Errorf("revoke token %s: %s", id, err)
+		return JSONProblemf(ctx, http.StatusInternalServerError, "Unexpected error", "%s", err)
+	}
+	items := make(api.AuthTokenItems, 0, len(revoked))
+	for _, r := range revoked {
+		log.Infof("token %s of %s revoked", r.ID, r.Owner)
+		items = append(items, authTokenItem(r))
+	}
+	return ctx.JSON(http.StatusOK, api.AuthTokenList{Kind: "AuthTokenList", Items: items})
+}
@@ -0,0 +1,56 @@
+package daemonapi
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/shaj13/go-guardian/v2/auth"
+
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/daemon/rbac"
+)
+
+// GetAuthTokens lists the tokens issued by the cluster nodes and not expired.
+// The users without the root grant only see their tokens.
+func (a *DaemonAPI) GetAuthTokens(ctx echo.Context) error {
+	log := LogHandler(ctx, "GetAuthTokens")
+	records, err := a.TokenDB.Tokens()
+	if err != nil {
+		log.Errorf("list tokens: %s", err)
+		return JSONProblemf(ctx, http.StatusInternalServerError, "Unexpected error", "%s", err)
+	}
+	username := ctx.Get("user").(auth.Info).GetUserName()
+	isRoot := grantsFromContext(ctx).HasRole(rbac.RoleRoot)
+	now := time.Now()
+	items := make(api.AuthTokenItems, 0)
+	for _, r := range records {
+		if r.ExpiredAt.Before(now) {
+			continue
+		}
+		if !isRoot && r.Owner != username {
+			continue
+		}
+		items = append(items, authTokenItem(r))
+	}
+	return ctx.JSON(http.StatusOK, api.AuthTokenList{Kind: "AuthTokenList", Items: items})
+}
+
+func authTokenItem(r object.TokenRecord) api.AuthTokenItem {
+	item := api.AuthTokenItem{
+		Id:        r.ID,
+		Type:      api.AuthTokenItemType(r.Type),
+		Owner:     r.Owner,
+		Grants:    append([]string{}, r.Grants...),
+		IssuedAt:  r.IssuedAt,
+		IssuedBy:  r.IssuedBy,
+		ExpiredAt: r.ExpiredAt,
+		RevokedAt: r.RevokedAt,
+	}
+	if r.Parent != "" {
+		parent := r.Parent
+		item.Parent = &parent
+	}
+	return item
+}
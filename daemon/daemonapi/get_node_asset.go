@@ -0,0 +1,117 @@
+package daemonapi
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/core/clusternode"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/daemon/rbac"
+)
+
+// GetNodesAsset returns the asset inventory of the selected cluster
+// nodes. The peer inventories are fetched through the peer api, and the
+// peers failing to respond are logged and skipped.
+func (a *DaemonAPI) GetNodesAsset(ctx echo.Context, params api.GetNodesAssetParams) error {
+	if v, err := assertGrant(ctx, rbac.GrantRoot); !v {
+		return err
+	}
+	meta := Meta{
+		Context: ctx,
+		Node:    params.Node,
+	}
+	name := "GetNodesAsset"
+	log := LogHandler(ctx, name)
+	if err := meta.Expand(); err != nil {
+		log.Errorf("%s: %s", name, err)
+		return JSONProblem(ctx, http.StatusInternalServerError, "Server error", "expand selection")
+	}
+	l := make(api.AssetItems, 0)
+	for _, nodename := range clusternode.Get() {
+		if !meta.HasNode(nodename) {
+			continue
+		}
+		if nodename == a.localhost {
+			item, err := a.localAssetItem()
+			if err != nil {
+				log.Warnf("%s: %s", nodename, err)
+				continue
+			}
+			l = append(l, item)
+			continue
+		}
+		c, err := newProxyClient(ctx, nodename)
+		if err != nil {
+			log.Warnf("%s: new client: %s", nodename, err)
+			continue
+		}
+		resp, err := c.GetNodeAssetWithResponse(ctx.Request().Context(), nodename)
+		if err != nil {
+			log.Warnf("%s: request peer: %s", nodename, err)
+			continue
+		} else if resp.JSON200 == nil {
+			log.Warnf("%s: request peer: unexpected response: %s", nodename, resp.Status())
+			continue
+		}
+		l = append(l, resp.JSON200.Items...)
+	}
+	sort.Slice(l, func(i, j int) bool {
+		return l[i].Meta.Node < l[j].Meta.Node
+	})
+	return ctx.JSON(http.StatusOK, api.AssetList{Kind: "AssetList", Items: l})
+}
+
+func (a *DaemonAPI) GetNodeAsset(ctx echo.Context, nodename string) error {
+	if v, err := assertGrant(ctx, rbac.GrantRoot); !v {
+		return err
+	}
+	if a.localhost == nodename {
+		return a.getLocalAsset(ctx)
+	} else if !clusternode.Has(nodename) {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "%s is not a cluster node", nodename)
+	} else {
+		return a.getPeerAsset(ctx, nodename)
+	}
+}
+
+func (a *DaemonAPI) getPeerAsset(ctx echo.Context, nodename string) error {
+	c, err := newProxyClient(ctx, nodename)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusInternalServerError, "New client", "%s: %s", nodename, err)
+	}
+	if resp, err := c.GetNodeAssetWithResponse(ctx.Request().Context(), nodename); err != nil {
+		return JSONProblemf(ctx, http.StatusInternalServerError, "Request peer", "%s: %s", nodename, err)
+	} else if len(resp.Body) > 0 {
+		return ctx.JSONBlob(resp.StatusCode(), resp.Body)
+	}
+	return nil
+}
+
+func (a *DaemonAPI) getLocalAsset(ctx echo.Context) error {
+	item, err := a.localAssetItem()
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusInternalServerError, "Asset", "%s", err)
+	}
+	return ctx.JSON(http.StatusOK, api.AssetList{Kind: "AssetList", Items: api.AssetItems{item}})
+}
+
+func (a *DaemonAPI) localAssetItem() (api.AssetItem, error) {
+	n, err := object.NewNode()
+	if err != nil {
+		return api.AssetItem{}, err
+	}
+	data, err := n.Asset()
+	if err != nil {
+		return api.AssetItem{}, err
+	}
+	return api.AssetItem{
+		Kind: "AssetItem",
+		Meta: api.NodeMeta{
+			Node: a.localhost,
+		},
+		Data: data,
+	}, nil
+}
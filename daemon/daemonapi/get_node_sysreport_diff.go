@@ -0,0 +1,88 @@
+package daemonapi
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/core/clusternode"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/daemon/rbac"
+	"github.com/opensvc/om3/util/sysreport"
+)
+
+func (a *DaemonAPI) GetNodeSysreportDiff(ctx echo.Context, nodename string, params api.GetNodeSysreportDiffParams) error {
+	if a.localhost == nodename {
+		return a.getLocalSysreportDiff(ctx, params)
+	} else if !clusternode.Has(nodename) {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "%s is not a cluster node", nodename)
+	} else {
+		return a.getPeerSysreportDiff(ctx, nodename, params)
+	}
+}
+
+func (a *DaemonAPI) getPeerSysreportDiff(ctx echo.Context, nodename string, params api.GetNodeSysreportDiffParams) error {
+	c, err := newProxyClient(ctx, nodename)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusInternalServerError, "New client", "%s: %s", nodename, err)
+	}
+	if resp, err := c.GetNodeSysreportDiffWithResponse(ctx.Request().Context(), nodename, &params); err != nil {
+		return JSONProblemf(ctx, http.StatusInternalServerError, "Request peer", "%s: %s", nodename, err)
+	} else if len(resp.Body) > 0 {
+		return ctx.JSONBlob(resp.StatusCode(), resp.Body)
+	}
+	return nil
+}
+
+func (a *DaemonAPI) getLocalSysreportDiff(ctx echo.Context, params api.GetNodeSysreportDiffParams) error {
+	if v, err := assertGrant(ctx, rbac.GrantRoot); !v {
+		return err
+	}
+	var (
+		rev   string
+		since time.Time
+	)
+	if params.Rev != nil {
+		rev = *params.Rev
+	}
+	if params.Since != nil {
+		since = *params.Since
+	}
+	store := sysreport.NewSnapshotStore(sysreport.SnapshotDir())
+	snapA, snapB, err := store.Range(rev, since)
+	switch {
+	case errors.Is(err, sysreport.ErrSnapshotNotFound):
+		return JSONProblemf(ctx, http.StatusNotFound, "Not found", "%s", err)
+	case errors.Is(err, sysreport.ErrSnapshotAmbiguous):
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "%s", err)
+	case err != nil:
+		return JSONProblemf(ctx, http.StatusInternalServerError, "Select sysreport snapshots", "%s", err)
+	}
+	changes, err := store.Diff(snapA, snapB)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusInternalServerError, "Diff sysreport snapshots", "%s", err)
+	}
+	resp := api.SysreportChangeList{
+		Kind:  "SysreportChangeList",
+		Items: make(api.SysreportChangeItems, 0, len(changes)),
+	}
+	for _, change := range changes {
+		resp.Items = append(resp.Items, api.SysreportChangeItem{
+			Kind: "SysreportChangeItem",
+			Meta: api.NodeMeta{
+				Node: a.localhost,
+			},
+			Data: api.SysreportChange{
+				Action: change.Action,
+				Diff:   change.Diff,
+				From:   change.From,
+				Name:   change.Name,
+				To:     change.To,
+				Type:   change.Type,
+			},
+		})
+	}
+	return ctx.JSON(http.StatusOK, resp)
+}
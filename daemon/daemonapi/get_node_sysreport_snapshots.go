@@ -0,0 +1,65 @@
+package daemonapi
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/core/clusternode"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/daemon/rbac"
+	"github.com/opensvc/om3/util/sysreport"
+)
+
+func (a *DaemonAPI) GetNodeSysreportSnapshots(ctx echo.Context, nodename string) error {
+	if a.localhost == nodename {
+		return a.getLocalSysreportSnapshots(ctx)
+	} else if !clusternode.Has(nodename) {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "%s is not a cluster node", nodename)
+	} else {
+		return a.getPeerSysreportSnapshots(ctx, nodename)
+	}
+}
+
+func (a *DaemonAPI) getPeerSysreportSnapshots(ctx echo.Context, nodename string) error {
+	c, err := newProxyClient(ctx, nodename)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusInternalServerError, "New client", "%s: %s", nodename, err)
+	}
+	if resp, err := c.GetNodeSysreportSnapshotsWithResponse(ctx.Request().Context(), nodename); err != nil {
+		return JSONProblemf(ctx, http.StatusInternalServerError, "Request peer", "%s: %s", nodename, err)
+	} else if len(resp.Body) > 0 {
+		return ctx.JSONBlob(resp.StatusCode(), resp.Body)
+	}
+	return nil
+}
+
+func (a *DaemonAPI) getLocalSysreportSnapshots(ctx echo.Context) error {
+	if v, err := assertGrant(ctx, rbac.GrantRoot); !v {
+		return err
+	}
+	l, err := sysreport.NewSnapshotStore(sysreport.SnapshotDir()).List()
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusInternalServerError, "List sysreport snapshots", "%s", err)
+	}
+	resp := api.SysreportSnapshotList{
+		Kind:  "SysreportSnapshotList",
+		Items: make(api.SysreportSnapshotItems, 0, len(l)),
+	}
+	for _, snap := range l {
+		resp.Items = append(resp.Items, api.SysreportSnapshotItem{
+			Kind: "SysreportSnapshotItem",
+			Meta: api.NodeMeta{
+				Node: a.localhost,
+			},
+			Data: api.SysreportSnapshot{
+				ID:        snap.ID,
+				Commands:  len(snap.Commands),
+				CreatedAt: snap.CreatedAt,
+				Csum:      snap.Checksum,
+				Files:     len(snap.Files),
+			},
+		})
+	}
+	return ctx.JSON(http.StatusOK, resp)
+}
@@ -61,6 +61,8 @@ func (a *DaemonAPI) getObjects(ctx echo.Context, pathSelector *string) (api.Obje
 			},
 			Data: api.ObjectData{
 				Avail:            api.Status(ostat.Avail.String()),
+				CertExpireDays:   ostat.CertExpireDays,
+				CertNotAfter:     ostat.CertNotAfter,
 				FlexMax:          ostat.FlexMax,
 				FlexMin:          ostat.FlexMin,
 				FlexTarget:       ostat.FlexTarget,
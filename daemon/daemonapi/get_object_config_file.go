@@ -6,6 +6,7 @@ import (
 
 	"github.com/labstack/echo/v4"
 
+	"github.com/opensvc/om3/core/confighistory"
 	"github.com/opensvc/om3/core/naming"
 	"github.com/opensvc/om3/daemon/api"
 	"github.com/opensvc/om3/util/file"
@@ -42,6 +43,9 @@ func (a *DaemonAPI) GetObjectConfigFile(ctx echo.Context, namespace string, kind
 		log.Infof("%s: file has changed %s", logName, filename)
 		return JSONProblemf(ctx, http.StatusTooEarly, "Too early", "file has changed %s", filename)
 	}
+	if rev, err := confighistory.New(objPath).Get(confighistory.RevisionID(resp.Data, resp.Mtime)); err == nil && rev.Author != "" {
+		resp.Author = &rev.Author
+	}
 
 	return ctx.JSON(http.StatusOK, resp)
 }
@@ -0,0 +1,59 @@
+package daemonapi
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/core/confighistory"
+	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/daemon/api"
+)
+
+// GetObjectConfigHistory returns the object configuration revisions recorded
+// by the local instance, or by a peer instance if the object has no local
+// instance.
+func (a *DaemonAPI) GetObjectConfigHistory(ctx echo.Context, namespace string, kind naming.Kind, name string) error {
+	p, err := naming.NewPath(namespace, kind, name)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "%s", err)
+	}
+	if !confighistory.IsSupported(p.Kind) {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "%s objects have no configuration history", p.Kind)
+	}
+	instanceConfigData := instance.ConfigData.GetByPath(p)
+	if _, ok := instanceConfigData[a.localhost]; ok {
+		l, err := confighistory.New(p).List()
+		if err != nil {
+			return JSONProblemf(ctx, http.StatusInternalServerError, "List config revisions", "%s", err)
+		}
+		resp := api.ObjectConfigRevisionList{
+			Kind:  "ObjectConfigRevisionList",
+			Items: make(api.ObjectConfigRevisionItems, 0),
+		}
+		for _, rev := range l {
+			resp.Items = append(resp.Items, api.ObjectConfigRevisionItem{
+				Kind: "ObjectConfigRevisionItem",
+				Meta: api.InstanceMeta{
+					Node:   a.localhost,
+					Object: p.String(),
+				},
+				Data: rev,
+			})
+		}
+		return ctx.JSON(http.StatusOK, resp)
+	}
+	for nodename := range instanceConfigData {
+		c, err := newProxyClient(ctx, nodename)
+		if err != nil {
+			return JSONProblemf(ctx, http.StatusInternalServerError, "New client", "%s: %s", nodename, err)
+		}
+		if resp, err := c.GetObjectConfigHistoryWithResponse(ctx.Request().Context(), namespace, kind, name); err != nil {
+			return JSONProblemf(ctx, http.StatusInternalServerError, "Request peer", "%s: %s", nodename, err)
+		} else if len(resp.Body) > 0 {
+			return ctx.JSONBlob(resp.StatusCode(), resp.Body)
+		}
+	}
+	return JSONProblemf(ctx, http.StatusNotFound, "Not found", "%s has no instance", p)
+}
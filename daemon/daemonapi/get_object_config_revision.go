@@ -0,0 +1,58 @@
+package daemonapi
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/core/confighistory"
+	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/daemon/api"
+)
+
+// GetObjectConfigRevision returns the object configuration file of a
+// revision recorded by the local instance, or by a peer instance if the
+// object has no local instance.
+func (a *DaemonAPI) GetObjectConfigRevision(ctx echo.Context, namespace string, kind naming.Kind, name string, rev string) error {
+	p, err := naming.NewPath(namespace, kind, name)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "%s", err)
+	}
+	if !confighistory.IsSupported(p.Kind) {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "%s objects have no configuration history", p.Kind)
+	}
+	instanceConfigData := instance.ConfigData.GetByPath(p)
+	if _, ok := instanceConfigData[a.localhost]; ok {
+		revision, b, err := confighistory.New(p).Data(rev)
+		switch {
+		case errors.Is(err, confighistory.ErrNotFound):
+			return JSONProblemf(ctx, http.StatusNotFound, "Not found", "%s", err)
+		case errors.Is(err, confighistory.ErrAmbiguous):
+			return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "%s", err)
+		case err != nil:
+			return JSONProblemf(ctx, http.StatusInternalServerError, "Get config revision", "%s", err)
+		}
+		resp := api.ObjectConfigFile{
+			Data:  b,
+			Mtime: revision.CreatedAt,
+		}
+		if revision.Author != "" {
+			resp.Author = &revision.Author
+		}
+		return ctx.JSON(http.StatusOK, resp)
+	}
+	for nodename := range instanceConfigData {
+		c, err := newProxyClient(ctx, nodename)
+		if err != nil {
+			return JSONProblemf(ctx, http.StatusInternalServerError, "New client", "%s: %s", nodename, err)
+		}
+		if resp, err := c.GetObjectConfigRevisionWithResponse(ctx.Request().Context(), namespace, kind, name, rev); err != nil {
+			return JSONProblemf(ctx, http.StatusInternalServerError, "Request peer", "%s: %s", nodename, err)
+		} else if len(resp.Body) > 0 {
+			return ctx.JSONBlob(resp.StatusCode(), resp.Body)
+		}
+	}
+	return JSONProblemf(ctx, http.StatusNotFound, "Not found", "%s has no instance", p)
+}
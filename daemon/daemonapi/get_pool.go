@@ -30,6 +30,18 @@ func (a *DaemonAPI) GetPools(ctx echo.Context, params api.GetPoolsParams) error
 			l := append([]string{}, stat.Errors...)
 			item.Errors = &l
 		}
+		if stat.DataPercent > 0 {
+			v := stat.DataPercent
+			item.DataPercent = &v
+		}
+		if stat.MetadataPercent > 0 {
+			v := stat.MetadataPercent
+			item.MetadataPercent = &v
+		}
+		if stat.Overcommit > 0 {
+			v := stat.Overcommit
+			item.Overcommit = &v
+		}
 		items = append(items, item)
 	}
 	return ctx.JSON(http.StatusOK, api.PoolList{Kind: "PoolList", Items: items})
@@ -0,0 +1,18 @@
+package daemonapi
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/daemon/api"
+)
+
+func (a *DaemonAPI) GetSchema(ctx echo.Context, name api.GetSchemaParamsName) error {
+	schema, err := object.Schema(string(name))
+	if err != nil {
+		return JSONProblem(ctx, http.StatusBadRequest, "Invalid parameters", err.Error())
+	}
+	return ctx.JSON(http.StatusOK, schema)
+}
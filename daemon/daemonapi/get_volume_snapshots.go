@@ -0,0 +1,78 @@
+package daemonapi
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/daemon/api"
+)
+
+// GetVolumeSnapshots returns the volume snapshots, as reported by the pool
+// driver of the instance selected by volumeNode.
+func (a *DaemonAPI) GetVolumeSnapshots(ctx echo.Context, namespace string, kind naming.Kind, name string) error {
+	p, err := volumePath(namespace, kind, name)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "%s", err)
+	}
+	nodename, ok := a.volumeNode(p)
+	if !ok {
+		return JSONProblemf(ctx, http.StatusNotFound, "Not found", "%s has no instance", p)
+	}
+	if nodename == a.localhost {
+		o, err := volumeSnapshotter(p)
+		if err != nil {
+			return JSONProblemf(ctx, http.StatusInternalServerError, "New object", "%s", err)
+		}
+		l, err := o.ListSnapshots()
+		if err != nil {
+			return JSONProblemf(ctx, http.StatusInternalServerError, "List snapshots", "%s", err)
+		}
+		resp := api.VolumeSnapshotList{
+			Kind:  "VolumeSnapshotList",
+			Items: make(api.VolumeSnapshotItems, 0),
+		}
+		for _, snap := range l {
+			resp.Items = append(resp.Items, api.VolumeSnapshotItem{
+				Kind: "VolumeSnapshotItem",
+				Meta: api.InstanceMeta{
+					Node:   a.localhost,
+					Object: p.String(),
+				},
+				Data: api.VolumeSnapshot{
+					Name:      snap.Name,
+					Volume:    snap.Volume,
+					CreatedAt: snap.CreatedAt,
+					Used:      snap.Used,
+				},
+			})
+		}
+		return ctx.JSON(http.StatusOK, resp)
+	}
+	return proxyVolumeRequest(ctx, nodename, func(c *client.T) (*http.Response, error) {
+		return c.GetVolumeSnapshots(ctx.Request().Context(), namespace, kind, name)
+	})
+}
+
+func volumePath(namespace string, kind naming.Kind, name string) (naming.Path, error) {
+	p, err := naming.NewPath(namespace, kind, name)
+	if err != nil {
+		return p, err
+	}
+	if p.Kind != naming.KindVol {
+		return p, fmt.Errorf("%s objects have no snapshots", p.Kind)
+	}
+	return p, nil
+}
+
+func volumeSnapshotter(p naming.Path) (object.VolSnapshotter, error) {
+	o, err := object.NewVol(p)
+	if err != nil {
+		return nil, err
+	}
+	return o, nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/shaj13/go-guardian/v2/auth"
 
+	"github.com/opensvc/om3/core/object"
 	"github.com/opensvc/om3/daemon/api"
 	"github.com/opensvc/om3/daemon/daemonauth"
 	"github.com/opensvc/om3/daemon/daemondata"
@@ -26,6 +27,7 @@ type (
 		Daemondata *daemondata.T
 		EventBus   *pubsub.Bus
 		JWTcreator JWTCreater
+		TokenDB    *object.TokenDB
 
 		LabelNode pubsub.Label
 
@@ -43,6 +45,7 @@ func New(ctx context.Context) *DaemonAPI {
 		Daemondata: daemondata.FromContext(ctx),
 		EventBus:   pubsub.BusFromContext(ctx),
 		JWTcreator: daemonauth.JWTCreatorFromContext(ctx),
+		TokenDB:    &object.TokenDB{},
 		LabelNode:  pubsub.Label{"node", localhost},
 		localhost:  localhost,
 	}
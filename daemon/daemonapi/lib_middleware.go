@@ -12,6 +12,7 @@ import (
 	"github.com/rs/zerolog"
 	"github.com/shaj13/go-guardian/v2/auth"
 
+	"github.com/opensvc/om3/core/object"
 	"github.com/opensvc/om3/daemon/daemonauth"
 	"github.com/opensvc/om3/daemon/daemonctx"
 	"github.com/opensvc/om3/daemon/rbac"
@@ -28,10 +29,11 @@ var (
 	// logRequestLevelPerPath defines logRequestMiddleWare log level per path.
 	// The default value is LevelInfo
 	logRequestLevelPerPath = map[string]zerolog.Level{
-		"/metrics":        zerolog.DebugLevel,
-		"/public/openapi": zerolog.DebugLevel,
-		"/public/ui/*":    zerolog.DebugLevel,
-		"/relay/message":  zerolog.DebugLevel,
+		"/metrics":             zerolog.DebugLevel,
+		"/public/openapi":      zerolog.DebugLevel,
+		"/public/schema/:name": zerolog.DebugLevel,
+		"/public/ui/*":         zerolog.DebugLevel,
+		"/relay/message":       zerolog.DebugLevel,
 	}
 )
 
@@ -112,6 +114,19 @@ func AuthMiddleware(parent context.Context) echo.MiddlewareFunc {
 				code := http.StatusUnauthorized
 				return JSONProblem(c, code, http.StatusText(code), err.Error())
 			}
+			if user.GetExtensions().Has("totp") && !isTOTPTokenRequest(c) {
+				// The password of a user enrolled to the TOTP second
+				// factor is only accepted to create a token.
+				log.Errorf("authenticating request from %s: user %s is enrolled to totp", req.RemoteAddr, user.GetUserName())
+				code := http.StatusUnauthorized
+				return JSONProblem(c, code, http.StatusText(code), "totp enrolled users must authenticate with a token created by POST /auth/token")
+			}
+			if user.GetExtensions().Get("token_type") == object.TokenTypeRefresh && !isRefreshRequest(c) {
+				// The refresh tokens are only accepted to create a token.
+				log.Errorf("authenticating request from %s: user %s used a refresh token", req.RemoteAddr, user.GetUserName())
+				code := http.StatusUnauthorized
+				return JSONProblem(c, code, http.StatusText(code), "refresh tokens are only accepted by POST /auth/refresh")
+			}
 			log.Debugf("user %s authenticated", user.GetUserName())
 			c.Set("user", user)
 			c.Set("grants", rbac.NewGrants(user.GetExtensions()["grant"]...))
@@ -120,6 +135,14 @@ func AuthMiddleware(parent context.Context) echo.MiddlewareFunc {
 	}
 }
 
+func isTOTPTokenRequest(c echo.Context) bool {
+	return c.Request().Method == http.MethodPost && c.Path() == "/auth/token"
+}
+
+func isRefreshRequest(c echo.Context) bool {
+	return c.Request().Method == http.MethodPost && c.Path() == "/auth/refresh"
+}
+
 func LogUserMiddleware(parent context.Context) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
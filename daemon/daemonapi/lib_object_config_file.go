@@ -17,7 +17,7 @@ func (a DaemonAPI) writeObjectConfigFile(ctx echo.Context, p naming.Path) error
 	if err := dec.Decode(&body); err != nil {
 		return JSONProblemf(ctx, http.StatusBadRequest, "Bad request body", fmt.Sprint(err))
 	}
-	o, err := object.New(p, object.WithConfigData(body.Data))
+	o, err := object.New(p, object.WithConfigData(body.Data), object.WithConfigAuthor(userFromContext(ctx).GetUserName()))
 	if err != nil {
 		return JSONProblemf(ctx, http.StatusInternalServerError, "New object", fmt.Sprint(err))
 	}
@@ -0,0 +1,64 @@
+package daemonapi
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/plan"
+	"github.com/opensvc/om3/daemon/api"
+)
+
+// postObjectActionPlan responds with the plan of the orchestration returned
+// by the eval function, without queuing the orchestration.
+func (a *DaemonAPI) postObjectActionPlan(ctx echo.Context, namespace string, kind naming.Kind, name string, eval func(*plan.Snapshot, naming.Path) plan.T) error {
+	p, err := naming.NewPath(namespace, kind, name)
+	if err != nil {
+		return JSONProblem(ctx, http.StatusBadRequest, "Invalid parameters", err.Error())
+	}
+	if instMon := instance.MonitorData.Get(p, a.localhost); instMon == nil {
+		return JSONProblemf(ctx, http.StatusNotFound, "Not found", "Object does not exist: %s", p)
+	}
+	return ctx.JSON(http.StatusOK, newOrchestrationPlanQueued(eval(plan.NewSnapshotFromData(), p)))
+}
+
+// newOrchestrationPlanQueued returns the api response of a dry run
+// orchestration request: a nil orchestration id and the plan.
+func newOrchestrationPlanQueued(t plan.T) api.OrchestrationQueued {
+	apiPlan := api.OrchestrationPlan{
+		Target:  t.Target,
+		Steps:   make([]api.OrchestrationPlanStep, len(t.Steps)),
+		Refused: make([]api.OrchestrationPlanRefusal, len(t.Refused)),
+	}
+	for i, step := range t.Steps {
+		apiStep := api.OrchestrationPlanStep{
+			Action: step.Action.String(),
+			Node:   step.Node,
+			Order:  step.Order,
+			Path:   step.Path,
+		}
+		if step.Reason != "" {
+			reason := step.Reason
+			apiStep.Reason = &reason
+		}
+		apiPlan.Steps[i] = apiStep
+	}
+	for i, refusal := range t.Refused {
+		apiRefusal := api.OrchestrationPlanRefusal{
+			Path:   refusal.Path,
+			Reason: refusal.Reason,
+		}
+		if refusal.Node != "" {
+			nodename := refusal.Node
+			apiRefusal.Node = &nodename
+		}
+		apiPlan.Refused[i] = apiRefusal
+	}
+	return api.OrchestrationQueued{
+		OrchestrationID: uuid.Nil,
+		Plan:            &apiPlan,
+	}
+}
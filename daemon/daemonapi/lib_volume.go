@@ -0,0 +1,74 @@
+package daemonapi
+
+import (
+	"io"
+	"net/http"
+	"sort"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/status"
+)
+
+// volumeNode returns the node where an operation on the <p> volume must
+// run, or false if the volume has no instance.
+//
+// The volume data is only reachable on the nodes where the volume instance
+// is up: the vg of a failover lv, for example, is not activated on the
+// standby nodes. The local node is preferred, then the peers in sorted
+// order. A volume with no up instance is handled by its local instance,
+// or its first peer instance in sorted order, so the operations expecting
+// a stopped volume, like a snapshot rollback, can run.
+func (a *DaemonAPI) volumeNode(p naming.Path) (string, bool) {
+	instanceConfigData := instance.ConfigData.GetByPath(p)
+	if len(instanceConfigData) == 0 {
+		return "", false
+	}
+	nodenames := make([]string, 0, len(instanceConfigData))
+	for nodename := range instanceConfigData {
+		nodenames = append(nodenames, nodename)
+	}
+	sort.Strings(nodenames)
+	_, hasLocal := instanceConfigData[a.localhost]
+	isUp := func(nodename string) bool {
+		instStatus := instance.StatusData.Get(p, nodename)
+		return instStatus != nil && instStatus.Avail == status.Up
+	}
+	if hasLocal && isUp(a.localhost) {
+		return a.localhost, true
+	}
+	for _, nodename := range nodenames {
+		if isUp(nodename) {
+			return nodename, true
+		}
+	}
+	if hasLocal {
+		return a.localhost, true
+	}
+	return nodenames[0], true
+}
+
+// proxyVolumeRequest relays to the client the response of the request
+// sent by <do> to the peer <nodename>.
+func proxyVolumeRequest(ctx echo.Context, nodename string, do func(c *client.T) (*http.Response, error)) error {
+	c, err := newProxyClient(ctx, nodename)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusInternalServerError, "New client", "%s: %s", nodename, err)
+	}
+	resp, err := do(c)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusInternalServerError, "Request peer", "%s: %s", nodename, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusInternalServerError, "Read peer response", "%s: %s", nodename, err)
+	}
+	if len(b) == 0 {
+		return ctx.NoContent(resp.StatusCode)
+	}
+	return ctx.Blob(resp.StatusCode, resp.Header.Get(echo.HeaderContentType), b)
+}
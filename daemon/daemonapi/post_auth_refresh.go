@@ -0,0 +1,48 @@
+package daemonapi
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/shaj13/go-guardian/v2/auth"
+
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/daemon/api"
+)
+
+// PostAuthRefresh create a new token for the owner of the refresh token
+// used to authenticate the request, with the refresh token grants.
+//
+// The new token is recorded with the refresh token id as parent, so it is
+// also revoked when the refresh token is revoked.
+func (a *DaemonAPI) PostAuthRefresh(ctx echo.Context, params api.PostAuthRefreshParams) error {
+	name := "PostAuthRefresh"
+	log := LogHandler(ctx, name)
+	user := ctx.Get("user").(auth.Info)
+	extensions := user.GetExtensions()
+	if extensions.Get("token_type") != object.TokenTypeRefresh {
+		return JSONProblemf(ctx, http.StatusForbidden, "Not a refresh token", "authenticate with a refresh token created by POST /auth/token?refresh=true")
+	}
+	duration, err := tokenDuration(params.Duration, tokenDurationDefault, tokenDurationMax)
+	if err != nil {
+		log.Infof("%s: invalid duration: %s: %s", name, *params.Duration, err)
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "Invalid duration: %s", *params.Duration)
+	}
+	username := user.GetUserName()
+	info := auth.NewUserInfo(username, username, nil, auth.Extensions{"grant": extensions.Values("grant")})
+	tk, tokenID, expireAt, err := a.createToken(info, duration, nil, object.TokenTypeAccess, extensions.Get("token_id"))
+	if err != nil {
+		log.Errorf("%s: can't create token: %s", name, err)
+		return JSONProblemf(ctx, http.StatusInternalServerError, "Unexpected error", "%s", err)
+	} else if tk == "" {
+		err := fmt.Errorf("create token error: jwt auth is not enabled")
+		log.Warnf("%s: %s", name, err)
+		return JSONProblemf(ctx, http.StatusNotImplemented, err.Error(), "")
+	}
+	return ctx.JSON(http.StatusOK, api.AuthToken{
+		ExpiredAt: expireAt,
+		Token:     tk,
+		TokenId:   &tokenID,
+	})
+}
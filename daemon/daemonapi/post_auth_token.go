@@ -1,24 +1,46 @@
 package daemonapi
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/shaj13/go-guardian/v2/auth"
 
+	"github.com/opensvc/om3/core/object"
 	"github.com/opensvc/om3/daemon/api"
 	"github.com/opensvc/om3/daemon/daemonenv"
 	"github.com/opensvc/om3/daemon/rbac"
 	"github.com/opensvc/om3/util/converters"
+	"github.com/opensvc/om3/util/totp"
+)
+
+const (
+	// tokenDurationDefault is the default duration of the access tokens.
+	tokenDurationDefault = 10 * time.Minute
+
+	// tokenDurationMax is the maximum duration of the access tokens.
+	tokenDurationMax = 24 * time.Hour
+
+	// refreshTokenDurationDefault is the default duration of the refresh
+	// tokens.
+	refreshTokenDurationDefault = 30 * 24 * time.Hour
+
+	// refreshTokenDurationMax is the maximum duration of the refresh tokens.
+	refreshTokenDurationMax = 90 * 24 * time.Hour
 )
 
 // PostAuthToken create a new token for a user
 //
 // When role parameter exists a new user is created with grants from role and
 // extra claims may be added to token
+//
+// When refresh parameter is true, a refresh token is also created, to be used
+// by long-running automation to obtain new tokens from PostAuthRefresh.
 func (a *DaemonAPI) PostAuthToken(ctx echo.Context, params api.PostAuthTokenParams) error {
 	if v, err := assertRole(ctx, rbac.RoleRoot); err != nil {
 		return err
@@ -26,29 +48,35 @@ func (a *DaemonAPI) PostAuthToken(ctx echo.Context, params api.PostAuthTokenPara
 		return nil
 	}
 	var (
-		// duration define the default token duration
-		duration = time.Minute * 10
-
-		// duration define the maximum token duration
-		durationMax = time.Hour * 24
-
 		xClaims = make(map[string]interface{})
 	)
 	name := "PostAuthToken"
 	log := LogHandler(ctx, name)
-	if params.Duration != nil {
-		if v, err := converters.Duration.Convert(*params.Duration); err != nil {
-			log.Infof("%s: invalid duration: %s: %s", name, *params.Duration, err)
-			return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "Invalid duration: %s", *params.Duration)
-		} else {
-			duration = *v.(*time.Duration)
-			if duration > durationMax {
-				duration = durationMax
-			}
-		}
+	duration, err := tokenDuration(params.Duration, tokenDurationDefault, tokenDurationMax)
+	if err != nil {
+		log.Infof("%s: invalid duration: %s: %s", name, *params.Duration, err)
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "Invalid duration: %s", *params.Duration)
+	}
+	refreshDuration, err := tokenDuration(params.RefreshDuration, refreshTokenDurationDefault, refreshTokenDurationMax)
+	if err != nil {
+		log.Infof("%s: invalid refresh duration: %s: %s", name, *params.RefreshDuration, err)
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "Invalid refresh duration: %s", *params.RefreshDuration)
 	}
 	user := ctx.Get("user").(auth.Info)
 	username := user.GetUserName()
+	if user.GetExtensions().Has("totp") {
+		if params.Totp == nil || *params.Totp == "" {
+			log.Infof("%s: user %s is enrolled to totp: missing code", name, username)
+			return JSONProblemf(ctx, http.StatusUnauthorized, "TOTP code required", "user %s is enrolled to the totp second factor", username)
+		}
+		if err := (&object.UsrDB{}).ValidateUserTOTP(username, *params.Totp); errors.Is(err, totp.ErrLocked) {
+			log.Warnf("%s: user %s: %s", name, username, err)
+			return JSONProblemf(ctx, http.StatusTooManyRequests, "Too many invalid TOTP codes", "%s", err)
+		} else if err != nil {
+			log.Infof("%s: user %s: %s", name, username, err)
+			return JSONProblemf(ctx, http.StatusUnauthorized, "Invalid TOTP code", "%s", err)
+		}
+	}
 	// TODO verify if user is allowed to create token => 403 Forbidden
 	if params.Role != nil {
 		var err error
@@ -59,7 +87,7 @@ func (a *DaemonAPI) PostAuthToken(ctx echo.Context, params api.PostAuthTokenPara
 		}
 	}
 
-	tk, expireAt, err := a.JWTcreator.CreateUserToken(user, duration, xClaims)
+	tk, tokenID, expireAt, err := a.createToken(user, duration, xClaims, object.TokenTypeAccess, "")
 	if err != nil {
 		log.Errorf("%s: can't create token: %s", name, err)
 		return JSONProblemf(ctx, http.StatusInternalServerError, "Unexpected error", "%s", err)
@@ -68,10 +96,72 @@ func (a *DaemonAPI) PostAuthToken(ctx echo.Context, params api.PostAuthTokenPara
 		log.Warnf("%s: %s", name, err)
 		return JSONProblemf(ctx, http.StatusNotImplemented, err.Error(), "")
 	}
-	return ctx.JSON(http.StatusOK, api.AuthToken{
+	resp := api.AuthToken{
 		ExpiredAt: expireAt,
 		Token:     tk,
+		TokenId:   &tokenID,
+	}
+	if params.Refresh != nil && *params.Refresh {
+		refreshToken, refreshTokenID, refreshExpireAt, err := a.createToken(user, refreshDuration, xClaims, object.TokenTypeRefresh, "")
+		if err != nil {
+			log.Errorf("%s: can't create refresh token: %s", name, err)
+			return JSONProblemf(ctx, http.StatusInternalServerError, "Unexpected error", "%s", err)
+		}
+		resp.RefreshToken = &refreshToken
+		resp.RefreshTokenId = &refreshTokenID
+		resp.RefreshExpiredAt = &refreshExpireAt
+	}
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+// createToken creates a token identified by a new jti claim, and records it
+// in the token db so it can be listed and revoked.
+func (a *DaemonAPI) createToken(user auth.Info, duration time.Duration, xClaims map[string]interface{}, tokenType, parent string) (tk, id string, expireAt time.Time, err error) {
+	id = uuid.New().String()
+	claims := make(map[string]interface{})
+	for c, v := range xClaims {
+		claims[c] = v
+	}
+	claims["jti"] = id
+	if tokenType == object.TokenTypeRefresh {
+		claims["token_type"] = tokenType
+	}
+	tk, expireAt, err = a.JWTcreator.CreateUserToken(user, duration, claims)
+	if err != nil || tk == "" {
+		return
+	}
+	err = a.TokenDB.AddToken(object.TokenRecord{
+		ID:        id,
+		Type:      tokenType,
+		Owner:     user.GetUserName(),
+		Grants:    append([]string{}, user.GetExtensions().Values("grant")...),
+		IssuedAt:  time.Now(),
+		IssuedBy:  a.localhost,
+		ExpiredAt: expireAt,
+		Parent:    parent,
 	})
+	if err != nil {
+		tk = ""
+		err = fmt.Errorf("record token: %w", err)
+	}
+	return
+}
+
+// tokenDuration returns the duration of a new token, converted from s,
+// defaulting to def and limited to max.
+func tokenDuration(s *string, def, max time.Duration) (time.Duration, error) {
+	if s == nil {
+		return def, nil
+	}
+	v, err := converters.Duration.Convert(*s)
+	if err != nil {
+		return 0, err
+	}
+	duration := *v.(*time.Duration)
+	if duration > max {
+		duration = max
+	}
+	return duration, nil
 }
 
 // userXClaims returns new user and Claims from p and current user
@@ -13,6 +13,7 @@ import (
 	"github.com/opensvc/om3/core/instance"
 	"github.com/opensvc/om3/core/naming"
 	"github.com/opensvc/om3/core/node"
+	"github.com/opensvc/om3/core/plan"
 	"github.com/opensvc/om3/daemon/api"
 	"github.com/opensvc/om3/daemon/msgbus"
 	"github.com/opensvc/om3/util/converters"
@@ -47,6 +48,8 @@ func (a *DaemonAPI) PostDaemonShutdown(ctx echo.Context, nodename string, params
 //   - announces node monitor state shutdown
 //   - publishes DaemonCtl stop
 //
+// With the dry_run parameter, it only responds with the shutdown plan.
+//
 // On unexpected errors it reverts pending local expect, and announces node monitor state shutdown failed
 func (a *DaemonAPI) localPostDaemonShutdown(ctx echo.Context, params api.PostDaemonShutdownParams) error {
 	var (
@@ -57,6 +60,9 @@ func (a *DaemonAPI) localPostDaemonShutdown(ctx echo.Context, params api.PostDae
 		shutdownCtx                = context.Background()
 		toWait                     = make(map[naming.Path]instance.MonitorState)
 	)
+	if params.DryRun != nil && *params.DryRun {
+		return ctx.JSON(http.StatusOK, newOrchestrationPlanQueued(plan.NewSnapshotFromData().Shutdown(a.localhost)))
+	}
 	if params.Duration != nil {
 		if v, err := converters.Duration.Convert(*params.Duration); err != nil {
 			log.Infof("Invalid parameter: field 'duration' with value '%s' validation error: %s", *params.Duration, err)
@@ -10,12 +10,16 @@ import (
 
 	"github.com/opensvc/om3/core/clusternode"
 	"github.com/opensvc/om3/core/node"
+	"github.com/opensvc/om3/core/plan"
 	"github.com/opensvc/om3/daemon/api"
 	"github.com/opensvc/om3/daemon/msgbus"
 )
 
-func (a *DaemonAPI) PostPeerActionDrain(ctx echo.Context, nodename string) error {
+func (a *DaemonAPI) PostPeerActionDrain(ctx echo.Context, nodename string, params api.PostPeerActionDrainParams) error {
 	if nodename == a.localhost {
+		if params.DryRun != nil && *params.DryRun {
+			return ctx.JSON(http.StatusOK, newOrchestrationPlanQueued(plan.NewSnapshotFromData().Drain(a.localhost)))
+		}
 		return a.localNodeActionDrain(ctx)
 	} else if !clusternode.Has(nodename) {
 		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "%s is not a cluster node", nodename)
@@ -24,7 +28,7 @@ func (a *DaemonAPI) PostPeerActionDrain(ctx echo.Context, nodename string) error
 		if err != nil {
 			return JSONProblemf(ctx, http.StatusInternalServerError, "New client", "%s: %s", nodename, err)
 		}
-		if resp, err := c.PostPeerActionDrainWithResponse(ctx.Request().Context(), nodename); err != nil {
+		if resp, err := c.PostPeerActionDrainWithResponse(ctx.Request().Context(), nodename, &params); err != nil {
 			return JSONProblemf(ctx, http.StatusInternalServerError, "Request peer", "%s: %s", nodename, err)
 		} else if len(resp.Body) > 0 {
 			return ctx.JSONBlob(resp.StatusCode(), resp.Body)
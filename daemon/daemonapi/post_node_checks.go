@@ -0,0 +1,43 @@
+package daemonapi
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/core/check"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/daemon/msgbus"
+	"github.com/opensvc/om3/daemon/rbac"
+	"github.com/opensvc/om3/util/pubsub"
+)
+
+func (a *DaemonAPI) PostNodeChecks(ctx echo.Context) error {
+	if v, err := assertGrant(ctx, rbac.GrantRoot); !v {
+		return err
+	}
+	var payload api.PostNodeChecks
+	log := LogHandler(ctx, "PostNodeChecks")
+	log.Debugf("starting")
+	if err := ctx.Bind(&payload); err != nil {
+		log.Warnf("decode body: %s", err)
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid body", "%s", err)
+	}
+	results := make([]check.Result, len(payload.Results))
+	for i, e := range payload.Results {
+		results[i] = check.Result{
+			DriverGroup: e.Type,
+			DriverName:  e.Driver,
+			Path:        e.Path,
+			Instance:    e.Instance,
+			Unit:        e.Unit,
+			Value:       e.Value,
+			Status:      e.Status,
+			Hint:        e.Hint,
+		}
+	}
+	a.EventBus.Pub(&msgbus.NodeChecksPost{Node: a.localhost, Degrade: payload.Degrade, Value: results},
+		pubsub.Label{"node", a.localhost},
+	)
+	return ctx.JSON(http.StatusOK, nil)
+}
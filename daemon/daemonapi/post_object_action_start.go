@@ -2,10 +2,16 @@ package daemonapi
 
 import (
 	"github.com/labstack/echo/v4"
+
 	"github.com/opensvc/om3/core/instance"
 	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/plan"
+	"github.com/opensvc/om3/daemon/api"
 )
 
-func (a *DaemonAPI) PostObjectActionStart(ctx echo.Context, namespace string, kind naming.Kind, name string) error {
+func (a *DaemonAPI) PostObjectActionStart(ctx echo.Context, namespace string, kind naming.Kind, name string, params api.PostObjectActionStartParams) error {
+	if params.DryRun != nil && *params.DryRun {
+		return a.postObjectActionPlan(ctx, namespace, kind, name, (*plan.Snapshot).Start)
+	}
 	return a.postObjectAction(ctx, namespace, kind, name, instance.MonitorGlobalExpectStarted)
 }
@@ -2,6 +2,7 @@ package daemonapi
 
 import (
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -9,12 +10,14 @@ import (
 
 	"github.com/opensvc/om3/core/instance"
 	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/nodeselector"
+	"github.com/opensvc/om3/core/plan"
 	"github.com/opensvc/om3/daemon/api"
 	"github.com/opensvc/om3/daemon/msgbus"
 	"github.com/opensvc/om3/util/pubsub"
 )
 
-func (a *DaemonAPI) PostObjectActionSwitch(ctx echo.Context, namespace string, kind naming.Kind, name string) error {
+func (a *DaemonAPI) PostObjectActionSwitch(ctx echo.Context, namespace string, kind naming.Kind, name string, params api.PostObjectActionSwitchParams) error {
 	var (
 		payload = api.PostObjectActionSwitch{}
 		value   = instance.MonitorUpdate{}
@@ -24,6 +27,17 @@ func (a *DaemonAPI) PostObjectActionSwitch(ctx echo.Context, namespace string, k
 	if err := ctx.Bind(&payload); err != nil {
 		return JSONProblem(ctx, http.StatusBadRequest, "Invalid Body", err.Error())
 	}
+	if params.DryRun != nil && *params.DryRun {
+		var destination []string
+		if len(payload.Destination) > 0 {
+			if destination, err = nodeselector.Expand(strings.Join(payload.Destination, " ")); err != nil {
+				return JSONProblemf(ctx, http.StatusBadRequest, "Invalid body", "destination: %s", err)
+			}
+		}
+		return a.postObjectActionPlan(ctx, namespace, kind, name, func(s *plan.Snapshot, p naming.Path) plan.T {
+			return s.Switch(p, destination)
+		})
+	}
 	p, err = naming.NewPath(namespace, kind, name)
 	if err != nil {
 		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "%s", err)
@@ -0,0 +1,75 @@
+package daemonapi
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/core/confighistory"
+	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/daemon/rbac"
+)
+
+// PostObjectConfigRollback commits the object configuration file of a
+// revision as a new revision, authored by the api user. The daemons
+// replicate the new revision to the peer instances.
+func (a *DaemonAPI) PostObjectConfigRollback(ctx echo.Context, namespace string, kind naming.Kind, name string, params api.PostObjectConfigRollbackParams) error {
+	log := LogHandler(ctx, "PostObjectConfigRollback")
+
+	if v, err := assertGrant(ctx, rbac.NewGrant(rbac.RoleAdmin, namespace), rbac.GrantRoot); !v {
+		return err
+	}
+	p, err := naming.NewPath(namespace, kind, name)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "%s", err)
+	}
+	if !confighistory.IsSupported(p.Kind) {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "%s objects have no configuration history", p.Kind)
+	}
+	log = naming.LogWithPath(log, p)
+
+	instanceConfigData := instance.ConfigData.GetByPath(p)
+	if _, ok := instanceConfigData[a.localhost]; ok {
+		revision, b, err := confighistory.New(p).Data(params.Rev)
+		switch {
+		case errors.Is(err, confighistory.ErrNotFound):
+			return JSONProblemf(ctx, http.StatusNotFound, "Not found", "%s", err)
+		case errors.Is(err, confighistory.ErrAmbiguous):
+			return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "%s", err)
+		case err != nil:
+			return JSONProblemf(ctx, http.StatusInternalServerError, "Get config revision", "%s", err)
+		}
+		o, err := object.New(p, object.WithConfigData(b), object.WithConfigAuthor(userFromContext(ctx).GetUserName()))
+		if err != nil {
+			return JSONProblemf(ctx, http.StatusInternalServerError, "New object", "%s", err)
+		}
+		configurer := o.(object.Configurer)
+		if report, err := configurer.ValidateConfig(ctx.Request().Context()); err != nil {
+			return JSONProblemf(ctx, http.StatusBadRequest, "Invalid configuration", "%s", report)
+		}
+		if err := configurer.Config().RecommitInvalid(); err != nil {
+			return JSONProblemf(ctx, http.StatusInternalServerError, "Commit", "%s", err)
+		}
+		log.Infof("config rolled back to revision %s", revision.ID)
+		return ctx.NoContent(http.StatusNoContent)
+	}
+
+	for nodename := range instanceConfigData {
+		c, err := newProxyClient(ctx, nodename)
+		if err != nil {
+			return JSONProblemf(ctx, http.StatusInternalServerError, "New client", "%s: %s", nodename, err)
+		}
+		if resp, err := c.PostObjectConfigRollbackWithResponse(ctx.Request().Context(), namespace, kind, name, &params); err != nil {
+			return JSONProblemf(ctx, http.StatusInternalServerError, "Request peer", "%s: %s", nodename, err)
+		} else if len(resp.Body) > 0 {
+			return ctx.JSONBlob(resp.StatusCode(), resp.Body)
+		} else {
+			return ctx.NoContent(resp.StatusCode())
+		}
+	}
+	return JSONProblemf(ctx, http.StatusNotFound, "Not found", "%s has no instance", p)
+}
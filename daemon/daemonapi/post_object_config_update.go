@@ -56,7 +56,7 @@ func (a *DaemonAPI) PostObjectConfigUpdate(ctx echo.Context, namespace string, k
 	instanceConfigData := instance.ConfigData.GetByPath(p)
 
 	if _, ok := instanceConfigData[a.localhost]; ok {
-		oc, err := object.NewConfigurer(p)
+		oc, err := object.NewConfigurer(p, object.WithConfigAuthor(userFromContext(ctx).GetUserName()))
 		if err != nil {
 			return JSONProblemf(ctx, http.StatusInternalServerError, "NewConfigurer", "%s", err)
 		}
@@ -0,0 +1,65 @@
+package daemonapi
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/daemon/rbac"
+)
+
+// PostVolumeClone creates the target vol object, backed by a new volume of
+// the source volume pool initialized with the source volume data. The clone
+// is done by the source volume instance selected by volumeNode, in the
+// background in the returned session.
+func (a *DaemonAPI) PostVolumeClone(ctx echo.Context, namespace string, kind naming.Kind, name string, params api.PostVolumeCloneParams) error {
+	log := LogHandler(ctx, "PostVolumeClone")
+
+	if v, err := assertGrant(ctx, rbac.NewGrant(rbac.RoleAdmin, namespace), rbac.GrantRoot); !v {
+		return err
+	}
+	p, err := volumePath(namespace, kind, name)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "%s", err)
+	}
+	dst, err := naming.ParsePath(params.Target)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "target: %s", err)
+	}
+	if dst.Kind != naming.KindVol {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "target: %s is not a vol", dst)
+	}
+	if v, err := assertGrant(ctx, rbac.NewGrant(rbac.RoleAdmin, dst.Namespace), rbac.GrantRoot); !v {
+		return err
+	}
+	if len(instance.ConfigData.GetByPath(dst)) > 0 {
+		return JSONProblemf(ctx, http.StatusConflict, "Conflict", "target %s already exists", dst)
+	}
+	log = naming.LogWithPath(log, p)
+
+	nodename, ok := a.volumeNode(p)
+	if !ok {
+		return JSONProblemf(ctx, http.StatusNotFound, "Not found", "%s has no instance", p)
+	}
+	if nodename == a.localhost {
+		var requesterSid uuid.UUID
+		if params.RequesterSid != nil {
+			requesterSid = *params.RequesterSid
+		}
+		args := []string{p.String(), "clone", dst.String(), "--local"}
+		sid, err := a.apiExec(ctx, p, requesterSid, args, log)
+		if err != nil {
+			return JSONProblemf(ctx, http.StatusInternalServerError, "Clone", "%s", err)
+		}
+		return ctx.JSON(http.StatusOK, api.InstanceActionAccepted{SessionID: sid})
+	}
+
+	return proxyVolumeRequest(ctx, nodename, func(c *client.T) (*http.Response, error) {
+		return c.PostVolumeClone(ctx.Request().Context(), namespace, kind, name, &params)
+	})
+}
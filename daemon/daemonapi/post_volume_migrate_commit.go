@@ -0,0 +1,53 @@
+package daemonapi
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/daemon/rbac"
+)
+
+// PostVolumeMigrateCommit copies the last changes to the new volume and
+// switches the vol object to it. The commit is done by the instance
+// selected by volumeNode for the vol object holding the new volume, which
+// is left up by the prepare on the node that copied the data. The commit
+// runs in the background in the returned session.
+func (a *DaemonAPI) PostVolumeMigrateCommit(ctx echo.Context, namespace string, kind naming.Kind, name string, params api.PostVolumeMigrateCommitParams) error {
+	log := LogHandler(ctx, "PostVolumeMigrateCommit")
+
+	if v, err := assertGrant(ctx, rbac.NewGrant(rbac.RoleAdmin, namespace), rbac.GrantRoot); !v {
+		return err
+	}
+	p, err := volumePath(namespace, kind, name)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "%s", err)
+	}
+	log = naming.LogWithPath(log, p)
+
+	nodename, ok := a.volumeNode(object.MigratePath(p))
+	if !ok {
+		return JSONProblemf(ctx, http.StatusNotFound, "Not found", "%s has no migration in progress", p)
+	}
+	if nodename == a.localhost {
+		var requesterSid uuid.UUID
+		if params.RequesterSid != nil {
+			requesterSid = *params.RequesterSid
+		}
+		args := []string{p.String(), "migrate", "--local", "--step", "commit"}
+		sid, err := a.apiExec(ctx, p, requesterSid, args, log)
+		if err != nil {
+			return JSONProblemf(ctx, http.StatusInternalServerError, "Migrate commit", "%s", err)
+		}
+		return ctx.JSON(http.StatusOK, api.InstanceActionAccepted{SessionID: sid})
+	}
+
+	return proxyVolumeRequest(ctx, nodename, func(c *client.T) (*http.Response, error) {
+		return c.PostVolumeMigrateCommit(ctx.Request().Context(), namespace, kind, name, &params)
+	})
+}
@@ -0,0 +1,61 @@
+package daemonapi
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/daemon/rbac"
+)
+
+// PostVolumeMigratePrepare provisions a new volume in the target pool and
+// copies the volume data, through the instance selected by volumeNode.
+// The preparation runs in the background in the returned session. The
+// response also lists the objects using the volume on this node, which
+// the client must stop before calling PostVolumeMigrateCommit.
+func (a *DaemonAPI) PostVolumeMigratePrepare(ctx echo.Context, namespace string, kind naming.Kind, name string, params api.PostVolumeMigratePrepareParams) error {
+	log := LogHandler(ctx, "PostVolumeMigratePrepare")
+
+	if v, err := assertGrant(ctx, rbac.NewGrant(rbac.RoleAdmin, namespace), rbac.GrantRoot); !v {
+		return err
+	}
+	p, err := volumePath(namespace, kind, name)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "%s", err)
+	}
+	if params.Pool == "" {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "pool is required")
+	}
+	log = naming.LogWithPath(log, p)
+
+	nodename, ok := a.volumeNode(p)
+	if !ok {
+		return JSONProblemf(ctx, http.StatusNotFound, "Not found", "%s has no instance", p)
+	}
+	if nodename == a.localhost {
+		o, err := object.NewVol(p)
+		if err != nil {
+			return JSONProblemf(ctx, http.StatusInternalServerError, "New object", "%s", err)
+		}
+		holders := o.HoldersExcept(ctx.Request().Context(), naming.Path{})
+		var requesterSid uuid.UUID
+		if params.RequesterSid != nil {
+			requesterSid = *params.RequesterSid
+		}
+		args := []string{p.String(), "migrate", "--local", "--step", "prepare", "--pool", params.Pool}
+		sid, err := a.apiExec(ctx, p, requesterSid, args, log)
+		if err != nil {
+			return JSONProblemf(ctx, http.StatusInternalServerError, "Migrate prepare", "%s", err)
+		}
+		return ctx.JSON(http.StatusOK, api.VolumeMigration{Holders: holders.StrSlice(), SessionID: sid})
+	}
+
+	return proxyVolumeRequest(ctx, nodename, func(c *client.T) (*http.Response, error) {
+		return c.PostVolumeMigratePrepare(ctx.Request().Context(), namespace, kind, name, &params)
+	})
+}
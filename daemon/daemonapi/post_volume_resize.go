@@ -0,0 +1,54 @@
+package daemonapi
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/daemon/rbac"
+	"github.com/opensvc/om3/util/sizeconv"
+)
+
+// PostVolumeResize grows the volume through the pool, disk and
+// filesystem layers of the instance selected by volumeNode, where the
+// volume is up and its filesystem mounted.
+func (a *DaemonAPI) PostVolumeResize(ctx echo.Context, namespace string, kind naming.Kind, name string, params api.PostVolumeResizeParams) error {
+	log := LogHandler(ctx, "PostVolumeResize")
+
+	if v, err := assertGrant(ctx, rbac.NewGrant(rbac.RoleAdmin, namespace), rbac.GrantRoot); !v {
+		return err
+	}
+	p, err := volumePath(namespace, kind, name)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "%s", err)
+	}
+	if params.Size == "" {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "size is required")
+	}
+	log = naming.LogWithPath(log, p)
+
+	nodename, ok := a.volumeNode(p)
+	if !ok {
+		return JSONProblemf(ctx, http.StatusNotFound, "Not found", "%s has no instance", p)
+	}
+	if nodename == a.localhost {
+		o, err := object.NewVol(p)
+		if err != nil {
+			return JSONProblemf(ctx, http.StatusInternalServerError, "New object", "%s", err)
+		}
+		size, err := o.Resize(ctx.Request().Context(), params.Size)
+		if err != nil {
+			return JSONProblemf(ctx, http.StatusInternalServerError, "Resize", "%s", err)
+		}
+		log.Infof("resized to %s", sizeconv.BSizeCompact(float64(size)))
+		return ctx.NoContent(http.StatusNoContent)
+	}
+
+	return proxyVolumeRequest(ctx, nodename, func(c *client.T) (*http.Response, error) {
+		return c.PostVolumeResize(ctx.Request().Context(), namespace, kind, name, &params)
+	})
+}
@@ -0,0 +1,51 @@
+package daemonapi
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/pool"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/daemon/rbac"
+)
+
+// PostVolumeSnapshot takes a snapshot of the volume data, through the pool
+// driver of the instance selected by volumeNode.
+func (a *DaemonAPI) PostVolumeSnapshot(ctx echo.Context, namespace string, kind naming.Kind, name string, params api.PostVolumeSnapshotParams) error {
+	log := LogHandler(ctx, "PostVolumeSnapshot")
+
+	if v, err := assertGrant(ctx, rbac.NewGrant(rbac.RoleAdmin, namespace), rbac.GrantRoot); !v {
+		return err
+	}
+	p, err := volumePath(namespace, kind, name)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "%s", err)
+	}
+	if err := pool.ValidateSnapshotName(params.Name); err != nil {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "%s", err)
+	}
+	log = naming.LogWithPath(log, p)
+
+	nodename, ok := a.volumeNode(p)
+	if !ok {
+		return JSONProblemf(ctx, http.StatusNotFound, "Not found", "%s has no instance", p)
+	}
+	if nodename == a.localhost {
+		o, err := volumeSnapshotter(p)
+		if err != nil {
+			return JSONProblemf(ctx, http.StatusInternalServerError, "New object", "%s", err)
+		}
+		if err := o.CreateSnapshot(params.Name); err != nil {
+			return JSONProblemf(ctx, http.StatusInternalServerError, "Create snapshot", "%s", err)
+		}
+		log.Infof("snapshot %s created", params.Name)
+		return ctx.NoContent(http.StatusNoContent)
+	}
+
+	return proxyVolumeRequest(ctx, nodename, func(c *client.T) (*http.Response, error) {
+		return c.PostVolumeSnapshot(ctx.Request().Context(), namespace, kind, name, &params)
+	})
+}
@@ -15,6 +15,14 @@ type (
 		UserGrants(username, password string) ([]string, error)
 	}
 
+	// UserTOTPEnroller is the interface for UserTOTPEnrolled method for user
+	// basic auth. The basic auth of the enrolled users is flagged with the
+	// "totp" extension, so the api only accepts it to create a token with a
+	// valid TOTP code.
+	UserTOTPEnroller interface {
+		UserTOTPEnrolled(username string) bool
+	}
+
 	// NodeAuthenticater is the interface for AuthenticateNode method for node basic auth.
 	NodeAuthenticater interface {
 		AuthenticateNode(nodename, password string) error
@@ -32,7 +40,11 @@ func initBasicUser(i any) (string, auth.Strategy, error) {
 		if err != nil {
 			return nil, fmt.Errorf("invalid user %s: %w", userName, err)
 		}
-		return auth.NewUserInfo(userName, "", nil, *authenticatedExtensions("user", grants...)), nil
+		extensions := authenticatedExtensions("user", grants...)
+		if enroller, ok := i.(UserTOTPEnroller); ok && enroller.UserTOTPEnrolled(userName) {
+			extensions.Set("totp", "required")
+		}
+		return auth.NewUserInfo(userName, "", nil, *extensions), nil
 	}
 	return name, basic.NewCached(validateUser, cache), nil
 }
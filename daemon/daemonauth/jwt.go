@@ -3,9 +3,11 @@ package daemonauth
 import (
 	"context"
 	"crypto/rsa"
+	"encoding/pem"
 	"fmt"
 	"net/http"
 	"os"
+	"sync"
 	"time"
 
 	"github.com/dgrijalva/jwt-go"
@@ -13,6 +15,8 @@ import (
 	"github.com/shaj13/go-guardian/v2/auth"
 	"github.com/shaj13/go-guardian/v2/auth/strategies/token"
 	"golang.org/x/crypto/ssh"
+
+	"github.com/opensvc/om3/util/file"
 )
 
 type (
@@ -21,20 +25,43 @@ type (
 
 	// apiClaims defines api claims
 	apiClaims struct {
-		Grant []string `json:"grant"`
+		Grant     []string `json:"grant"`
+		TokenType string   `json:"token_type,omitempty"`
 		*jwt.StandardClaims
 	}
 
+	// TokenRevoker is the interface for IsTokenRevoked method for JWT auth.
+	// The tokens with a revoked jti claim are rejected.
+	TokenRevoker interface {
+		IsTokenRevoked(id string) bool
+	}
+
 	// JWTFiler is the interface that groups SignKeyFile and VerifyKeyFile methods
 	// for JWT auth.
 	JWTFiler interface {
 		SignKeyFile() string
 		VerifyKeyFile() string
 	}
+
+	// jwtKeyring holds the jwt sign key and the verify keys, reloaded
+	// from the sign and verify key files when they change.
+	//
+	// The verify keys are the public keys of all the certificates found in
+	// the verify key file, so the tokens signed by a rotated CA are still
+	// accepted while the previous CA is trusted.
+	jwtKeyring struct {
+		signKeyFile   string
+		verifyKeyFile string
+
+		mu         sync.Mutex
+		modTime    time.Time
+		auth       *jwtauth.JWTAuth
+		verifyKeys []*rsa.PublicKey
+	}
 )
 
 var (
-	jwtAuth *jwtauth.JWTAuth
+	jwtKeys *jwtKeyring
 
 	// jwtVerifyKeySign is the jwt verify key signature initialized during initAuthJWT
 	jwtVerifyKeySign string
@@ -42,28 +69,47 @@ var (
 
 func initJWT(i interface{}) (string, auth.Strategy, error) {
 	var (
-		err       error
-		verifyKey *rsa.PublicKey
-		name      = "jwt"
+		err  error
+		name = "jwt"
 	)
 
-	verifyKey, jwtAuth, err = initAuthJWT(i)
+	jwtKeys, err = initAuthJWT(i)
 	if err != nil {
 		return name, nil, err
 	}
+	revoker, _ := i.(TokenRevoker)
 	validate := func(ctx context.Context, r *http.Request, s string) (info auth.Info, exp time.Time, err error) {
 		var tk *jwt.Token
 
-		tk, err = jwt.ParseWithClaims(s, &apiClaims{}, func(token *jwt.Token) (interface{}, error) {
-			return verifyKey, nil
-		})
+		_, verifyKeys, err := jwtKeys.get()
+		if err != nil {
+			return
+		}
+		for _, verifyKey := range verifyKeys {
+			tk, err = jwt.ParseWithClaims(s, &apiClaims{}, func(token *jwt.Token) (interface{}, error) {
+				return verifyKey, nil
+			})
+			if err == nil {
+				break
+			}
+		}
 		if err != nil {
 			return
 		}
 		claims := tk.Claims.(*apiClaims)
+		if claims.Id != "" && revoker != nil && revoker.IsTokenRevoked(claims.Id) {
+			err = fmt.Errorf("token %s is revoked", claims.Id)
+			return
+		}
 		exp = time.Unix(claims.ExpiresAt, 0)
 
 		extensions := authenticatedExtensions("jwt", claims.Grant...)
+		if claims.Id != "" {
+			extensions.Set("token_id", claims.Id)
+		}
+		if claims.TokenType != "" {
+			extensions.Set("token_type", claims.TokenType)
+		}
 		info = auth.NewUserInfo(claims.Subject, claims.Subject, nil, *extensions)
 		return
 	}
@@ -71,60 +117,112 @@ func initJWT(i interface{}) (string, auth.Strategy, error) {
 	return name, token.New(validate, cache), nil
 }
 
-// initAuthJWT initialize auth JWT and returns verify key and *jwtauth.JWTAuth
-func initAuthJWT(i interface{}) (*rsa.PublicKey, *jwtauth.JWTAuth, error) {
-	var (
-		err error
-
-		verifyBytes []byte
-		signBytes   []byte
-
-		signKey   *rsa.PrivateKey
-		verifyKey *rsa.PublicKey
-	)
-
+// initAuthJWT initialize auth JWT and returns the jwt keyring
+func initAuthJWT(i interface{}) (*jwtKeyring, error) {
 	f, ok := i.(JWTFiler)
 	if !ok {
-		return nil, nil, fmt.Errorf("missing sign and verify files")
+		return nil, fmt.Errorf("missing sign and verify files")
 	}
 	var (
 		signKeyFile   = f.SignKeyFile()
 		verifyKeyFile = f.VerifyKeyFile()
 	)
 	if signKeyFile == "" && verifyKeyFile == "" {
-		return nil, nil, fmt.Errorf("jwt undefined files: sign key and verify key")
+		return nil, fmt.Errorf("jwt undefined files: sign key and verify key")
 	} else if signKeyFile == "" {
-		return nil, nil, fmt.Errorf("jwt undefined file: sign key")
+		return nil, fmt.Errorf("jwt undefined file: sign key")
 		// If we want to support less secure HMAC token from a static sign key:
 		//	jwtAuth = jwtauth.New("HMAC", []byte(jwtSignKey), nil)
 	} else if verifyKeyFile == "" {
-		return nil, nil, fmt.Errorf("jwt undefined file: verify key")
+		return nil, fmt.Errorf("jwt undefined file: verify key")
+	}
+	keyring := &jwtKeyring{
+		signKeyFile:   signKeyFile,
+		verifyKeyFile: verifyKeyFile,
 	}
+	if _, _, err := keyring.get(); err != nil {
+		return nil, err
+	}
+	return keyring, nil
+}
+
+// get returns the jwt auth and the verify keys, reloaded if the key files
+// have changed. The previous keys are kept if the files can not be loaded.
+func (t *jwtKeyring) get() (*jwtauth.JWTAuth, []*rsa.PublicKey, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	modTime, err := file.LastModTime(t.signKeyFile, t.verifyKeyFile)
+	switch {
+	case err != nil && t.auth != nil:
+		return t.auth, t.verifyKeys, nil
+	case err != nil:
+		return nil, nil, err
+	case t.auth != nil && modTime.Equal(t.modTime):
+		return t.auth, t.verifyKeys, nil
+	}
+	jwtAuth, verifyKeys, err := t.load()
+	if err != nil {
+		if t.auth != nil {
+			return t.auth, t.verifyKeys, nil
+		}
+		return nil, nil, err
+	}
+	t.auth = jwtAuth
+	t.verifyKeys = verifyKeys
+	t.modTime = modTime
+	return t.auth, t.verifyKeys, nil
+}
+
+func (t *jwtKeyring) load() (*jwtauth.JWTAuth, []*rsa.PublicKey, error) {
+	var (
+		err error
 
-	if signBytes, err = os.ReadFile(signKeyFile); err != nil {
+		verifyBytes []byte
+		signBytes   []byte
+
+		signKey    *rsa.PrivateKey
+		verifyKeys []*rsa.PublicKey
+	)
+	if signBytes, err = os.ReadFile(t.signKeyFile); err != nil {
 		return nil, nil, fmt.Errorf("%w: jwt sign key file", err)
 	}
-	if verifyBytes, err = os.ReadFile(verifyKeyFile); err != nil {
+	if verifyBytes, err = os.ReadFile(t.verifyKeyFile); err != nil {
 		return nil, nil, fmt.Errorf("%w: jwt verify key file", err)
 	}
 	if signKey, err = jwt.ParseRSAPrivateKeyFromPEM(signBytes); err != nil {
 		return nil, nil, fmt.Errorf("%w: parse RSA private key from sign key file content", err)
 	}
-	if verifyKey, err = jwt.ParseRSAPublicKeyFromPEM(verifyBytes); err != nil {
-		return nil, nil, fmt.Errorf("%w: parse RSA public key from verify key file content", err)
+	for {
+		var block *pem.Block
+		block, verifyBytes = pem.Decode(verifyBytes)
+		if block == nil {
+			break
+		}
+		verifyKey, err := jwt.ParseRSAPublicKeyFromPEM(pem.EncodeToMemory(block))
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: parse RSA public key from verify key file content", err)
+		}
+		verifyKeys = append(verifyKeys, verifyKey)
+	}
+	if len(verifyKeys) == 0 {
+		return nil, nil, fmt.Errorf("no RSA public key found in verify key file content")
 	}
-	if pk, err := ssh.NewPublicKey(verifyKey); err != nil {
+	if pk, err := ssh.NewPublicKey(verifyKeys[0]); err != nil {
 		jwtVerifyKeySign = fmt.Sprintf("can't read public key:%s", err)
 	} else {
 		jwtVerifyKeySign = ssh.FingerprintLegacyMD5(pk)
 	}
-	return verifyKey, jwtauth.New("RS256", signKey, verifyKey), nil
+	return jwtauth.New("RS256", signKey, &signKey.PublicKey), verifyKeys, nil
 }
 
 // CreateUserToken implements CreateUserToken interface for JWTCreator.
 // empty token is returned if jwtAuth is not initialized
 func (*JWTCreator) CreateUserToken(userInfo auth.Info, duration time.Duration, xClaims map[string]interface{}) (tk string, expiredAt time.Time, err error) {
-	if jwtAuth == nil {
+	if jwtKeys == nil {
+		return
+	}
+	jwtAuth, _, err := jwtKeys.get()
+	if err != nil {
 		return
 	}
 	expiredAt = time.Now().Add(duration)
@@ -1,13 +1,18 @@
 package daemonauth
 
 import (
+	"context"
 	"crypto/x509"
-	"encoding/pem"
 	"fmt"
+	"net/http"
 	"os"
+	"sync"
+	"time"
 
 	"github.com/shaj13/go-guardian/v2/auth"
 	x509Strategy "github.com/shaj13/go-guardian/v2/auth/strategies/x509"
+
+	"github.com/opensvc/om3/util/file"
 )
 
 type (
@@ -15,6 +20,18 @@ type (
 	X509CACertFiler interface {
 		X509CACertFile() string
 	}
+
+	// x509Reloader is a x509 strategy trusting all the CA certificates
+	// found in the CA certificates file. The strategy is renewed when the
+	// file changes, so the CA rotations apply without restarting the
+	// listeners.
+	x509Reloader struct {
+		caCertsFile string
+
+		mu       sync.Mutex
+		modTime  time.Time
+		strategy auth.Strategy
+	}
 )
 
 func initX509(i interface{}) (string, auth.Strategy, error) {
@@ -23,32 +40,59 @@ func initX509(i interface{}) (string, auth.Strategy, error) {
 	if !ok {
 		return name, nil, fmt.Errorf("missing ca certificates")
 	}
-	caCertsFile := caFiler.X509CACertFile()
-	cert, err := x509CertificateFromFile(caCertsFile)
+	t := &x509Reloader{caCertsFile: caFiler.X509CACertFile()}
+	if _, err := t.get(); err != nil {
+		return name, nil, fmt.Errorf("initX509 retrieve cert from file %s: %w", t.caCertsFile, err)
+	}
+	return name, t, nil
+}
+
+func (t *x509Reloader) Authenticate(ctx context.Context, r *http.Request) (auth.Info, error) {
+	strategy, err := t.get()
 	if err != nil {
-		return name, nil, fmt.Errorf("initX509 retrieve cert from file %s: %w", caCertsFile, err)
+		return nil, err
+	}
+	return strategy.Authenticate(ctx, r)
+}
+
+// get returns the x509 strategy, renewed if the CA certificates file has
+// changed. The previous strategy is kept if the file can not be loaded.
+func (t *x509Reloader) get() (auth.Strategy, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	modTime, err := file.LastModTime(t.caCertsFile)
+	switch {
+	case err != nil && t.strategy != nil:
+		return t.strategy, nil
+	case err != nil:
+		return nil, err
+	case t.strategy != nil && modTime.Equal(t.modTime):
+		return t.strategy, nil
+	}
+	roots, err := x509CertPoolFromFile(t.caCertsFile)
+	if err != nil {
+		if t.strategy != nil {
+			return t.strategy, nil
+		}
+		return nil, err
 	}
-	roots := x509.NewCertPool()
-	roots.AddCert(cert)
 	opts := x509.VerifyOptions{
 		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
 		Roots:     roots,
 	}
-	return name, x509Strategy.New(opts), nil
+	t.strategy = x509Strategy.New(opts)
+	t.modTime = modTime
+	return t.strategy, nil
 }
 
-func x509CertificateFromFile(s string) (*x509.Certificate, error) {
-	ca, err := os.ReadFile(s)
+func x509CertPoolFromFile(s string) (*x509.CertPool, error) {
+	b, err := os.ReadFile(s)
 	if err != nil {
 		return nil, fmt.Errorf("read file: %w", err)
 	}
-	p, _ := pem.Decode(ca)
-	if p == nil {
-		return nil, fmt.Errorf("pem decode: %w", err)
-	}
-	cert, err := x509.ParseCertificate(p.Bytes)
-	if err != nil {
-		return nil, fmt.Errorf("x509 parse certificate: %w", err)
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(b) {
+		return nil, fmt.Errorf("no certificate found")
 	}
-	return cert, nil
+	return roots, nil
 }
@@ -378,6 +378,8 @@ func (d *data) startSubscriptions() {
 	sub.AddFilter(&msgbus.ClusterConfigUpdated{}, d.labelLocalNode)
 	sub.AddFilter(&msgbus.ClusterStatusUpdated{}, d.labelLocalNode)
 
+	sub.AddFilter(&msgbus.DaemonCollectorUpdated{}, d.labelLocalNode)
+
 	sub.AddFilter(&msgbus.InstanceConfigDeleted{}, d.labelLocalNode)
 	sub.AddFilter(&msgbus.InstanceConfigUpdated{}, d.labelLocalNode)
 
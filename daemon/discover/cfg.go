@@ -8,6 +8,7 @@ import (
 
 	"github.com/opensvc/om3/core/client"
 	"github.com/opensvc/om3/core/cluster"
+	"github.com/opensvc/om3/core/confighistory"
 	"github.com/opensvc/om3/core/freeze"
 	"github.com/opensvc/om3/core/instance"
 	"github.com/opensvc/om3/core/naming"
@@ -250,6 +251,11 @@ func (t *Manager) onRemoteConfigFetched(c *msgbus.RemoteFileConfig) {
 			c.Err <- err
 		} else {
 			log.Infof("cfg: install %s config fetched from node %s", c.Path, c.Node)
+			if confighistory.IsSupported(c.Path.Kind) {
+				if _, err := confighistory.New(c.Path).AddFile(confFile, c.Author); err != nil {
+					log.Warnf("cfg: can't add %s config revision fetched from node %s: %s", c.Path, c.Node, err)
+				}
+			}
 		}
 		c.Err <- nil
 	}
@@ -306,7 +312,7 @@ func fetch(ctx context.Context, cli *client.T, p naming.Path, peer string, cmdC
 	log := naming.LogWithPath(plog.NewDefaultLogger(), p).
 		Attr("pkg", "daemon/discover").
 		Attr("id", id).WithPrefix("daemon: discover: cfg: fetch: ")
-	tmpFilename, updated, err := remoteconfig.FetchObjectConfigFile(cli, p)
+	tmpFilename, updated, author, err := remoteconfig.FetchObjectConfigFileWithAuthor(cli, p)
 	if err != nil {
 		log.Warnf("unable to retrieve %s from %s: %s", id, cli.URL(), err)
 		time.Sleep(250 * time.Millisecond)
@@ -319,7 +325,7 @@ func fetch(ctx context.Context, cli *client.T, p naming.Path, peer string, cmdC
 				log.Errorf("unable to recreate client: %s", err)
 				return
 			}
-			if tmpFilename, updated, err = remoteconfig.FetchObjectConfigFile(cli, p); err != nil {
+			if tmpFilename, updated, author, err = remoteconfig.FetchObjectConfigFileWithAuthor(cli, p); err != nil {
 				log.Infof("unable to retrieve %s from outdated url %s: %s", id, cli.URL(), err)
 				return
 			}
@@ -366,6 +372,7 @@ func fetch(ctx context.Context, cli *client.T, p naming.Path, peer string, cmdC
 			File:      tmpFilename,
 			Freeze:    freezeV,
 			UpdatedAt: updated,
+			Author:    author,
 			Ctx:       ctx,
 			Err:       err,
 		}
@@ -0,0 +1,147 @@
+package icfg
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/util/stringslice"
+)
+
+var (
+	// certCheckInterval is the interval between the sec objects certificate
+	// expiry checks.
+	certCheckInterval = time.Hour
+)
+
+// setCertInfo sets the certificate expiry of a sec object holding a
+// certificate in the instance config.
+func (t *Manager) setCertInfo(cfg *instance.Config) {
+	cfg.CertNotAfter = nil
+	cfg.CertExpireDays = nil
+	store, ok := t.configure.(object.Keystore)
+	if !ok || !store.HasKey("certificate") {
+		return
+	}
+	notAfter, err := t.configure.(object.SecureKeystore).CertNotAfter()
+	if err != nil {
+		t.log.Warnf("certificate expiry: %s", err)
+		return
+	}
+	days := int(math.Floor(time.Until(notAfter).Hours() / 24))
+	cfg.CertNotAfter = &notAfter
+	cfg.CertExpireDays = &days
+}
+
+// onCertCheck refreshes the certificate expiry in the instance config, and
+// renews the certificate if its expiry is near.
+func (t *Manager) onCertCheck() {
+	if len(t.instanceConfig.Scope) == 0 {
+		return
+	}
+	cfg := t.instanceConfig.DeepCopy()
+	t.setCertInfo(cfg)
+	if cfg.CertNotAfter == nil {
+		return
+	}
+	t.updateConfig(cfg)
+	t.certRenew(cfg)
+}
+
+// certRenew renews the certificate if its expiry is within the duration
+// set by the renew keyword.
+//
+// To avoid concurrent renewals, the certificate is renewed by the first
+// node of the scope. The next nodes renew the certificate one day later
+// each, in case the previous nodes did not.
+func (t *Manager) certRenew(cfg *instance.Config) {
+	store := t.configure.(object.SecureKeystore)
+	renewBefore := store.CertRenewBefore()
+	if renewBefore <= 0 {
+		return
+	}
+	scope := append([]string{}, cfg.Scope...)
+	sort.Strings(scope)
+	i := stringslice.Index(t.localhost, scope)
+	if i < 0 {
+		return
+	}
+	delay := time.Duration(i) * 24 * time.Hour
+	if time.Until(*cfg.CertNotAfter) > renewBefore-delay {
+		return
+	}
+	if t.certRenewing {
+		return
+	}
+	t.log.Infof("renew the certificate expiring in %d days", *cfg.CertExpireDays)
+	t.certRenewing = true
+	go func() {
+		err := genCert(t.path)
+		select {
+		case t.certRenewedC <- err:
+		case <-t.ctx.Done():
+		}
+	}()
+}
+
+// genCert generates a new certificate for the sec object. It runs out of
+// the worker loop, as the key generation and the key provider calls can be
+// slow, so it uses its own object.
+func genCert(p naming.Path) error {
+	o, err := object.NewConfigurer(p)
+	if err != nil {
+		return err
+	}
+	store, ok := o.(object.SecureKeystore)
+	if !ok {
+		return fmt.Errorf("%s is not a secure keystore", p)
+	}
+	return store.GenCert()
+}
+
+// onCertRenewed is called by the worker loop when a certificate renewal
+// started by certRenew is done.
+func (t *Manager) onCertRenewed(err error) {
+	t.certRenewing = false
+	if err != nil {
+		t.log.Errorf("renew the certificate: %s", err)
+		return
+	}
+	t.log.Infof("certificate renewed")
+}
+
+// onCertChanged installs the sec keys in the volumes projecting them when
+// the certificate is changed, as the node committing the change is the
+// only one doing it on commit.
+func (t *Manager) onCertChanged(before, after *time.Time) {
+	if before == nil || after == nil || before.Equal(*after) {
+		return
+	}
+	if _, ok := t.configure.(object.Keystore); !ok {
+		return
+	}
+	t.log.Infof("certificate changed => install in volumes")
+	go func() {
+		if err := installVolumes(t.path); err != nil {
+			t.log.Warnf("install in volumes: %s", err)
+		}
+	}()
+}
+
+// installVolumes installs the keystore keys in the volumes projecting them.
+// Like genCert, it runs out of the worker loop, so it uses its own object.
+func installVolumes(p naming.Path) error {
+	o, err := object.NewConfigurer(p)
+	if err != nil {
+		return err
+	}
+	store, ok := o.(object.Keystore)
+	if !ok {
+		return fmt.Errorf("%s is not a keystore", p)
+	}
+	return store.InstallVolumes()
+}
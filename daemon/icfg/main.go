@@ -8,6 +8,8 @@
 //   - when on InstanceConfigUpdated for local cluster is fired (scope may need refresh)
 //   - for cluster config
 //   - when on ClusterConfigUpdated for local node is fired
+//   - for sec config holding a certificate
+//   - periodically, to refresh the certificate expiry and renew it
 //
 // The worker routine is terminated when ConfigFileUpdated is fired, or
 // when daemon discover context is done.
@@ -20,6 +22,7 @@ import (
 	"time"
 
 	"github.com/opensvc/om3/core/clusternode"
+	"github.com/opensvc/om3/core/confighistory"
 	"github.com/opensvc/om3/core/instance"
 	"github.com/opensvc/om3/core/naming"
 	"github.com/opensvc/om3/core/object"
@@ -53,6 +56,15 @@ type (
 		instanceMonitorCtx       context.Context
 		isInstanceMonitorStarted bool
 
+		// templates are the paths of the objects extended by the object
+		// configuration, watched for changes.
+		templates naming.Paths
+
+		// certRenewing is true while a certificate renewal is running.
+		// certRenewedC receives the renewal result.
+		certRenewing bool
+		certRenewedC chan error
+
 		// ctx is a context created from parent context
 		ctx context.Context
 		// cancel is a cancel func for icfg, used to stop ifg if error occurs
@@ -94,6 +106,7 @@ func Start(parent context.Context, p naming.Path, filename string, svcDiscoverCm
 		forceRefresh:   false,
 		bus:            pubsub.BusFromContext(ctx),
 		filename:       filename,
+		certRenewedC:   make(chan error),
 
 		ctx:    ctx,
 		cancel: cancel,
@@ -163,19 +176,39 @@ func (t *Manager) worker() {
 	}
 	defer t.delete()
 
+	var certTickerC <-chan time.Time
+	if t.path.Kind == naming.KindSec {
+		certTicker := time.NewTicker(certCheckInterval)
+		defer certTicker.Stop()
+		certTickerC = certTicker.C
+		t.onCertCheck()
+	}
+
 	t.log.Debugf("started")
 	for {
 		select {
 		case <-t.ctx.Done():
 			return
+		case <-certTickerC:
+			t.onCertCheck()
+		case err := <-t.certRenewedC:
+			t.onCertRenewed(err)
 		case i := <-t.sub.C:
-			switch i.(type) {
+			switch c := i.(type) {
 			case *msgbus.ClusterConfigUpdated:
 				t.onClusterConfigUpdated()
 			case *msgbus.ConfigFileRemoved:
-				t.onConfigFileRemoved()
+				if c.Path != t.path {
+					t.onTemplateConfigFileChanged(c.Path)
+				} else {
+					t.onConfigFileRemoved()
+				}
 			case *msgbus.ConfigFileUpdated:
-				t.onConfigFileUpdated()
+				if c.Path != t.path {
+					t.onTemplateConfigFileChanged(c.Path)
+				} else {
+					t.onConfigFileUpdated()
+				}
 			case *msgbus.InstanceConfigUpdated:
 				t.onLocalClusterInstanceConfigUpdated()
 			}
@@ -205,6 +238,11 @@ func (t *Manager) onConfigFileUpdated() {
 	_ = t.configFileCheckRefresh(false)
 }
 
+func (t *Manager) onTemplateConfigFileChanged(p naming.Path) {
+	t.log.Infof("template %s config file changed => refresh", p)
+	_ = t.configFileCheckRefresh(true)
+}
+
 func (t *Manager) onLocalClusterInstanceConfigUpdated() {
 	t.log.Infof("cluster instance config changed => refresh")
 	_ = t.configFileCheckRefresh(true)
@@ -262,6 +300,7 @@ func (t *Manager) configFileCheck() error {
 		return errConfigFileCheck
 	}
 	t.forceRefresh = false
+	t.watchTemplates()
 	cf := t.configure.Config()
 	scope, err := t.getScope(cf)
 	if err != nil {
@@ -310,13 +349,25 @@ func (t *Manager) configFileCheck() error {
 	if sz := cf.GetSize(keySize); sz != nil {
 		cfg.Size = sz
 	}
+	if t.path.Kind == naming.KindSec {
+		t.setCertInfo(&cfg)
+	}
 	if cfg.Topology == topology.Flex {
 		cfg.FlexMin = t.getFlexMin(cf)
 		cfg.FlexMax = t.getFlexMax(cf)
 		cfg.FlexTarget = t.getFlexTarget(cf, cfg.FlexMin, cfg.FlexMax)
 	}
 
+	if confighistory.IsSupported(t.path.Kind) {
+		// The committer already recorded the revision with its author, but
+		// direct config file modifications are only detected here.
+		if _, err := confighistory.New(t.path).AddFile(t.filename, ""); err != nil {
+			t.log.Warnf("add config revision: %s", err)
+		}
+	}
+
 	t.lastMtime = mtime
+	t.onCertChanged(t.instanceConfig.CertNotAfter, cfg.CertNotAfter)
 	t.updateConfig(&cfg)
 	return nil
 }
@@ -459,6 +510,28 @@ func (t *Manager) setConfigure() error {
 	return nil
 }
 
+// watchTemplates updates the subscription filters to watch the config files
+// of the templates extended by the object configuration. The dependent
+// instance config is refreshed when a template is changed.
+func (t *Manager) watchTemplates() {
+	templates := t.configure.Templates()
+	for _, p := range t.templates {
+		if !templates.Contains(p) {
+			label := pubsub.Label{"path", p.String()}
+			t.sub.DelFilter(&msgbus.ConfigFileUpdated{}, label)
+			t.sub.DelFilter(&msgbus.ConfigFileRemoved{}, label)
+		}
+	}
+	for _, p := range templates {
+		if !t.templates.Contains(p) {
+			label := pubsub.Label{"path", p.String()}
+			t.sub.AddFilter(&msgbus.ConfigFileUpdated{}, label)
+			t.sub.AddFilter(&msgbus.ConfigFileRemoved{}, label)
+		}
+	}
+	t.templates = templates
+}
+
 func (t *Manager) delete() {
 	labels := []pubsub.Label{
 		{"node", t.localhost},
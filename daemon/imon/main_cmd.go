@@ -1,11 +1,8 @@
 package imon
 
 import (
-	"bytes"
-	"crypto/md5"
 	"errors"
 	"fmt"
-	"sort"
 	"strings"
 	"time"
 
@@ -16,6 +13,7 @@ import (
 	"github.com/opensvc/om3/core/nodeselector"
 	"github.com/opensvc/om3/core/object"
 	"github.com/opensvc/om3/core/placement"
+	"github.com/opensvc/om3/core/plan"
 	"github.com/opensvc/om3/core/provisioned"
 	"github.com/opensvc/om3/core/status"
 	"github.com/opensvc/om3/core/topology"
@@ -787,100 +785,13 @@ func (t *Manager) needOrchestrate(c cmdOrchestrate) {
 }
 
 func (t *Manager) sortCandidates(candidates []string) []string {
-	switch t.objStatus.PlacementPolicy {
-	case placement.NodesOrder:
-		return t.sortWithNodesOrderPolicy(candidates)
-	case placement.Spread:
-		return t.sortWithSpreadPolicy(candidates)
-	case placement.Score:
-		return t.sortWithScorePolicy(candidates)
-	case placement.Shift:
-		return t.sortWithShiftPolicy(candidates)
-	case placement.LastStart:
-		return t.sortWithLastStartPolicy(candidates)
-	default:
-		return []string{}
-	}
-}
-
-func (t *Manager) sortWithSpreadPolicy(candidates []string) []string {
-	l := append([]string{}, candidates...)
-	sum := func(s string) []byte {
-		b := append([]byte(t.path.String()), []byte(s)...)
-		return md5.New().Sum(b)
-	}
-	sort.SliceStable(l, func(i, j int) bool {
-		return bytes.Compare(sum(l[i]), sum(l[j])) < 0
-	})
-	return l
-}
-
-// sortWithScorePolicy sorts candidates by descending cluster.NodeStats.Score
-func (t *Manager) sortWithScorePolicy(candidates []string) []string {
-	l := append([]string{}, candidates...)
-	sort.SliceStable(l, func(i, j int) bool {
-		var si, sj uint64
-		if stats, ok := t.nodeStats[l[i]]; ok {
-			si = stats.Score
-		}
-		if stats, ok := t.nodeStats[l[j]]; ok {
-			sj = stats.Score
-		}
-		return si > sj
-	})
-	return l
-}
-
-func (t *Manager) sortWithLoadAvgPolicy(candidates []string) []string {
-	l := append([]string{}, candidates...)
-	sort.SliceStable(l, func(i, j int) bool {
-		var si, sj float64
-		if stats, ok := t.nodeStats[l[i]]; ok {
-			si = stats.Load15M
-		}
-		if stats, ok := t.nodeStats[l[j]]; ok {
-			sj = stats.Load15M
-		}
-		return si > sj
-	})
-	return l
-}
-
-func (t *Manager) sortWithLastStartPolicy(candidates []string) []string {
-	l := append([]string{}, candidates...)
-	sort.SliceStable(l, func(i, j int) bool {
-		var si, sj time.Time
-		if instStatus, ok := t.instStatus[l[i]]; ok {
-			si = instStatus.LastStartedAt
-		}
-		if instStatus, ok := t.instStatus[l[j]]; ok {
-			sj = instStatus.LastStartedAt
-		}
-		return si.After(sj)
-	})
-	return l
-}
-
-func (t *Manager) sortWithShiftPolicy(candidates []string) []string {
-	var i int
-	l := t.sortWithNodesOrderPolicy(candidates)
-	l = append(l, l...)
-	n := len(candidates)
-	scalerSliceIndex := t.path.ScalerSliceIndex()
-	if n > 0 && scalerSliceIndex > n {
-		i = t.path.ScalerSliceIndex() % n
-	}
-	return candidates[i : i+n]
-}
-
-func (t *Manager) sortWithNodesOrderPolicy(candidates []string) []string {
-	var l []string
-	for _, node := range t.scopeNodes {
-		if stringslice.Has(node, candidates) {
-			l = append(l, node)
-		}
-	}
-	return l
+	return plan.CandidateSorter{
+		Path:           t.path,
+		Policy:         t.objStatus.PlacementPolicy,
+		Scope:          t.scopeNodes,
+		NodeStats:      t.nodeStats,
+		InstanceStatus: t.instStatus,
+	}.Sort(candidates)
 }
 
 func (t *Manager) nextPlacedAtCandidates(want []string) (string, error) {
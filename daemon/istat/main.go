@@ -7,7 +7,10 @@ import (
 	"sync"
 	"time"
 
+	"github.com/opensvc/om3/core/check"
 	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/status"
 	"github.com/opensvc/om3/daemon/msgbus"
 	"github.com/opensvc/om3/util/hostname"
 	"github.com/opensvc/om3/util/plog"
@@ -20,6 +23,8 @@ type (
 	// It publishes following messages for localhost instances:
 	//   - msgbus.InstanceStatusDeleted
 	//   - msgbus.InstanceStatusUpdated
+	//   - msgbus.CheckThresholdBreached
+	//   - msgbus.CheckThresholdCleared
 	T struct {
 		localhost string
 
@@ -35,6 +40,14 @@ type (
 		//    msgbus.InstanceStatusUpdated.
 		iStatusM map[string]instance.Status
 
+		// checkM is the breached localhost check instances indexed by
+		// check instance id. It is updated from local msgbus.NodeChecksPost.
+		checkM map[string]check.Result
+
+		// degrade is true when the overall status of the instances owning
+		// a breached check instance must be published as warn.
+		degrade bool
+
 		log *plog.Logger
 
 		ctx    context.Context
@@ -57,6 +70,7 @@ func New() *T {
 	localhost := hostname.Hostname()
 	return &T{
 		iStatusM:       make(map[string]instance.Status),
+		checkM:         make(map[string]check.Result),
 		localhost:      localhost,
 		labelLocalhost: pubsub.Label{"node", localhost},
 	}
@@ -78,6 +92,7 @@ func (t *T) Start(ctx context.Context) error {
 		sub.AddFilter(&msgbus.InstanceFrozenFileRemoved{}, t.labelLocalhost)
 		sub.AddFilter(&msgbus.InstanceFrozenFileUpdated{}, t.labelLocalhost)
 		sub.AddFilter(&msgbus.InstanceStatusPost{}, t.labelLocalhost)
+		sub.AddFilter(&msgbus.NodeChecksPost{}, t.labelLocalhost)
 		sub.Start()
 		t.sub = sub
 
@@ -115,6 +130,8 @@ func (t *T) worker() {
 				t.onInstanceFrozenFileUpdated(m)
 			case *msgbus.InstanceStatusPost:
 				t.onInstanceStatusPost(m)
+			case *msgbus.NodeChecksPost:
+				t.onNodeChecksPost(m)
 			}
 		}
 	}
@@ -146,11 +163,7 @@ func (t *T) onInstanceFrozenFileRemoved(fileRemoved *msgbus.InstanceFrozenFileRe
 		iStatus.UpdatedAt = fileRemoved.At
 	}
 	t.iStatusM[s] = iStatus
-	instance.StatusData.Set(fileRemoved.Path, t.localhost, iStatus.DeepCopy())
-	t.bus.Pub(&msgbus.InstanceStatusUpdated{Path: fileRemoved.Path, Node: t.localhost, Value: *iStatus.DeepCopy()},
-		t.labelLocalhost,
-		pubsub.Label{"path", s},
-	)
+	t.publishInstanceStatus(fileRemoved.Path, iStatus)
 }
 
 func (t *T) onInstanceFrozenFileUpdated(frozen *msgbus.InstanceFrozenFileUpdated) {
@@ -171,18 +184,100 @@ func (t *T) onInstanceFrozenFileUpdated(frozen *msgbus.InstanceFrozenFileUpdated
 		iStatus.UpdatedAt = frozen.At
 	}
 	t.iStatusM[s] = iStatus
-	instance.StatusData.Set(frozen.Path, t.localhost, iStatus.DeepCopy())
-	t.bus.Pub(&msgbus.InstanceStatusUpdated{Path: frozen.Path, Node: t.localhost, Value: *iStatus.DeepCopy()},
-		t.labelLocalhost,
-		pubsub.Label{"path", s},
-	)
+	t.publishInstanceStatus(frozen.Path, iStatus)
 }
 
 func (t *T) onInstanceStatusPost(post *msgbus.InstanceStatusPost) {
 	s := post.Path.String()
 	t.iStatusM[s] = post.Value
-	instance.StatusData.Set(post.Path, post.Node, post.Value.DeepCopy())
-	t.bus.Pub(&msgbus.InstanceStatusUpdated{Path: post.Path, Node: post.Node, Value: post.Value},
+	t.publishInstanceStatus(post.Path, post.Value)
+}
+
+// onNodeChecksPost publishes the check instances breaches and clearances,
+// and republishes the status of the instances whose degradation changed.
+func (t *T) onNodeChecksPost(post *msgbus.NodeChecksPost) {
+	breached := make(map[string]check.Result)
+	current := make(map[string]check.Result)
+	for _, r := range post.Value {
+		id := checkID(r)
+		current[id] = r
+		if r.IsBreached() {
+			breached[id] = r
+		}
+	}
+	changedPaths := make(map[string]any)
+	for id, r := range breached {
+		if prev, ok := t.checkM[id]; ok && prev.Status == r.Status {
+			continue
+		}
+		changedPaths[r.Path] = nil
+		t.bus.Pub(&msgbus.CheckThresholdBreached{Node: t.localhost, Value: r},
+			t.labelLocalhost,
+			pubsub.Label{"path", r.Path},
+		)
+	}
+	for id, prev := range t.checkM {
+		if _, ok := breached[id]; ok {
+			continue
+		}
+		r, ok := current[id]
+		if !ok {
+			r = prev
+			r.Status = ""
+		}
+		changedPaths[prev.Path] = nil
+		t.bus.Pub(&msgbus.CheckThresholdCleared{Node: t.localhost, Value: r},
+			t.labelLocalhost,
+			pubsub.Label{"path", r.Path},
+		)
+	}
+	degradeChanged := t.degrade != post.Degrade
+	t.checkM = breached
+	t.degrade = post.Degrade
+
+	for s, iStatus := range t.iStatusM {
+		if _, ok := changedPaths[s]; !ok && !degradeChanged {
+			continue
+		}
+		p, err := naming.ParsePath(s)
+		if err != nil {
+			continue
+		}
+		t.publishInstanceStatus(p, iStatus)
+	}
+}
+
+// isDegraded returns true if the overall status of the instance of the
+// object path s must be published as warn.
+func (t *T) isDegraded(s string) bool {
+	if !t.degrade {
+		return false
+	}
+	for _, r := range t.checkM {
+		if r.Path == s {
+			return true
+		}
+	}
+	return false
+}
+
+// publishInstanceStatus sets and publishes the localhost instance status,
+// with its overall status degraded if a check instance attributed to the
+// object is breached. Only the up overall status is degraded, so the down
+// or n/a instances keep reporting their real state.
+func (t *T) publishInstanceStatus(p naming.Path, iStatus instance.Status) {
+	s := p.String()
+	value := iStatus.DeepCopy()
+	if value.Overall == status.Up && t.isDegraded(s) {
+		value.Overall = status.Warn
+	}
+	instance.StatusData.Set(p, t.localhost, value.DeepCopy())
+	t.bus.Pub(&msgbus.InstanceStatusUpdated{Path: p, Node: t.localhost, Value: *value},
 		t.labelLocalhost,
-		pubsub.Label{"path", s})
+		pubsub.Label{"path", s},
+	)
+}
+
+func checkID(r check.Result) string {
+	return r.Path + ":" + r.DriverGroup + ":" + r.Instance + ":" + r.Unit
 }
@@ -0,0 +1,83 @@
+package istat
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/core/check"
+	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/status"
+	"github.com/opensvc/om3/daemon/msgbus"
+	"github.com/opensvc/om3/util/pubsub"
+)
+
+func TestDegrade(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	bus := pubsub.NewBus("daemon")
+	bus.Start(ctx)
+	defer bus.Stop()
+	ctx = pubsub.ContextWithBus(ctx, bus)
+
+	istat := New()
+	require.NoError(t, istat.Start(ctx))
+	defer func() { _ = istat.Stop() }()
+
+	sub := bus.Sub("test")
+	sub.AddFilter(&msgbus.InstanceStatusUpdated{}, istat.labelLocalhost)
+	sub.Start()
+	defer func() { _ = sub.Stop() }()
+
+	p := naming.Path{Namespace: "root", Kind: naming.KindSvc, Name: "svc1"}
+	breached := []check.Result{{DriverGroup: "fs_u", Path: p.String(), Instance: "/", Status: check.StatusNok}}
+
+	expectOverall := func(name string, expected status.T) {
+		t.Helper()
+		select {
+		case i := <-sub.C:
+			m, ok := i.(*msgbus.InstanceStatusUpdated)
+			require.True(t, ok, "%s: unexpected message %T", name, i)
+			require.Equal(t, expected, m.Value.Overall, name)
+		case <-time.After(time.Second):
+			t.Fatalf("%s: no instance status published", name)
+		}
+	}
+	postStatus := func(overall status.T) {
+		bus.Pub(&msgbus.InstanceStatusPost{Path: p, Node: istat.localhost, Value: instance.Status{Avail: overall, Overall: overall}},
+			istat.labelLocalhost, pubsub.Label{"path", p.String()})
+	}
+	postChecks := func(degrade bool, results []check.Result) {
+		bus.Pub(&msgbus.NodeChecksPost{Node: istat.localhost, Degrade: degrade, Value: results}, istat.labelLocalhost)
+	}
+
+	postStatus(status.Up)
+	expectOverall("initial up", status.Up)
+
+	postChecks(false, breached)
+	expectOverall("breached without degrade", status.Up)
+
+	postChecks(true, breached)
+	expectOverall("degrade on", status.Warn)
+
+	postStatus(status.Down)
+	expectOverall("down is not degraded", status.Down)
+
+	postStatus(status.NotApplicable)
+	expectOverall("n/a is not degraded", status.NotApplicable)
+
+	postStatus(status.Up)
+	expectOverall("up again is degraded", status.Warn)
+
+	postChecks(false, breached)
+	expectOverall("degrade off", status.Up)
+
+	postChecks(true, breached)
+	expectOverall("degrade on again", status.Warn)
+
+	postChecks(true, nil)
+	expectOverall("cleared", status.Up)
+}
@@ -0,0 +1,93 @@
+package listener
+
+import (
+	"context"
+
+	"github.com/opensvc/om3/core/cluster"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/daemon/msgbus"
+	"github.com/opensvc/om3/util/hostname"
+	"github.com/opensvc/om3/util/pubsub"
+)
+
+// watchCerts installs the certificate files again when the ca and cert sec
+// objects, or the sec objects of the cluster.ca trusted list, are updated,
+// locally or by the config sync.
+//
+// The listeners and the auth strategies reload the installed files when
+// they change, so a ca rotation or a certificate renewal applies without
+// restarting the daemon.
+func (t *T) watchCerts(ctx context.Context) {
+	bus := pubsub.BusFromContext(ctx)
+	sub := bus.Sub("daemon.listener.certs")
+	sub.AddFilter(&msgbus.ClusterConfigUpdated{}, pubsub.Label{"node", hostname.Hostname()})
+
+	watched := make(map[string]bool)
+	watch := func(caSecPaths []string) (changed bool) {
+		want := map[string]bool{
+			caPath.String():   true,
+			certPath.String(): true,
+		}
+		for _, s := range caSecPaths {
+			if p, err := naming.ParsePath(s); err == nil {
+				want[p.String()] = true
+			}
+		}
+		for s := range watched {
+			if !want[s] {
+				sub.DelFilter(&msgbus.ConfigFileUpdated{}, pubsub.Label{"path", s})
+				delete(watched, s)
+				changed = true
+			}
+		}
+		for s := range want {
+			if !watched[s] {
+				sub.AddFilter(&msgbus.ConfigFileUpdated{}, pubsub.Label{"path", s})
+				watched[s] = true
+				changed = true
+			}
+		}
+		return
+	}
+	watch(cluster.ConfigData.Get().CASecPaths)
+
+	sub.Start()
+	defer func() {
+		if err := sub.Stop(); err != nil {
+			t.log.Errorf("certs watcher subscription stop: %s", err)
+		}
+	}()
+
+	installCaFiles := func() {
+		if err := t.installCaFiles(cluster.ConfigData.Get().Name); err != nil {
+			t.log.Errorf("certs watcher: %s", err)
+		}
+	}
+	installCertFiles := func() {
+		if err := t.installCertFiles(cluster.ConfigData.Get().Name); err != nil {
+			t.log.Errorf("certs watcher: %s", err)
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case i := <-sub.C:
+			switch m := i.(type) {
+			case *msgbus.ClusterConfigUpdated:
+				if watch(m.Value.CASecPaths) {
+					t.log.Infof("certs watcher: trusted ca list changed")
+					installCaFiles()
+				}
+			case *msgbus.ConfigFileUpdated:
+				t.log.Infof("certs watcher: %s updated", m.Path)
+				if m.Path == certPath {
+					installCertFiles()
+				} else {
+					installCaFiles()
+				}
+			}
+		}
+	}
+}
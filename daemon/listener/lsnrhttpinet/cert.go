@@ -0,0 +1,54 @@
+package lsnrhttpinet
+
+import (
+	"crypto/tls"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/opensvc/om3/util/file"
+)
+
+type (
+	// certLoader serves the listener certificate, reloaded from the cert
+	// and key files when they change, so a renewed certificate is used by
+	// the new connections without restarting the listener.
+	certLoader struct {
+		certFile string
+		keyFile  string
+
+		mu      sync.Mutex
+		modTime time.Time
+		cert    *tls.Certificate
+	}
+)
+
+// GetCertificate implements the tls.Config GetCertificate function.
+//
+// The cert and key files are not updated atomically, so a reload error
+// keeps serving the previously loaded certificate, and the reload is
+// retried on the next handshake.
+func (t *certLoader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	modTime, err := file.LastModTime(t.certFile, t.keyFile)
+	if err != nil {
+		if t.cert != nil {
+			return t.cert, nil
+		}
+		return nil, err
+	}
+	if t.cert != nil && modTime.Equal(t.modTime) {
+		return t.cert, nil
+	}
+	cert, err := tls.LoadX509KeyPair(t.certFile, t.keyFile)
+	if err != nil {
+		if t.cert != nil {
+			return t.cert, nil
+		}
+		return nil, fmt.Errorf("load certificate: %w", err)
+	}
+	t.cert = &cert
+	t.modTime = modTime
+	return t.cert, nil
+}
@@ -86,11 +86,17 @@ func (t *T) start(ctx context.Context, errC chan<- error) {
 			return
 		}
 	}
+	certs := &certLoader{certFile: t.certFile, keyFile: t.keyFile}
+	if _, err := certs.GetCertificate(nil); err != nil {
+		errC <- fmt.Errorf("can't listen: %w", err)
+		return
+	}
 	t.listener = &http.Server{
 		Addr:    t.addr,
 		Handler: routehttp.New(ctx, true),
 		TLSConfig: &tls.Config{
-			ClientAuth: tls.NoClientCert,
+			ClientAuth:     tls.NoClientCert,
+			GetCertificate: certs.GetCertificate,
 		},
 		ErrorLog: golog.New(t.log.Logger(), "", 0),
 	}
@@ -125,7 +131,7 @@ func (t *T) start(ctx context.Context, errC chan<- error) {
 	}()
 	t.log.Infof("started")
 	errC <- nil
-	if err := t.listener.ServeTLS(lsnr, t.certFile, t.keyFile); err != nil {
+	if err := t.listener.ServeTLS(lsnr, "", ""); err != nil {
 		if errors.Is(err, http.ErrServerClosed) || errors.Is(err, net.ErrClosed) {
 			t.log.Debugf("listener serve ends with expected error")
 		} else {
@@ -142,7 +148,7 @@ func (t *T) start(ctx context.Context, errC chan<- error) {
 
 // janitor startup initial http inet listener, then watch events to stop, start or restart listener.
 // events are: DaemonCtl,name=lsnr-http-inet, ClusterConfigUpdated,node=<localhost> with changed lsnr addr or port
-// TODO: also watch for tls setting changed
+// The certificate changes are handled by the certLoader, without restart.
 func (t *T) janitor(ctx context.Context, errC chan<- error) {
 	var started bool
 	sub := t.bus.Sub("lsnr-http-inet")
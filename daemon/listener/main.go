@@ -28,6 +28,7 @@ type (
 	authOption struct {
 		*ccfg.NodeDB
 		*object.UsrDB
+		*object.TokenDB
 	}
 )
 
@@ -71,8 +72,9 @@ func (t *T) Start(ctx context.Context) error {
 		t.log.Errorf("start certificates volatile fs: %s", err)
 	} else {
 		t.stopFunc = append(t.stopFunc, stopCertFS)
+		go t.watchCerts(ctx)
 	}
-	if strategies, err := daemonauth.InitStategies(ctx, &authOption{}); err != nil {
+	if strategies, err := daemonauth.InitStategies(ctx, &authOption{TokenDB: &object.TokenDB{}}); err != nil {
 		return err
 	} else {
 		ctx = daemonauth.ContextWithStrategies(ctx, strategies)
@@ -0,0 +1,5 @@
+package msgbus
+
+func (data *ClusterData) onDaemonCollectorUpdated(m *DaemonCollectorUpdated) {
+	data.Daemon.Collector = m.Value
+}
@@ -20,6 +20,8 @@ func (data *ClusterData) ApplyMessage(m pubsub.Messager) {
 		data.onClusterStatusUpdated(c)
 	case *ClusterConfigUpdated:
 		data.onClusterConfigUpdated(c)
+	case *DaemonCollectorUpdated:
+		data.onDaemonCollectorUpdated(c)
 	case *DaemonHb:
 		data.onDaemonHb(c)
 	case *ObjectStatusDeleted:
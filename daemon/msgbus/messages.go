@@ -26,6 +26,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/opensvc/om3/core/check"
 	"github.com/opensvc/om3/core/cluster"
 	"github.com/opensvc/om3/core/event"
 	"github.com/opensvc/om3/core/instance"
@@ -40,6 +41,10 @@ var (
 	kindToT = map[string]func() any{
 		"ArbitratorError": func() any { return &ArbitratorError{} },
 
+		"CheckThresholdBreached": func() any { return &CheckThresholdBreached{} },
+
+		"CheckThresholdCleared": func() any { return &CheckThresholdCleared{} },
+
 		"ClusterConfigUpdated": func() any { return &ClusterConfigUpdated{} },
 
 		"ClusterStatusUpdated": func() any { return &ClusterStatusUpdated{} },
@@ -52,6 +57,8 @@ var (
 
 		"ClientUnsubscribed": func() any { return &ClientUnsubscribed{} },
 
+		"DaemonCollectorUpdated": func() any { return &DaemonCollectorUpdated{} },
+
 		"DaemonCtl": func() any { return &DaemonCtl{} },
 
 		"DaemonHb": func() any { return &DaemonHb{} },
@@ -120,6 +127,8 @@ var (
 
 		"Log": func() any { return &Log{} },
 
+		"NodeChecksPost": func() any { return &NodeChecksPost{} },
+
 		"NodeConfigUpdated": func() any { return &NodeConfigUpdated{} },
 
 		"NodeDataUpdated": func() any { return &NodeDataUpdated{} },
@@ -230,6 +239,22 @@ type (
 		File       string      `json:"file" yaml:"file"`
 	}
 
+	// CheckThresholdBreached is emitted by istat when a local check
+	// instance status becomes warn or nok, or changes between warn and nok.
+	CheckThresholdBreached struct {
+		pubsub.Msg `yaml:",inline"`
+		Node       string       `json:"node" yaml:"node"`
+		Value      check.Result `json:"check_result" yaml:"check_result"`
+	}
+
+	// CheckThresholdCleared is emitted by istat when a breached local check
+	// instance is ok again or no longer reported.
+	CheckThresholdCleared struct {
+		pubsub.Msg `yaml:",inline"`
+		Node       string       `json:"node" yaml:"node"`
+		Value      check.Result `json:"check_result" yaml:"check_result"`
+	}
+
 	ClientSubscribed struct {
 		pubsub.Msg `yaml:",inline"`
 		Time       time.Time `json:"at" yaml:"at"`
@@ -256,6 +281,14 @@ type (
 		Value      cluster.Status `json:"cluster_status" yaml:"cluster_status"`
 	}
 
+	// DaemonCollectorUpdated is emitted by the daemon collector routine
+	// when its status changes, for example when the spool depth changes.
+	DaemonCollectorUpdated struct {
+		pubsub.Msg `yaml:",inline"`
+		Node       string                  `json:"node" yaml:"node"`
+		Value      cluster.DaemonCollector `json:"collector" yaml:"collector"`
+	}
+
 	DaemonCtl struct {
 		pubsub.Msg `yaml:",inline"`
 		Component  string `json:"component" yaml:"component"`
@@ -506,6 +539,15 @@ type (
 		Level      string `json:"level" yaml:"level"`
 	}
 
+	// NodeChecksPost is emitted by the api when the node checks results are
+	// posted. The istat goroutine listens to this event.
+	NodeChecksPost struct {
+		pubsub.Msg `yaml:",inline"`
+		Node       string         `json:"node" yaml:"node"`
+		Degrade    bool           `json:"degrade" yaml:"degrade"`
+		Value      []check.Result `json:"check_results" yaml:"check_results"`
+	}
+
 	NodeConfigUpdated struct {
 		pubsub.Msg `yaml:",inline"`
 		Node       string      `json:"node" yaml:"node"`
@@ -679,6 +721,7 @@ type (
 		File       string          `json:"file" yaml:"file"`
 		Freeze     bool            `json:"freeze" yaml:"freeze"`
 		UpdatedAt  time.Time       `json:"updated_at" yaml:"updated_at"`
+		Author     string          `json:"author" yaml:"author"`
 		Ctx        context.Context `json:"-" yaml:"-"`
 		Err        chan error      `json:"-" yaml:"-"`
 	}
@@ -751,6 +794,14 @@ func (e *ArbitratorError) Kind() string {
 	return "ArbitratorError"
 }
 
+func (e *CheckThresholdBreached) Kind() string {
+	return "CheckThresholdBreached"
+}
+
+func (e *CheckThresholdCleared) Kind() string {
+	return "CheckThresholdCleared"
+}
+
 func (e *ClusterConfigUpdated) Kind() string {
 	return "ClusterConfigUpdated"
 }
@@ -787,6 +838,10 @@ func (e *DaemonCtl) Kind() string {
 	return "DaemonCtl"
 }
 
+func (e *DaemonCollectorUpdated) Kind() string {
+	return "DaemonCollectorUpdated"
+}
+
 func (e *DaemonHb) Kind() string {
 	return "DaemonHb"
 }
@@ -937,6 +992,10 @@ func (e *Log) Kind() string {
 	return "Log"
 }
 
+func (e *NodeChecksPost) Kind() string {
+	return "NodeChecksPost"
+}
+
 func (e *NodeConfigUpdated) Kind() string {
 	return "NodeConfigUpdated"
 }
@@ -96,6 +96,8 @@ func Start(ctx context.Context, p naming.Path, cfg instance.Config, discoverCmdC
 			Priority:        cfg.Priority,
 			Size:            cfg.Size,
 			Topology:        cfg.Topology,
+			CertNotAfter:    cfg.CertNotAfter,
+			CertExpireDays:  cfg.CertExpireDays,
 		},
 		path:         p,
 		id:           id,
@@ -233,6 +235,8 @@ func (t *Manager) worker() {
 				t.status.Priority = c.Value.Priority
 				t.status.Size = c.Value.Size
 				t.status.Topology = c.Value.Topology
+				t.status.CertNotAfter = c.Value.CertNotAfter
+				t.status.CertExpireDays = c.Value.CertExpireDays
 				t.srcEvent = c
 
 				t.instConfig[c.Node] = c.Value
@@ -18,11 +18,18 @@ import (
 )
 
 func FetchObjectConfigFile(cli *client.T, p naming.Path) (filename string, updated time.Time, err error) {
+	filename, updated, _, err = FetchObjectConfigFileWithAuthor(cli, p)
+	return
+}
+
+// FetchObjectConfigFileWithAuthor is like FetchObjectConfigFile, and also
+// returns the author of the fetched config revision, if known by the peer.
+func FetchObjectConfigFileWithAuthor(cli *client.T, p naming.Path) (filename string, updated time.Time, author string, err error) {
 	var (
 		b       []byte
 		tmpFile *os.File
 	)
-	b, updated, err = fetchFromAPI(cli, p)
+	b, updated, author, err = fetchFromAPI(cli, p)
 	if err != nil {
 		return
 	}
@@ -53,7 +60,7 @@ func FetchObjectConfigFile(cli *client.T, p naming.Path) (filename string, updat
 	return
 }
 
-func fetchFromAPI(cli *client.T, p naming.Path) (b []byte, updated time.Time, err error) {
+func fetchFromAPI(cli *client.T, p naming.Path) (b []byte, updated time.Time, author string, err error) {
 	var (
 		resp *api.GetObjectConfigFileResponse
 	)
@@ -64,5 +71,8 @@ func fetchFromAPI(cli *client.T, p naming.Path) (b []byte, updated time.Time, er
 		err = fmt.Errorf("unexpected get object file %s status %s", p, resp.Status())
 		return
 	}
-	return resp.JSON200.Data, resp.JSON200.Mtime, nil
+	if resp.JSON200.Author != nil {
+		author = *resp.JSON200.Author
+	}
+	return resp.JSON200.Data, resp.JSON200.Mtime, author, nil
 }
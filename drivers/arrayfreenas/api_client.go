@@ -1095,6 +1095,21 @@ type ClientInterface interface {
 	// DeleteDataset request
 	DeleteDataset(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// CreateSnapshot request
+	CreateSnapshot(ctx context.Context, body CreateSnapshotJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSnapshots request
+	GetSnapshots(ctx context.Context, params *GetSnapshotsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteSnapshot request
+	DeleteSnapshot(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RollbackSnapshot request
+	RollbackSnapshot(ctx context.Context, body RollbackSnapshotJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CloneSnapshot request
+	CloneSnapshot(ctx context.Context, body CloneSnapshotJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetDataset request
 	GetDataset(ctx context.Context, id string, params *GetDatasetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -3725,6 +3740,21 @@ type ClientWithResponsesInterface interface {
 	// DeleteDataset request
 	DeleteDatasetWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteDatasetResponse, error)
 
+	// CreateSnapshot request
+	CreateSnapshotWithResponse(ctx context.Context, body CreateSnapshotJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSnapshotResponse, error)
+
+	// GetSnapshots request
+	GetSnapshotsWithResponse(ctx context.Context, params *GetSnapshotsParams, reqEditors ...RequestEditorFn) (*GetSnapshotsResponse, error)
+
+	// DeleteSnapshot request
+	DeleteSnapshotWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteSnapshotResponse, error)
+
+	// RollbackSnapshot request
+	RollbackSnapshotWithResponse(ctx context.Context, body RollbackSnapshotJSONRequestBody, reqEditors ...RequestEditorFn) (*RollbackSnapshotResponse, error)
+
+	// CloneSnapshot request
+	CloneSnapshotWithResponse(ctx context.Context, body CloneSnapshotJSONRequestBody, reqEditors ...RequestEditorFn) (*CloneSnapshotResponse, error)
+
 	// GetDataset request
 	GetDatasetWithResponse(ctx context.Context, id string, params *GetDatasetParams, reqEditors ...RequestEditorFn) (*GetDatasetResponse, error)
 
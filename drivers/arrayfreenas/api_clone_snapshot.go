@@ -0,0 +1,110 @@
+package arrayfreenas
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// CloneSnapshotParams defines model for CloneSnapshotParams.
+type CloneSnapshotParams struct {
+	Snapshot   string `json:"snapshot"`
+	DatasetDst string `json:"dataset_dst"`
+}
+
+// CloneSnapshotJSONRequestBody defines body for CloneSnapshot for application/json ContentType.
+type CloneSnapshotJSONRequestBody = CloneSnapshotParams
+
+type CloneSnapshotResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r CloneSnapshotResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CloneSnapshotResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CloneSnapshotWithResponse request returning *CloneSnapshotResponse
+func (c *ClientWithResponses) CloneSnapshotWithResponse(ctx context.Context, body CloneSnapshotJSONRequestBody, reqEditors ...RequestEditorFn) (*CloneSnapshotResponse, error) {
+	rsp, err := c.CloneSnapshot(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCloneSnapshotResponse(rsp)
+}
+
+// ParseCloneSnapshotResponse parses an HTTP response from a CloneSnapshotWithResponse call
+func ParseCloneSnapshotResponse(rsp *http.Response) (*CloneSnapshotResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CloneSnapshotResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// NewCloneSnapshotRequest generates requests for CloneSnapshot
+func NewCloneSnapshotRequest(server string, body CloneSnapshotJSONRequestBody) (*http.Request, error) {
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/zfs/snapshot/clone")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), bytes.NewReader(buf))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", "application/json")
+
+	return req, nil
+}
+
+func (c *Client) CloneSnapshot(ctx context.Context, body CloneSnapshotJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCloneSnapshotRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
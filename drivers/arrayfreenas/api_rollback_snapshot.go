@@ -0,0 +1,111 @@
+package arrayfreenas
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// RollbackSnapshotParams defines model for RollbackSnapshotParams.
+type RollbackSnapshotParams struct {
+	Id        string `json:"id"`
+	Recursive *bool  `json:"recursive,omitempty"`
+	Force     *bool  `json:"force,omitempty"`
+}
+
+// RollbackSnapshotJSONRequestBody defines body for RollbackSnapshot for application/json ContentType.
+type RollbackSnapshotJSONRequestBody = RollbackSnapshotParams
+
+type RollbackSnapshotResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r RollbackSnapshotResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RollbackSnapshotResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// RollbackSnapshotWithResponse request returning *RollbackSnapshotResponse
+func (c *ClientWithResponses) RollbackSnapshotWithResponse(ctx context.Context, body RollbackSnapshotJSONRequestBody, reqEditors ...RequestEditorFn) (*RollbackSnapshotResponse, error) {
+	rsp, err := c.RollbackSnapshot(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRollbackSnapshotResponse(rsp)
+}
+
+// ParseRollbackSnapshotResponse parses an HTTP response from a RollbackSnapshotWithResponse call
+func ParseRollbackSnapshotResponse(rsp *http.Response) (*RollbackSnapshotResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RollbackSnapshotResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// NewRollbackSnapshotRequest generates requests for RollbackSnapshot
+func NewRollbackSnapshotRequest(server string, body RollbackSnapshotJSONRequestBody) (*http.Request, error) {
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/zfs/snapshot/rollback")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), bytes.NewReader(buf))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", "application/json")
+
+	return req, nil
+}
+
+func (c *Client) RollbackSnapshot(ctx context.Context, body RollbackSnapshotJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRollbackSnapshotRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
@@ -749,6 +749,110 @@ func (t Array) DeleteDataset(id string) (*Dataset, error) {
 	return nil, fmt.Errorf("%s", r.Status())
 }
 
+func (t Array) CreateSnapshot(dataset, name string) (*Snapshot, error) {
+	client, err := t.client()
+	if err != nil {
+		return nil, err
+	}
+	params := CreateSnapshotParams{
+		Dataset: dataset,
+		Name:    name,
+	}
+	r, err := client.CreateSnapshotWithResponse(context.Background(), params)
+	if err != nil {
+		return nil, err
+	}
+	if r.JSON200 != nil {
+		return r.JSON200, nil
+	}
+	if err := parseRespError(r.Body); err != nil {
+		return nil, err
+	}
+	return nil, fmt.Errorf("%s", string(r.Body))
+}
+
+func (t Array) GetSnapshots(dataset string) (Snapshots, error) {
+	client, err := t.client()
+	if err != nil {
+		return nil, err
+	}
+	params := &GetSnapshotsParams{
+		Dataset: &dataset,
+	}
+	r, err := client.GetSnapshotsWithResponse(context.Background(), params)
+	if err != nil {
+		return nil, err
+	}
+	if r.StatusCode() == http.StatusOK {
+		return r.JSON200, nil
+	}
+	if err := parseRespError(r.Body); err != nil {
+		return nil, err
+	}
+	return nil, fmt.Errorf("%s", r.Status())
+}
+
+func (t Array) DeleteSnapshot(id string) error {
+	client, err := t.client()
+	if err != nil {
+		return err
+	}
+	r, err := client.DeleteSnapshotWithResponse(context.Background(), id)
+	if err != nil {
+		return err
+	}
+	if r.StatusCode() == http.StatusOK {
+		return nil
+	}
+	if err := parseRespError(r.Body); err != nil {
+		return err
+	}
+	return fmt.Errorf("%s", r.Status())
+}
+
+func (t Array) RollbackSnapshot(id string) error {
+	client, err := t.client()
+	if err != nil {
+		return err
+	}
+	params := RollbackSnapshotParams{
+		Id: id,
+	}
+	r, err := client.RollbackSnapshotWithResponse(context.Background(), params)
+	if err != nil {
+		return err
+	}
+	if r.StatusCode() == http.StatusOK {
+		return nil
+	}
+	if err := parseRespError(r.Body); err != nil {
+		return err
+	}
+	return fmt.Errorf("%s", r.Status())
+}
+
+func (t Array) CloneSnapshot(id, dst string) error {
+	client, err := t.client()
+	if err != nil {
+		return err
+	}
+	params := CloneSnapshotParams{
+		Snapshot:   id,
+		DatasetDst: dst,
+	}
+	r, err := client.CloneSnapshotWithResponse(context.Background(), params)
+	if err != nil {
+		return err
+	}
+	if r.StatusCode() == http.StatusOK {
+		return nil
+	}
+	if err := parseRespError(r.Body); err != nil {
+		return err
+	}
+	return fmt.Errorf("%s", r.Status())
+}
+
 func (t Array) GetPools() ([]Pool, error) {
 	client, err := t.client()
 	if err != nil {
@@ -0,0 +1,127 @@
+package chkdrbd
+
+import (
+	"fmt"
+
+	"github.com/opensvc/om3/core/check"
+	"github.com/opensvc/om3/core/driver"
+	"github.com/opensvc/om3/util/drbd"
+	"github.com/opensvc/om3/util/hostname"
+)
+
+const (
+	// DriverGroup is the type of check driver.
+	DriverGroup = "drbd"
+	// DriverName is the name of check driver.
+	DriverName = "drbdadm"
+)
+
+type (
+	drbdChecker struct{}
+)
+
+var (
+	resDrvID = driver.NewID(driver.GroupDisk, "drbd")
+)
+
+func init() {
+	check.Register(&drbdChecker{})
+}
+
+// isSyncing returns true if the connection state is a resync in progress.
+func isSyncing(cstate string) bool {
+	switch cstate {
+	case "SyncSource", "SyncTarget", "PausedSyncS", "PausedSyncT", "VerifyS", "VerifyT":
+		return true
+	default:
+		return false
+	}
+}
+
+// connResult returns the connection state check result: ok if connected,
+// warn if resyncing, nok otherwise.
+func connResult(res, cstate string) check.Result {
+	result := check.Result{
+		Instance:    res + ".cstate",
+		Unit:        "disconnected",
+		DriverGroup: DriverGroup,
+		DriverName:  DriverName,
+		Status:      check.StatusOk,
+	}
+	switch {
+	case cstate == "Connected":
+	case isSyncing(cstate):
+		result.Status = check.StatusWarn
+		result.Hint = fmt.Sprintf("drbd %s is %s", res, cstate)
+	default:
+		result.Value = 1
+		result.Status = check.StatusNok
+		result.Hint = fmt.Sprintf("drbd %s is %s, check the peers with 'drbdadm status %s'", res, cstate, res)
+	}
+	return result
+}
+
+// diskResult returns the disk states check result: ok if all disks are
+// up to date, warn if resyncing, nok otherwise.
+func diskResult(res, cstate string, dstates []string) check.Result {
+	result := check.Result{
+		Instance:    res + ".dstate",
+		Unit:        "outdated",
+		DriverGroup: DriverGroup,
+		DriverName:  DriverName,
+		Status:      check.StatusOk,
+	}
+	for _, dstate := range dstates {
+		if dstate != "UpToDate" {
+			result.Value++
+		}
+	}
+	switch {
+	case result.Value == 0:
+	case isSyncing(cstate):
+		result.Status = check.StatusWarn
+		result.Hint = fmt.Sprintf("drbd %s disks are resyncing", res)
+	default:
+		result.Status = check.StatusNok
+		result.Hint = fmt.Sprintf("drbd %s disks are %v, check the disks with 'drbdadm status %s'", res, dstates, res)
+	}
+	return result
+}
+
+func (t *drbdChecker) ResultSet(res string, objs []interface{}) *check.ResultSet {
+	rs := check.NewResultSet()
+	dev := drbd.New(res)
+	cstate, err := dev.ConnState()
+	if err != nil {
+		return rs
+	}
+	dstates, err := dev.DiskStates()
+	if err != nil {
+		return rs
+	}
+	path := check.ObjectPathClaimingResource(res, objs, resDrvID)
+	for _, result := range []check.Result{connResult(res, cstate), diskResult(res, cstate, dstates)} {
+		result.Path = path
+		rs.Push(result)
+	}
+	return rs
+}
+
+func (t *drbdChecker) Check(objs []interface{}) (*check.ResultSet, error) {
+	rs := check.NewResultSet()
+	if !drbd.IsCapable() {
+		return rs, nil
+	}
+	config, err := drbd.GetConfig()
+	if err != nil {
+		return rs, err
+	}
+	hn := hostname.Hostname()
+	for _, resource := range config.Resources {
+		if _, ok := resource.GetHost(hn); !ok {
+			continue
+		}
+		rs.Add(t.ResultSet(resource.Name, objs))
+	}
+	return rs, nil
+}
@@ -0,0 +1,35 @@
+package chkdrbd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/opensvc/om3/core/check"
+)
+
+func TestResults(t *testing.T) {
+	cases := map[string]struct {
+		cstate      string
+		dstates     []string
+		connStatus  string
+		diskStatus  string
+		diskOutdate int64
+	}{
+		"healthy":      {"Connected", []string{"UpToDate", "UpToDate"}, check.StatusOk, check.StatusOk, 0},
+		"resyncing":    {"SyncTarget", []string{"Inconsistent", "UpToDate"}, check.StatusWarn, check.StatusWarn, 1},
+		"standalone":   {"StandAlone", []string{"UpToDate", "DUnknown"}, check.StatusNok, check.StatusNok, 1},
+		"disconnected": {"WFConnection", []string{"Outdated", "DUnknown"}, check.StatusNok, check.StatusNok, 2},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			conn := connResult("r1", c.cstate)
+			assert.Equal(t, "r1.cstate", conn.Instance)
+			assert.Equal(t, c.connStatus, conn.Status)
+			disk := diskResult("r1", c.cstate, c.dstates)
+			assert.Equal(t, "r1.dstate", disk.Instance)
+			assert.Equal(t, c.diskStatus, disk.Status)
+			assert.Equal(t, c.diskOutdate, disk.Value)
+		})
+	}
+}
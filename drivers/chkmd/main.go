@@ -0,0 +1,70 @@
+package chkmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/opensvc/om3/core/check"
+	"github.com/opensvc/om3/util/md"
+)
+
+const (
+	// DriverGroup is the type of check driver.
+	DriverGroup = "raid"
+	// DriverName is the name of check driver.
+	DriverName = "md"
+)
+
+type (
+	mdChecker struct{}
+)
+
+func init() {
+	check.Register(&mdChecker{})
+}
+
+// status returns the check status of the array: nok if degraded, warn if
+// degraded but recovering or if a member is faulty, ok otherwise.
+func status(array md.MdstatArray) (string, string) {
+	switch {
+	case array.State != "active":
+		return check.StatusNok, fmt.Sprintf("md %s is %s, assemble with 'mdadm --assemble --scan'", array.Name, array.State)
+	case array.Degraded() > 0 && array.Action != "":
+		return check.StatusWarn, fmt.Sprintf("md %s is degraded, %s in progress", array.Name, array.Action)
+	case array.Degraded() > 0:
+		return check.StatusNok, fmt.Sprintf("md %s is degraded, replace the missing devices with 'mdadm --manage /dev/%s --add <dev>'", array.Name, array.Name)
+	case array.FailedDevices > 0:
+		return check.StatusWarn, fmt.Sprintf("md %s has faulty devices, remove them with 'mdadm --manage /dev/%s --remove failed'", array.Name, array.Name)
+	default:
+		return check.StatusOk, ""
+	}
+}
+
+func (t *mdChecker) ResultSet(array md.MdstatArray, objs []interface{}) *check.ResultSet {
+	rs := check.NewResultSet()
+	result := check.Result{
+		Instance:    array.Name,
+		Value:       int64(array.Degraded()),
+		Path:        check.ObjectPathClaimingDevice(objs, "/dev/"+array.Name),
+		Unit:        "missing",
+		DriverGroup: DriverGroup,
+		DriverName:  DriverName,
+	}
+	result.Status, result.Hint = status(array)
+	rs.Push(result)
+	return rs
+}
+
+func (t *mdChecker) Check(objs []interface{}) (*check.ResultSet, error) {
+	rs := check.NewResultSet()
+	l, err := md.ListMdstatArrays()
+	if os.IsNotExist(err) {
+		return rs, nil
+	} else if err != nil {
+		return rs, err
+	}
+	for _, array := range l {
+		rs.Add(t.ResultSet(array, objs))
+	}
+	return rs, nil
+}
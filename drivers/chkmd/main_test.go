@@ -0,0 +1,33 @@
+package chkmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/opensvc/om3/core/check"
+	"github.com/opensvc/om3/util/md"
+)
+
+func TestResultSet(t *testing.T) {
+	c := &mdChecker{}
+	cases := map[string]struct {
+		array  md.MdstatArray
+		status string
+		value  int64
+	}{
+		"healthy":    {md.MdstatArray{Name: "md0", State: "active", Devices: 2, ActiveDevices: 2}, check.StatusOk, 0},
+		"recovering": {md.MdstatArray{Name: "md0", State: "active", Devices: 2, ActiveDevices: 1, Action: "recovery"}, check.StatusWarn, 1},
+		"degraded":   {md.MdstatArray{Name: "md0", State: "active", Devices: 3, ActiveDevices: 1}, check.StatusNok, 2},
+		"faulty":     {md.MdstatArray{Name: "md0", State: "active", Devices: 2, ActiveDevices: 2, FailedDevices: 1}, check.StatusWarn, 0},
+		"inactive":   {md.MdstatArray{Name: "md0", State: "inactive"}, check.StatusNok, 0},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			rs := c.ResultSet(tc.array, []interface{}{})
+			assert.Len(t, rs.Data, 1)
+			assert.Equal(t, tc.status, rs.Data[0].Status)
+			assert.Equal(t, tc.value, rs.Data[0].Value)
+		})
+	}
+}
@@ -0,0 +1,119 @@
+//go:build linux
+
+package chkmem
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/procfs"
+
+	"github.com/opensvc/om3/core/check"
+	"github.com/opensvc/om3/util/key"
+	"github.com/opensvc/om3/util/sizeconv"
+)
+
+const (
+	// DriverGroup is the type of check driver.
+	DriverGroup = "mem_u"
+	// DriverName is the name of check driver.
+	DriverName = "procfs"
+
+	defaultMinAvailMem  = "2%"
+	defaultMinAvailSwap = "10%"
+)
+
+type (
+	memChecker struct{}
+)
+
+var (
+	keyMinAvailMem  = key.New("node", "min_avail_mem")
+	keyMinAvailSwap = key.New("node", "min_avail_swap")
+)
+
+func init() {
+	check.Register(&memChecker{})
+}
+
+// minAvailPercent converts a min_avail_mem or min_avail_swap value to a
+// percentage of the total bytes. The value is either a percentage, like
+// "10%", or a size, like "1g".
+func minAvailPercent(s string, total uint64) (uint64, error) {
+	s = strings.TrimSpace(s)
+	if strings.HasSuffix(s, "%") {
+		return strconv.ParseUint(strings.TrimSpace(strings.TrimSuffix(s, "%")), 10, 64)
+	}
+	size, err := sizeconv.FromSize(s)
+	if err != nil {
+		return 0, err
+	}
+	if total == 0 {
+		return 0, nil
+	}
+	return uint64(size) * 100 / total, nil
+}
+
+// ResultSet returns the available and total results of the memory or the
+// swap, with avail and total in kb. The available result is nok if lower
+// than the minimum.
+func (t *memChecker) ResultSet(name string, avail, total uint64, minAvail string) *check.ResultSet {
+	rs := check.NewResultSet()
+	var availPercent uint64
+	if total > 0 {
+		availPercent = 100 * avail / total
+	}
+	result := check.Result{
+		Instance:    name + ".avail",
+		Value:       int64(availPercent),
+		Unit:        "%",
+		DriverGroup: DriverGroup,
+		DriverName:  DriverName,
+		Status:      check.StatusOk,
+	}
+	if minPercent, err := minAvailPercent(minAvail, total*1024); err != nil {
+		result.Status = check.StatusNA
+		result.Hint = fmt.Sprintf("invalid %s min avail value %s: %s", name, minAvail, err)
+	} else if total > 0 && availPercent < minPercent {
+		result.Status = check.StatusNok
+		result.Hint = fmt.Sprintf("%s available %d%% is lower than the %s minimum", name, availPercent, minAvail)
+	}
+	rs.Push(result)
+	rs.Push(check.Result{
+		Instance:    name + ".total",
+		Value:       int64(total),
+		Unit:        "kb",
+		DriverGroup: DriverGroup,
+		DriverName:  DriverName,
+	})
+	return rs
+}
+
+func (t *memChecker) Check(objs []interface{}) (*check.ResultSet, error) {
+	rs := check.NewResultSet()
+	minAvailMem, minAvailSwap := defaultMinAvailMem, defaultMinAvailSwap
+	if config := check.NodeConfig(objs); config != nil {
+		if s := config.GetString(keyMinAvailMem); s != "" {
+			minAvailMem = s
+		}
+		if s := config.GetString(keyMinAvailSwap); s != "" {
+			minAvailSwap = s
+		}
+	}
+	fs, err := procfs.NewDefaultFS()
+	if err != nil {
+		return rs, err
+	}
+	mem, err := fs.Meminfo()
+	if err != nil {
+		return rs, err
+	}
+	if mem.MemTotal != nil && mem.MemAvailable != nil {
+		rs.Add(t.ResultSet("mem", *mem.MemAvailable, *mem.MemTotal, minAvailMem))
+	}
+	if mem.SwapTotal != nil && mem.SwapFree != nil && *mem.SwapTotal > 0 {
+		rs.Add(t.ResultSet("swap", *mem.SwapFree, *mem.SwapTotal, minAvailSwap))
+	}
+	return rs, nil
+}
@@ -0,0 +1,39 @@
+//go:build linux
+
+package chkmem
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/core/check"
+)
+
+func TestMinAvailPercent(t *testing.T) {
+	v, err := minAvailPercent("10%", 8*1024*1024*1024)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), v)
+
+	v, err = minAvailPercent("2g", 8*1024*1024*1024)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(25), v)
+
+	_, err = minAvailPercent("foo", 8*1024*1024*1024)
+	assert.Error(t, err)
+}
+
+func TestResultSet(t *testing.T) {
+	c := &memChecker{}
+	rs := c.ResultSet("mem", 100*1024, 8*1024*1024, "2%")
+	require.Len(t, rs.Data, 2)
+	assert.Equal(t, "mem.avail", rs.Data[0].Instance)
+	assert.Equal(t, int64(1), rs.Data[0].Value)
+	assert.Equal(t, check.StatusNok, rs.Data[0].Status)
+	assert.NotEmpty(t, rs.Data[0].Hint)
+	assert.Equal(t, "mem.total", rs.Data[1].Instance)
+
+	rs = c.ResultSet("swap", 4*1024*1024, 8*1024*1024, "10%")
+	assert.Equal(t, check.StatusOk, rs.Data[0].Status)
+}
@@ -0,0 +1,61 @@
+//go:build linux
+
+package chkmpath
+
+import (
+	"fmt"
+
+	"github.com/opensvc/om3/core/check"
+	"github.com/opensvc/om3/util/scsi"
+)
+
+const (
+	// DriverGroup is the type of check driver.
+	DriverGroup = "mpath"
+	// DriverName is the name of check driver.
+	DriverName = "scsi"
+)
+
+type (
+	mpathChecker struct{}
+)
+
+func init() {
+	check.Register(&mpathChecker{})
+}
+
+func (t *mpathChecker) ResultSet(dev scsi.MultipathDevice, objs []interface{}) *check.ResultSet {
+	rs := check.NewResultSet()
+	running := dev.RunningPaths()
+	result := check.Result{
+		Instance:    dev.WWID,
+		Value:       int64(running),
+		Path:        check.ObjectPathClaimingDevice(objs, dev.Dev, "/dev/mapper/"+dev.Name),
+		Unit:        "paths",
+		DriverGroup: DriverGroup,
+		DriverName:  DriverName,
+		Status:      check.StatusOk,
+	}
+	switch {
+	case running == 0:
+		result.Status = check.StatusNok
+		result.Hint = fmt.Sprintf("no running path to %s, check the san with 'multipath -ll %s'", dev.Name, dev.WWID)
+	case running < len(dev.Paths):
+		result.Status = check.StatusWarn
+		result.Hint = fmt.Sprintf("%d/%d running paths to %s, check the san with 'multipath -ll %s'", running, len(dev.Paths), dev.Name, dev.WWID)
+	}
+	rs.Push(result)
+	return rs
+}
+
+func (t *mpathChecker) Check(objs []interface{}) (*check.ResultSet, error) {
+	rs := check.NewResultSet()
+	l, err := scsi.ListMultipathDevices()
+	if err != nil {
+		return rs, err
+	}
+	for _, dev := range l {
+		rs.Add(t.ResultSet(dev, objs))
+	}
+	return rs, nil
+}
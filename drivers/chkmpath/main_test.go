@@ -0,0 +1,35 @@
+package chkmpath
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/opensvc/om3/core/check"
+	"github.com/opensvc/om3/util/scsi"
+)
+
+func TestResultSet(t *testing.T) {
+	c := &mpathChecker{}
+	running := scsi.MultipathPath{Dev: "/dev/sdb", State: "running"}
+	offline := scsi.MultipathPath{Dev: "/dev/sdc", State: "offline"}
+	cases := map[string]struct {
+		paths  []scsi.MultipathPath
+		status string
+		value  int64
+	}{
+		"healthy":  {[]scsi.MultipathPath{running, running}, check.StatusOk, 2},
+		"degraded": {[]scsi.MultipathPath{running, offline}, check.StatusWarn, 1},
+		"down":     {[]scsi.MultipathPath{offline, offline}, check.StatusNok, 0},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			dev := scsi.MultipathDevice{Name: "mpatha", Dev: "/dev/dm-1", WWID: "3600a0980383030", Paths: tc.paths}
+			rs := c.ResultSet(dev, []interface{}{})
+			assert.Len(t, rs.Data, 1)
+			assert.Equal(t, "3600a0980383030", rs.Data[0].Instance)
+			assert.Equal(t, tc.status, rs.Data[0].Status)
+			assert.Equal(t, tc.value, rs.Data[0].Value)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+//go:build linux
+
+package chkvg
+
+import (
+	"github.com/opensvc/om3/core/check"
+	"github.com/opensvc/om3/core/driver"
+	"github.com/opensvc/om3/util/lvm2"
+)
+
+const (
+	// DriverGroup is the type of check driver.
+	DriverGroup = "vg_u"
+	// DriverName is the name of check driver.
+	DriverName = "lvm2"
+)
+
+type (
+	vgChecker struct{}
+)
+
+var (
+	resDrvIDs = []driver.ID{
+		driver.NewID(driver.GroupDisk, "vg"),
+		driver.NewID(driver.GroupDisk, "lvm"),
+	}
+)
+
+func init() {
+	check.Register(&vgChecker{})
+}
+
+func (t *vgChecker) ResultSet(info lvm2.VGInfo, objs []interface{}) *check.ResultSet {
+	rs := check.NewResultSet()
+	size, err := info.Size()
+	if err != nil {
+		return rs
+	}
+	free, err := info.Free()
+	if err != nil {
+		return rs
+	}
+	var usedPercent int64
+	if size > 0 {
+		usedPercent = 100 * (size - free) / size
+	}
+	path := check.ObjectPathClaimingResource(info.VGName, objs, resDrvIDs...)
+	rs.Push(check.Result{
+		Instance:    info.VGName,
+		Value:       usedPercent,
+		Path:        path,
+		Unit:        "%",
+		DriverGroup: DriverGroup,
+		DriverName:  DriverName,
+	})
+	rs.Push(check.Result{
+		Instance:    info.VGName + ".free",
+		Value:       free / 1024,
+		Path:        path,
+		Unit:        "kb",
+		DriverGroup: DriverGroup,
+		DriverName:  DriverName,
+	})
+	rs.Push(check.Result{
+		Instance:    info.VGName + ".size",
+		Value:       size / 1024,
+		Path:        path,
+		Unit:        "kb",
+		DriverGroup: DriverGroup,
+		DriverName:  DriverName,
+	})
+	return rs
+}
+
+func (t *vgChecker) Check(objs []interface{}) (*check.ResultSet, error) {
+	rs := check.NewResultSet()
+	if !lvm2.IsCapable() {
+		return rs, nil
+	}
+	l, err := lvm2.ListVGs()
+	if err != nil {
+		return rs, err
+	}
+	for _, info := range l {
+		rs.Add(t.ResultSet(info, objs))
+	}
+	return rs, nil
+}
@@ -0,0 +1,27 @@
+package chkvg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/opensvc/om3/util/lvm2"
+)
+
+func TestResultSet(t *testing.T) {
+	c := &vgChecker{}
+	rs := c.ResultSet(lvm2.VGInfo{VGName: "data", VGSize: "10737418240", VGFree: "2684354560"}, []interface{}{})
+	assert.Len(t, rs.Data, 3)
+	values := make(map[string]int64)
+	for _, r := range rs.Data {
+		values[r.Instance] = r.Value
+	}
+	assert.Equal(t, map[string]int64{
+		"data":      75,
+		"data.free": 2621440,
+		"data.size": 10485760,
+	}, values)
+
+	rs = c.ResultSet(lvm2.VGInfo{VGName: "data", VGSize: "bad", VGFree: "0"}, []interface{}{})
+	assert.Len(t, rs.Data, 0, "unparsable size")
+}
@@ -0,0 +1,99 @@
+package chkzpool
+
+import (
+	"fmt"
+
+	"github.com/opensvc/om3/core/check"
+	"github.com/opensvc/om3/core/driver"
+	"github.com/opensvc/om3/util/zfs"
+)
+
+const (
+	// DriverGroup is the type of check driver.
+	DriverGroup = "zpool"
+	// DriverName is the name of check driver.
+	DriverName = "zfs"
+)
+
+type (
+	zpoolChecker struct{}
+)
+
+var (
+	resDrvID = driver.NewID(driver.GroupDisk, "zpool")
+)
+
+func init() {
+	check.Register(&zpoolChecker{})
+}
+
+// healthStatus returns the check status of the pool health: ok if online,
+// warn if degraded, nok if faulted, unavailable, suspended, ...
+func healthStatus(health string) string {
+	switch health {
+	case "ONLINE":
+		return check.StatusOk
+	case "DEGRADED":
+		return check.StatusWarn
+	default:
+		return check.StatusNok
+	}
+}
+
+func (t *zpoolChecker) ResultSet(pool zfs.PoolListItem, objs []interface{}) *check.ResultSet {
+	path := check.ObjectPathClaimingResource(pool.Name, objs, resDrvID)
+	rs := check.NewResultSet()
+	health := check.Result{
+		Instance:    pool.Name + ".health",
+		Path:        path,
+		Unit:        "faulted",
+		DriverGroup: DriverGroup,
+		DriverName:  DriverName,
+		Status:      healthStatus(pool.Health),
+	}
+	if health.Status != check.StatusOk {
+		health.Value = 1
+		health.Hint = fmt.Sprintf("pool %s is %s, check the vdevs with 'zpool status -x %s'", pool.Name, pool.Health, pool.Name)
+	}
+	rs.Push(health)
+	rs.Push(check.Result{
+		Instance:    pool.Name,
+		Value:       pool.UsedPercent(),
+		Path:        path,
+		Unit:        "%",
+		DriverGroup: DriverGroup,
+		DriverName:  DriverName,
+	})
+	rs.Push(check.Result{
+		Instance:    pool.Name + ".free",
+		Value:       pool.Free / 1024,
+		Path:        path,
+		Unit:        "kb",
+		DriverGroup: DriverGroup,
+		DriverName:  DriverName,
+	})
+	rs.Push(check.Result{
+		Instance:    pool.Name + ".size",
+		Value:       pool.Size / 1024,
+		Path:        path,
+		Unit:        "kb",
+		DriverGroup: DriverGroup,
+		DriverName:  DriverName,
+	})
+	return rs
+}
+
+func (t *zpoolChecker) Check(objs []interface{}) (*check.ResultSet, error) {
+	rs := check.NewResultSet()
+	if !zfs.IsCapable() {
+		return rs, nil
+	}
+	l, err := zfs.ListPools()
+	if err != nil {
+		return rs, err
+	}
+	for _, pool := range l {
+		rs.Add(t.ResultSet(pool, objs))
+	}
+	return rs, nil
+}
@@ -0,0 +1,37 @@
+package chkzpool
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/opensvc/om3/core/check"
+	"github.com/opensvc/om3/util/zfs"
+)
+
+func TestResultSet(t *testing.T) {
+	c := &zpoolChecker{}
+	cases := map[string]struct {
+		health string
+		status string
+		value  int64
+	}{
+		"online":   {"ONLINE", check.StatusOk, 0},
+		"degraded": {"DEGRADED", check.StatusWarn, 1},
+		"faulted":  {"FAULTED", check.StatusNok, 1},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			pool := zfs.PoolListItem{Name: "tank", Size: 107374182400, Alloc: 96636764160, Free: 10737418240, Health: tc.health}
+			rs := c.ResultSet(pool, []interface{}{})
+			assert.Len(t, rs.Data, 4)
+			assert.Equal(t, "tank.health", rs.Data[0].Instance)
+			assert.Equal(t, tc.status, rs.Data[0].Status)
+			assert.Equal(t, tc.value, rs.Data[0].Value)
+			assert.Equal(t, "tank", rs.Data[1].Instance)
+			assert.Equal(t, int64(90), rs.Data[1].Value)
+			assert.Equal(t, int64(10485760), rs.Data[2].Value)
+			assert.Equal(t, int64(104857600), rs.Data[3].Value)
+		})
+	}
+}
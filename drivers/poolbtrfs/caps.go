@@ -0,0 +1,21 @@
+//go:build linux
+
+package poolbtrfs
+
+import (
+	"github.com/opensvc/om3/core/driver"
+	"github.com/opensvc/om3/util/btrfs"
+	"github.com/opensvc/om3/util/capabilities"
+)
+
+func init() {
+	capabilities.Register(capabilitiesScanner)
+}
+
+func capabilitiesScanner() ([]string, error) {
+	volDrvID := driver.NewID(driver.GroupVolume, drvID.Name)
+	if btrfs.IsCapable() {
+		return []string{drvID.Cap(), volDrvID.Cap()}, nil
+	}
+	return []string{}, nil
+}
@@ -0,0 +1,87 @@
+//go:build linux
+
+package poolbtrfs
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/opensvc/om3/core/driver"
+	"github.com/opensvc/om3/core/pool"
+	"github.com/opensvc/om3/util/df"
+	"github.com/opensvc/om3/util/sizeconv"
+)
+
+type (
+	T struct {
+		pool.T
+	}
+)
+
+var (
+	drvID = driver.NewID(driver.GroupPool, "btrfs")
+)
+
+func init() {
+	driver.Register(drvID, NewPooler)
+}
+
+func NewPooler() pool.Pooler {
+	t := New()
+	var i interface{} = t
+	return i.(pool.Pooler)
+}
+
+func New() *T {
+	t := T{}
+	return &t
+}
+
+func (t T) Head() string {
+	return t.path()
+}
+
+func (t T) Capabilities() []string {
+	return []string{"rox", "rwx", "roo", "rwo"}
+}
+
+func (t T) Usage() (pool.Usage, error) {
+	entries, err := df.ContainingMountUsage(t.path())
+	if err != nil {
+		return pool.Usage{}, err
+	}
+	if len(entries) == 0 {
+		return pool.Usage{}, fmt.Errorf("not mounted")
+	}
+	usage := pool.Usage{
+		Size: entries[0].Total * 1024,
+		Free: entries[0].Free * 1024,
+		Used: entries[0].Used * 1024,
+	}
+	return usage, nil
+}
+
+// Translate returns the keywords of a fs resource mounting a new
+// subvolume of the pool, with its qgroup limited to <size>.
+func (t *T) Translate(name string, size int64, shared bool) ([]string, error) {
+	data := []string{
+		"fs#0.type=btrfs_subvol",
+		"fs#0.path=" + filepath.Join(t.path(), name),
+		"fs#0.mnt=" + pool.MountPointFromName(name),
+		"fs#0.size=" + sizeconv.ExactBSizeCompact(float64(size)),
+	}
+	if mntOpt := t.MntOptions(); mntOpt != "" {
+		data = append(data, "fs#0.mnt_opt="+mntOpt)
+	}
+	return data, nil
+}
+
+func (t T) path() string {
+	return t.GetString("path")
+}
+
+// ResizeVolume is a no-op: the subvolume qgroup limit is raised by the
+// btrfs_subvol fs resource.
+func (t T) ResizeVolume(volume string, size int64) error {
+	return nil
+}
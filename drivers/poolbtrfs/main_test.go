@@ -0,0 +1,63 @@
+//go:build linux
+
+package poolbtrfs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/util/key"
+)
+
+type testConfig map[string]string
+
+func (t testConfig) Eval(k key.T) (any, error) {
+	return t[k.String()], nil
+}
+
+func (t testConfig) GetString(k key.T) string {
+	return t[k.String()]
+}
+
+func (t testConfig) GetStringStrict(k key.T) (string, error) {
+	return t[k.String()], nil
+}
+
+func (t testConfig) GetStrings(k key.T) []string {
+	return nil
+}
+
+func (t testConfig) GetBool(k key.T) bool {
+	return false
+}
+
+func (t testConfig) GetSize(k key.T) *int64 {
+	return nil
+}
+
+func (t testConfig) HasSectionString(s string) bool {
+	return s == "pool#test"
+}
+
+func TestTranslate(t *testing.T) {
+	p := New()
+	p.SetName("test")
+	p.SetDriver("btrfs")
+	p.SetConfig(testConfig{"pool#test.path": "/srv/pool"})
+
+	kws, err := p.Translate("vol1.ns1.vol.cluster1", 2*1024*1024*1024, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"fs#0.type=btrfs_subvol",
+		"fs#0.path=/srv/pool/vol1.ns1.vol.cluster1",
+		"fs#0.mnt=/srv/vol1.ns1.vol.cluster1",
+		"fs#0.size=2g",
+	}, kws)
+
+	p.SetConfig(testConfig{"pool#test.path": "/srv/pool", "pool#test.mnt_opt": "compress=zstd"})
+	kws, err = p.Translate("vol1.ns1.vol.cluster1", 2*1024*1024*1024, false)
+	require.NoError(t, err)
+	assert.Contains(t, kws, "fs#0.mnt_opt=compress=zstd")
+}
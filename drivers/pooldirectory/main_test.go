@@ -0,0 +1,48 @@
+package pooldirectory
+
+import (
+	"testing"
+
+	"github.com/opensvc/om3/util/key"
+)
+
+type testConfig map[string]string
+
+func (t testConfig) Eval(k key.T) (any, error) {
+	return t[k.String()], nil
+}
+
+func (t testConfig) GetString(k key.T) string {
+	return t[k.String()]
+}
+
+func (t testConfig) GetStringStrict(k key.T) (string, error) {
+	return t[k.String()], nil
+}
+
+func (t testConfig) GetStrings(k key.T) []string {
+	return nil
+}
+
+func (t testConfig) GetBool(k key.T) bool {
+	return false
+}
+
+func (t testConfig) GetSize(k key.T) *int64 {
+	return nil
+}
+
+func (t testConfig) HasSectionString(s string) bool {
+	return s == "pool#test"
+}
+
+// newTestPool returns a directory pool storing its volumes in a temporary
+// directory.
+func newTestPool(t *testing.T) *T {
+	t.Helper()
+	p := New()
+	p.SetName("test")
+	p.SetDriver("directory")
+	p.SetConfig(testConfig{"pool#test.path": t.TempDir()})
+	return p
+}
@@ -0,0 +1,21 @@
+package pooldirectory
+
+import (
+	"os"
+)
+
+// ResizeVolume grows the loop file backing the <volume> block volume
+// to <size> bytes, if smaller. Formatted volumes are plain directories
+// sharing the pool filesystem space, so they have no size to change.
+func (t *T) ResizeVolume(volume string, size int64) error {
+	info, err := os.Stat(t.loopFile(volume))
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if info.Size() >= size {
+		return nil
+	}
+	return os.Truncate(t.loopFile(volume), size)
+}
@@ -0,0 +1,40 @@
+package pooldirectory
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResizeVolume(t *testing.T) {
+	p := newTestPool(t)
+
+	t.Run("grows the loop file", func(t *testing.T) {
+		loopFile := p.loopFile("blk1")
+		require.NoError(t, os.WriteFile(loopFile, []byte("data"), 0600))
+		require.NoError(t, p.ResizeVolume("blk1", 1024*1024))
+		info, err := os.Stat(loopFile)
+		require.NoError(t, err)
+		require.Equal(t, int64(1024*1024), info.Size())
+		b, err := os.ReadFile(loopFile)
+		require.NoError(t, err)
+		require.Equal(t, "data", string(b[:4]))
+	})
+
+	t.Run("does not shrink the loop file", func(t *testing.T) {
+		require.NoError(t, p.ResizeVolume("blk1", 1024))
+		info, err := os.Stat(p.loopFile("blk1"))
+		require.NoError(t, err)
+		require.Equal(t, int64(1024*1024), info.Size())
+	})
+
+	t.Run("is a no-op for formatted volumes", func(t *testing.T) {
+		volumeDir := filepath.Join(p.path(), "vol1")
+		require.NoError(t, os.MkdirAll(volumeDir, 0700))
+		require.NoError(t, p.ResizeVolume("vol1", 1024*1024))
+		require.NoFileExists(t, p.loopFile("vol1"))
+		require.DirExists(t, volumeDir)
+	})
+}
@@ -0,0 +1,182 @@
+package pooldirectory
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+
+	"github.com/rs/zerolog"
+
+	"github.com/opensvc/om3/core/pool"
+	"github.com/opensvc/om3/util/command"
+	"github.com/opensvc/om3/util/file"
+)
+
+// volumeData returns the path of the volume data, which is a directory
+// for formatted volumes or a loop file for block volumes.
+func (t *T) volumeData(volume string) (string, error) {
+	for _, p := range []string{filepath.Join(t.path(), volume), t.loopFile(volume)} {
+		if file.Exists(p) {
+			return p, nil
+		}
+	}
+	return "", fmt.Errorf("volume %s has no data in %s", volume, t.path())
+}
+
+func (t *T) snapshotsDir(volume string) string {
+	return filepath.Join(t.path(), ".snapshots", volume)
+}
+
+func (t *T) snapshotDir(volume, name string) string {
+	return filepath.Join(t.snapshotsDir(volume), name)
+}
+
+// snapshotData returns the path of the snapshot copy of the volume data.
+func (t *T) snapshotData(volume, name, data string) string {
+	return filepath.Join(t.snapshotDir(volume, name), filepath.Base(data))
+}
+
+// CreateSnapshot copies the volume data into the pool snapshots
+// directory. The copy uses reflinks when the filesystem supports them,
+// so it is fast and space efficient on btrfs or xfs.
+func (t *T) CreateSnapshot(volume, name string) error {
+	data, err := t.volumeData(volume)
+	if err != nil {
+		return err
+	}
+	dir := t.snapshotDir(volume, name)
+	if file.Exists(dir) {
+		return fmt.Errorf("snapshot %s of volume %s already exists", name, volume)
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	if err := copyData(data, t.snapshotData(volume, name, data)); err != nil {
+		_ = os.RemoveAll(dir)
+		return err
+	}
+	return nil
+}
+
+func (t *T) ListSnapshots(volume string) (pool.Snapshots, error) {
+	entries, err := os.ReadDir(t.snapshotsDir(volume))
+	if errors.Is(err, fs.ErrNotExist) {
+		return pool.Snapshots{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	snaps := make(pool.Snapshots, 0, len(entries))
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			return nil, err
+		}
+		snaps = append(snaps, pool.Snapshot{
+			Name:      e.Name(),
+			Volume:    volume,
+			CreatedAt: info.ModTime(),
+		})
+	}
+	sort.Slice(snaps, func(i, j int) bool {
+		return snaps[i].CreatedAt.Before(snaps[j].CreatedAt)
+	})
+	return snaps, nil
+}
+
+func (t *T) DeleteSnapshot(volume, name string) error {
+	dir := t.snapshotDir(volume, name)
+	if !file.Exists(dir) {
+		return fmt.Errorf("%w: %s", pool.ErrSnapshotNotFound, name)
+	}
+	return os.RemoveAll(dir)
+}
+
+// RollbackSnapshot replaces the volume data with a copy of the snapshot
+// data. The current data is removed only when the copy succeeded.
+func (t *T) RollbackSnapshot(volume, name string) error {
+	data, err := t.volumeData(volume)
+	if err != nil {
+		return err
+	}
+	snapData := t.snapshotData(volume, name, data)
+	if !file.Exists(snapData) {
+		return fmt.Errorf("%w: %s", pool.ErrSnapshotNotFound, name)
+	}
+	tmp := data + ".rollback"
+	if err := os.RemoveAll(tmp); err != nil {
+		return err
+	}
+	if err := copyData(snapData, tmp); err != nil {
+		_ = os.RemoveAll(tmp)
+		return err
+	}
+	old := data + ".old"
+	if err := os.Rename(data, old); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, data); err != nil {
+		_ = os.Rename(old, data)
+		return err
+	}
+	return os.RemoveAll(old)
+}
+
+// Clone copies the <src> volume data to the <dst> volume data path.
+func (t *T) Clone(src, dst string, size int64, format bool, shared bool) ([]string, error) {
+	data, err := t.volumeData(src)
+	if err != nil {
+		return nil, err
+	}
+	dstData := filepath.Join(t.path(), dst)
+	if !format {
+		dstData = t.loopFile(dst)
+	}
+	if isLoop := data == t.loopFile(src); isLoop == format {
+		return nil, fmt.Errorf("volume %s data %s can not be cloned to a volume with format=%v", src, data, format)
+	}
+	if file.Exists(dstData) {
+		return nil, fmt.Errorf("volume %s data %s already exists", dst, dstData)
+	}
+	if err := copyData(data, dstData); err != nil {
+		_ = os.RemoveAll(dstData)
+		return nil, err
+	}
+	if format {
+		return t.Translate(dst, size, shared)
+	}
+	return t.BlkTranslate(dst, size, shared)
+}
+
+// copyData copies the src file or directory to dst, preserving the
+// ownership, permissions and timestamps, and sharing the data blocks if
+// the filesystem supports it.
+func copyData(src, dst string) error {
+	var args []string
+	switch runtime.GOOS {
+	case "linux":
+		args = []string{"-a", "--reflink=auto"}
+	case "darwin":
+		args = []string{"-c", "-pR"}
+	default:
+		args = []string{"-pR"}
+	}
+	args = append(args, src, dst)
+	cmd := command.New(
+		command.WithName("cp"),
+		command.WithArgs(args),
+		command.WithBufferedStderr(),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%s: %w: %s", cmd, err, cmd.Stderr())
+	}
+	return nil
+}
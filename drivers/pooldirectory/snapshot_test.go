@@ -0,0 +1,73 @@
+package pooldirectory
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/core/pool"
+)
+
+func TestSnapshot(t *testing.T) {
+	p := newTestPool(t)
+	volumeDir := filepath.Join(p.path(), "vol1")
+	dataFile := filepath.Join(volumeDir, "data")
+	require.NoError(t, os.MkdirAll(volumeDir, 0700))
+	require.NoError(t, os.WriteFile(dataFile, []byte("v1"), 0600))
+
+	t.Run("create", func(t *testing.T) {
+		require.NoError(t, p.CreateSnapshot("vol1", "snap1"))
+		require.Error(t, p.CreateSnapshot("vol1", "snap1"), "snapshot names must be unique")
+		require.Error(t, p.CreateSnapshot("vol2", "snap1"), "vol2 has no data")
+	})
+
+	t.Run("list", func(t *testing.T) {
+		snaps, err := p.ListSnapshots("vol1")
+		require.NoError(t, err)
+		require.Len(t, snaps, 1)
+		require.Equal(t, "snap1", snaps[0].Name)
+		require.Equal(t, "vol1", snaps[0].Volume)
+
+		snaps, err = p.ListSnapshots("vol2")
+		require.NoError(t, err)
+		require.Empty(t, snaps)
+	})
+
+	t.Run("rollback", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(dataFile, []byte("v2"), 0600))
+		require.NoError(t, os.WriteFile(filepath.Join(volumeDir, "new"), []byte("v2"), 0600))
+		require.NoError(t, p.RollbackSnapshot("vol1", "snap1"))
+
+		b, err := os.ReadFile(dataFile)
+		require.NoError(t, err)
+		require.Equal(t, "v1", string(b))
+		require.NoFileExists(t, filepath.Join(volumeDir, "new"))
+		require.NoDirExists(t, volumeDir+".old")
+		require.NoDirExists(t, volumeDir+".rollback")
+
+		err = p.RollbackSnapshot("vol1", "snap2")
+		require.ErrorIs(t, err, pool.ErrSnapshotNotFound)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, p.DeleteSnapshot("vol1", "snap1"))
+		require.ErrorIs(t, p.DeleteSnapshot("vol1", "snap1"), pool.ErrSnapshotNotFound)
+		snaps, err := p.ListSnapshots("vol1")
+		require.NoError(t, err)
+		require.Empty(t, snaps)
+	})
+}
+
+func TestSnapshotLoopFile(t *testing.T) {
+	p := newTestPool(t)
+	loopFile := p.loopFile("vol1")
+	require.NoError(t, os.WriteFile(loopFile, []byte("v1"), 0600))
+	require.NoError(t, p.CreateSnapshot("vol1", "snap1"))
+	require.NoError(t, os.WriteFile(loopFile, []byte("v2"), 0600))
+	require.NoError(t, p.RollbackSnapshot("vol1", "snap1"))
+	b, err := os.ReadFile(loopFile)
+	require.NoError(t, err)
+	require.Equal(t, "v1", string(b))
+}
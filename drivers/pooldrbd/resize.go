@@ -0,0 +1,51 @@
+//go:build linux
+
+package pooldrbd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/opensvc/om3/util/lvm2"
+	"github.com/opensvc/om3/util/zfs"
+)
+
+// ResizeVolume grows the local drbd backing device of <volume> to <size>
+// bytes, if smaller. The drbd device itself is resized by the drbd disk
+// resource, once the backing devices are grown on all peers.
+func (t T) ResizeVolume(volume string, size int64) error {
+	if t.vg() != "" {
+		return t.resizeVG(volume, size)
+	} else if t.zpool() != "" {
+		return t.resizeZpool(volume, size)
+	}
+	return fmt.Errorf("pool %s: resize is not supported for file backed drbd volumes", t.Name())
+}
+
+func (t T) resizeVG(volume string, size int64) error {
+	lv := lvm2.NewLV(t.vg(), volume)
+	current, err := lv.Size()
+	if err != nil {
+		return err
+	}
+	if current >= size {
+		return nil
+	}
+	return lv.Extend(size)
+}
+
+func (t T) resizeZpool(volume string, size int64) error {
+	vol := &zfs.Vol{Name: t.zpool() + "/" + volume}
+	s, err := vol.GetProperty("volsize")
+	if err != nil {
+		return err
+	}
+	current, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%s: parse volsize %s: %w", vol.Name, s, err)
+	}
+	if current >= size {
+		return nil
+	}
+	return vol.SetProperty("volsize", strconv.FormatInt(size, 10))
+}
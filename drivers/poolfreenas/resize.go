@@ -0,0 +1,39 @@
+//go:build linux || solaris
+
+package poolfreenas
+
+import (
+	"fmt"
+
+	"github.com/opensvc/om3/drivers/arrayfreenas"
+	"github.com/opensvc/om3/util/hostname"
+	"github.com/opensvc/om3/util/sizeconv"
+)
+
+// ResizeVolume grows the zvol backing <volume> to <size> bytes, if
+// smaller. Non-shared volumes are backed by a per-node zvol named after
+// the local hostname.
+func (t T) ResizeVolume(volume string, size int64) error {
+	a := t.array()
+	var ds *arrayfreenas.Dataset
+	for _, name := range []string{volume, volume + t.Separator() + hostname.Hostname()} {
+		if e, err := a.GetDataset(t.dataset(name)); err != nil {
+			return err
+		} else if e != nil {
+			ds = e
+			break
+		}
+	}
+	if ds == nil {
+		return fmt.Errorf("volume %s has no dataset in %s", volume, t.diskgroup())
+	}
+	if ds.Volsize != nil {
+		if current, err := sizeconv.FromSize(ds.Volsize.Rawvalue); err != nil {
+			return err
+		} else if current >= size {
+			return nil
+		}
+	}
+	_, err := a.UpdateDataset(ds.Id, arrayfreenas.UpdateDatasetParams{Volsize: &size})
+	return err
+}
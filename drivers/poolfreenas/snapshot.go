@@ -0,0 +1,93 @@
+//go:build linux || solaris
+
+package poolfreenas
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/opensvc/om3/core/pool"
+	"github.com/opensvc/om3/drivers/arrayfreenas"
+)
+
+func (t T) dataset(volume string) string {
+	return t.diskgroup() + "/" + volume
+}
+
+func (t T) CreateSnapshot(volume, name string) error {
+	_, err := t.array().CreateSnapshot(t.dataset(volume), name)
+	return err
+}
+
+func (t T) ListSnapshots(volume string) (pool.Snapshots, error) {
+	l, err := t.array().GetSnapshots(t.dataset(volume))
+	if err != nil {
+		return nil, err
+	}
+	snaps := make(pool.Snapshots, len(l))
+	for i, e := range l {
+		snaps[i] = pool.Snapshot{
+			Name:   e.SnapshotName,
+			Volume: volume,
+		}
+		if v, ok := e.Properties["creation"]; ok {
+			if n, err := strconv.ParseInt(v.Rawvalue, 10, 64); err == nil {
+				snaps[i].CreatedAt = time.Unix(n, 0)
+			}
+		}
+		if v, ok := e.Properties["used"]; ok {
+			if n, err := strconv.ParseInt(v.Rawvalue, 10, 64); err == nil {
+				snaps[i].Used = n
+			}
+		}
+	}
+	return snaps, nil
+}
+
+func (t T) DeleteSnapshot(volume, name string) error {
+	snap, err := t.getSnapshot(volume, name)
+	if err != nil {
+		return err
+	}
+	return t.array().DeleteSnapshot(snap.Id)
+}
+
+func (t T) RollbackSnapshot(volume, name string) error {
+	snap, err := t.getSnapshot(volume, name)
+	if err != nil {
+		return err
+	}
+	return t.array().RollbackSnapshot(snap.Id)
+}
+
+func (t T) getSnapshot(volume, name string) (arrayfreenas.Snapshot, error) {
+	l, err := t.array().GetSnapshots(t.dataset(volume))
+	if err != nil {
+		return arrayfreenas.Snapshot{}, err
+	}
+	for _, e := range l {
+		if e.SnapshotName == name {
+			return e, nil
+		}
+	}
+	return arrayfreenas.Snapshot{}, fmt.Errorf("%w: %s", pool.ErrSnapshotNotFound, name)
+}
+
+// Clone creates the <dst> zvol as a zfs clone of a new snapshot of the
+// <src> zvol. The disk provisioning then maps the existing <dst> zvol
+// instead of creating a new one.
+func (t *T) Clone(src, dst string, size int64, format bool, shared bool) ([]string, error) {
+	a := t.array()
+	snap, err := a.CreateSnapshot(t.dataset(src), "clone-"+dst)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.CloneSnapshot(snap.Id, t.dataset(dst)); err != nil {
+		return nil, err
+	}
+	if format {
+		return t.Translate(dst, size, shared)
+	}
+	return t.BlkTranslate(dst, size, shared)
+}
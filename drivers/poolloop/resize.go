@@ -0,0 +1,22 @@
+//go:build linux
+
+package poolloop
+
+import (
+	"fmt"
+	"os"
+)
+
+// ResizeVolume grows the loop file backing <volume> to <size> bytes,
+// if smaller. The file is extended sparse.
+func (t T) ResizeVolume(volume string, size int64) error {
+	p := fmt.Sprintf("%s/%s.img", t.Head(), volume)
+	info, err := os.Stat(p)
+	if err != nil {
+		return err
+	}
+	if info.Size() >= size {
+		return nil
+	}
+	return os.Truncate(p, size)
+}
@@ -0,0 +1,17 @@
+//go:build linux
+
+package poolthinlvm
+
+import (
+	"github.com/opensvc/om3/core/driver"
+	"github.com/opensvc/om3/util/capabilities"
+)
+
+func init() {
+	capabilities.Register(capabilitiesScanner)
+}
+
+func capabilitiesScanner() ([]string, error) {
+	volDrvID := driver.NewID(driver.GroupVolume, drvID.Name)
+	return []string{drvID.Cap(), volDrvID.Cap()}, nil
+}
@@ -0,0 +1,143 @@
+//go:build linux
+
+package poolthinlvm
+
+import (
+	"fmt"
+
+	"github.com/opensvc/om3/core/driver"
+	"github.com/opensvc/om3/core/pool"
+	"github.com/opensvc/om3/util/lvm2"
+	"github.com/opensvc/om3/util/sizeconv"
+)
+
+type (
+	T struct {
+		pool.T
+	}
+)
+
+var (
+	drvID = driver.NewID(driver.GroupPool, "thinlvm")
+)
+
+func init() {
+	driver.Register(drvID, NewPooler)
+}
+
+func NewPooler() pool.Pooler {
+	t := New()
+	var i interface{} = t
+	return i.(pool.Pooler)
+}
+
+func New() *T {
+	t := T{}
+	return &t
+}
+
+func (t T) Head() string {
+	return t.VGName() + "/" + t.ThinPoolName()
+}
+
+func (t T) Capabilities() []string {
+	return []string{"rox", "rwx", "roo", "rwo", "blk"}
+}
+
+func (t T) VGName() string {
+	return t.GetString("vg")
+}
+
+func (t T) ThinPoolName() string {
+	return t.GetString("thinpool")
+}
+
+func (t T) thinPool() *lvm2.LV {
+	return lvm2.NewLV(t.VGName(), t.ThinPoolName())
+}
+
+// maxOvercommit returns the maximum ratio of the thin volumes virtual
+// sizes to the thin pool size. Zero means no limit.
+func (t T) maxOvercommit() (float64, error) {
+	f, err := t.GetFloat64("max_overcommit")
+	if err != nil {
+		return 0, fmt.Errorf("invalid max_overcommit: %w", err)
+	}
+	return f, nil
+}
+
+// Validate returns an error if the max_overcommit keyword value is not a
+// float.
+func (t T) Validate() error {
+	_, err := t.maxOvercommit()
+	return err
+}
+
+func (t T) Usage() (pool.Usage, error) {
+	info, err := t.thinPool().ThinPoolUsage()
+	if err != nil {
+		return pool.Usage{}, err
+	}
+	used := info.Used()
+	usage := pool.Usage{
+		Size:            info.Size,
+		Free:            info.Size - used,
+		Used:            used,
+		DataPercent:     info.DataPercent,
+		MetadataPercent: info.MetadataPercent,
+		Overcommit:      info.Overcommit(),
+	}
+	return usage, nil
+}
+
+// CanOvercommit returns an error if a new thin volume of <size> bytes
+// would make the thin volumes virtual sizes exceed max_overcommit times
+// the thin pool size.
+func (t T) CanOvercommit(size int64) error {
+	maxOvercommit, err := t.maxOvercommit()
+	if err != nil {
+		return err
+	}
+	if maxOvercommit <= 0 {
+		return nil
+	}
+	info, err := t.thinPool().ThinPoolUsage()
+	if err != nil {
+		return err
+	}
+	if info.Size == 0 {
+		return fmt.Errorf("thin pool %s has no data space", t.Head())
+	}
+	overcommit := float64(info.Virtual+size) / float64(info.Size)
+	if overcommit > maxOvercommit {
+		return fmt.Errorf("a %s volume would raise the thin pool %s overcommit to %.2f, above the %.2f max_overcommit",
+			sizeconv.BSize(float64(size)), t.Head(), overcommit, maxOvercommit)
+	}
+	return nil
+}
+
+func (t *T) Translate(name string, size int64, shared bool) ([]string, error) {
+	data, err := t.BlkTranslate(name, size, shared)
+	if err != nil {
+		return nil, err
+	}
+	data = append(data, t.AddFS(name, shared, 1, 0, "disk#0")...)
+	return data, nil
+}
+
+func (t *T) BlkTranslate(name string, size int64, shared bool) ([]string, error) {
+	if err := t.CanOvercommit(size); err != nil {
+		return nil, err
+	}
+	data := []string{
+		"disk#0.type=lv",
+		"disk#0.name=" + name,
+		"disk#0.vg=" + t.VGName(),
+		"disk#0.thinpool=" + t.ThinPoolName(),
+		"disk#0.size=" + sizeconv.ExactBSizeCompact(float64(size)),
+	}
+	if opts := t.MkblkOptions(); opts != "" {
+		data = append(data, "disk#0.create_options="+opts)
+	}
+	return data, nil
+}
@@ -0,0 +1,56 @@
+//go:build linux
+
+package poolthinlvm
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/pool"
+	"github.com/opensvc/om3/core/rawconfig"
+	"github.com/opensvc/om3/testhelper"
+)
+
+func TestValidate(t *testing.T) {
+	cases := map[string]struct {
+		value    string
+		expected float64
+		hasError bool
+	}{
+		"float":   {value: "1.5", expected: 1.5},
+		"integer": {value: "2", expected: 2},
+		"default": {value: "", expected: 2},
+		"invalid": {value: "abc", hasError: true},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			testhelper.Setup(t)
+			conf := "[pool#thin]\ntype = thinlvm\nvg = vg1\nthinpool = tp1\n"
+			if tc.value != "" {
+				conf += "max_overcommit = " + tc.value + "\n"
+			}
+			require.NoError(t, os.WriteFile(filepath.Join(rawconfig.Paths.Etc, "node.conf"), []byte(conf), 0644))
+			node, err := object.NewNode()
+			require.NoError(t, err)
+			p := pool.New("thin", node.MergedConfig())
+			require.NotNil(t, p)
+			o := p.(*T)
+			err = o.Validate()
+			status := pool.GetStatus(p, false)
+			if tc.hasError {
+				require.ErrorContains(t, err, "invalid max_overcommit")
+				require.Len(t, status.Errors, 1)
+				return
+			}
+			require.NoError(t, err)
+			require.Empty(t, status.Errors)
+			f, err := o.maxOvercommit()
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, f)
+		})
+	}
+}
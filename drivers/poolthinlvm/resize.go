@@ -0,0 +1,25 @@
+//go:build linux
+
+package poolthinlvm
+
+import (
+	"github.com/opensvc/om3/util/lvm2"
+)
+
+// ResizeVolume extends the thin logical volume backing <volume> to
+// <size> bytes, if smaller. The growth is accounted against the pool
+// max_overcommit like a new volume of the same size would be.
+func (t T) ResizeVolume(volume string, size int64) error {
+	lv := lvm2.NewLV(t.VGName(), volume)
+	current, err := lv.Size()
+	if err != nil {
+		return err
+	}
+	if current >= size {
+		return nil
+	}
+	if err := t.CanOvercommit(size - current); err != nil {
+		return err
+	}
+	return lv.Extend(size)
+}
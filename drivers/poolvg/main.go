@@ -39,7 +39,7 @@ func (t T) Head() string {
 }
 
 func (t T) Capabilities() []string {
-	return []string{"rox", "rwx", "roo", "rwo", "blk"}
+	return []string{"rox", "rwx", "roo", "rwo", "blk", "snap"}
 }
 
 func (t T) VGName() string {
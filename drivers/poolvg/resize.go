@@ -0,0 +1,21 @@
+//go:build linux
+
+package poolvg
+
+import (
+	"github.com/opensvc/om3/util/lvm2"
+)
+
+// ResizeVolume extends the logical volume backing <volume> to <size>
+// bytes, if smaller.
+func (t T) ResizeVolume(volume string, size int64) error {
+	lv := lvm2.NewLV(t.VGName(), volume)
+	current, err := lv.Size()
+	if err != nil {
+		return err
+	}
+	if current >= size {
+		return nil
+	}
+	return lv.Extend(size)
+}
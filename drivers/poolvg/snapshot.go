@@ -0,0 +1,138 @@
+//go:build linux
+
+package poolvg
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rs/zerolog"
+
+	"github.com/opensvc/om3/core/pool"
+	"github.com/opensvc/om3/util/command"
+	"github.com/opensvc/om3/util/filesystems"
+	"github.com/opensvc/om3/util/lvm2"
+	"github.com/opensvc/om3/util/sizeconv"
+)
+
+// snapshotLVName returns the name of the lv holding the <name> snapshot
+// of the <volume> lv.
+func snapshotLVName(volume, name string) string {
+	return volume + "_snap_" + name
+}
+
+func (t T) snapSize() string {
+	if s := t.GetString("snap_size"); s != "" {
+		return s
+	}
+	return "20%ORIGIN"
+}
+
+func (t T) CreateSnapshot(volume, name string) error {
+	return lvm2.NewLV(t.VGName(), volume).CreateSnapshot(snapshotLVName(volume, name), t.snapSize())
+}
+
+func (t T) ListSnapshots(volume string) (pool.Snapshots, error) {
+	l, err := lvm2.NewLV(t.VGName(), volume).Snapshots()
+	if err != nil {
+		return nil, err
+	}
+	prefix := snapshotLVName(volume, "")
+	snaps := make(pool.Snapshots, 0)
+	for _, e := range l {
+		if !strings.HasPrefix(e.LVName, prefix) {
+			// the temporary source snapshot of a clone in progress
+			continue
+		}
+		snaps = append(snaps, pool.Snapshot{
+			Name:      strings.TrimPrefix(e.LVName, prefix),
+			Volume:    volume,
+			CreatedAt: e.CreatedAt,
+			Used:      e.Used,
+		})
+	}
+	return snaps, nil
+}
+
+func (t T) DeleteSnapshot(volume, name string) error {
+	if err := t.hasSnapshot(volume, name); err != nil {
+		return err
+	}
+	return lvm2.NewLV(t.VGName(), snapshotLVName(volume, name)).Remove([]string{"-f"})
+}
+
+// RollbackSnapshot merges the snapshot lv into the volume lv. The
+// snapshot is consumed by the merge.
+func (t T) RollbackSnapshot(volume, name string) error {
+	if err := t.hasSnapshot(volume, name); err != nil {
+		return err
+	}
+	return lvm2.NewLV(t.VGName(), snapshotLVName(volume, name)).Merge()
+}
+
+func (t T) hasSnapshot(volume, name string) error {
+	l, err := t.ListSnapshots(volume)
+	if err != nil {
+		return err
+	}
+	_, err = l.Find(name)
+	return err
+}
+
+// Clone creates the <dst> lv and copies the data of a temporary snapshot
+// of the <src> lv, so the clone is consistent and does not depend on the
+// <src> lv, which can be unprovisioned while the clone lives.
+//
+// The filesystem of a formatted clone is given a new uuid, so the clone
+// can be mounted next to the <src> lv.
+func (t *T) Clone(src, dst string, size int64, format bool, shared bool) ([]string, error) {
+	vg := t.VGName()
+	var fs filesystems.UUIDRegenerater
+	if format {
+		i, ok := filesystems.FromType(t.FSType()).(filesystems.UUIDRegenerater)
+		if !ok {
+			return nil, fmt.Errorf("can not clone a %s formatted volume: the filesystem uuid can not be regenerated", t.FSType())
+		}
+		fs = i
+	}
+	snap := lvm2.NewLV(vg, cloneSourceLVName(dst))
+	if err := lvm2.NewLV(vg, src).CreateSnapshot(snap.LVName, t.snapSize()); err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = snap.Remove([]string{"-f"})
+	}()
+	lv := lvm2.NewLV(vg, dst)
+	if err := lv.Create(sizeconv.ExactBSizeCompact(float64(size)), strings.Fields(t.MkblkOptions())); err != nil {
+		return nil, err
+	}
+	err := copyLV(snap, lv)
+	if err == nil && fs != nil {
+		err = fs.RegenerateUUID(lv.DevPath())
+	}
+	if err != nil {
+		_ = lv.Remove([]string{"-f"})
+		return nil, err
+	}
+	if format {
+		return t.Translate(dst, size, shared)
+	}
+	return t.BlkTranslate(dst, size, shared)
+}
+
+// cloneSourceLVName returns the name of the temporary snapshot lv used as
+// the data source of the <volume> clone.
+func cloneSourceLVName(volume string) string {
+	return volume + "_clone_src"
+}
+
+func copyLV(src, dst *lvm2.LV) error {
+	cmd := command.New(
+		command.WithName("dd"),
+		command.WithVarArgs("if="+src.DevPath(), "of="+dst.DevPath(), "bs=1M", "conv=fsync"),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.DebugLevel),
+		command.WithStderrLogLevel(zerolog.DebugLevel),
+	)
+	return cmd.Run()
+}
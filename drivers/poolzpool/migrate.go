@@ -0,0 +1,99 @@
+//go:build linux || solaris
+
+package poolzpool
+
+import (
+	"github.com/opensvc/om3/core/pool"
+	"github.com/opensvc/om3/util/zfs"
+)
+
+const (
+	// sendSnapshot is the name of the snapshot holding the data sent by
+	// the last SendVolume, on both the source and destination datasets.
+	// The leading "_" is refused by pool.ValidateSnapshotName, so this
+	// name can not collide with a user snapshot.
+	sendSnapshot = "_migrate"
+
+	// sendNextSnapshot is the name of the snapshot holding the data sent
+	// by the running SendVolume, renamed to sendSnapshot once received.
+	sendNextSnapshot = "_migrate-next"
+)
+
+// SendVolume copies the <volume> dataset to the <dst> zpool with zfs
+// send and receive. If both datasets have the snapshot of the previous
+// send, only the changes since this snapshot are sent. The destination
+// dataset is not mounted by the receive, and the changes made to it since
+// the previous send are lost.
+func (t T) SendVolume(volume string, dst pool.Pooler) error {
+	src := t.dataset(volume)
+	dstDataset := &zfs.Filesystem{Name: dst.Head() + "/" + volume}
+	for _, ds := range []*zfs.Filesystem{src, dstDataset} {
+		if err := destroySnapshotIfExists(ds, sendNextSnapshot); err != nil {
+			return err
+		}
+	}
+	incremental, err := hasSnapshots(sendSnapshot, src, dstDataset)
+	if err != nil {
+		return err
+	}
+	if !incremental {
+		// a full receive refuses to overwrite a dataset with snapshots
+		for _, ds := range []*zfs.Filesystem{src, dstDataset} {
+			if err := destroySnapshotIfExists(ds, sendSnapshot); err != nil {
+				return err
+			}
+		}
+		if err := zfs.SnapshotCreate(src, sendSnapshot); err != nil {
+			return err
+		}
+		return zfs.SnapshotSend(src, "", sendSnapshot, dstDataset.Name)
+	}
+	if err := zfs.SnapshotCreate(src, sendNextSnapshot); err != nil {
+		return err
+	}
+	if err := zfs.SnapshotSend(src, sendSnapshot, sendNextSnapshot, dstDataset.Name); err != nil {
+		return err
+	}
+	for _, ds := range []*zfs.Filesystem{src, dstDataset} {
+		if err := zfs.SnapshotDestroy(ds, sendSnapshot); err != nil {
+			return err
+		}
+		if err := zfs.SnapshotRename(ds, sendNextSnapshot, sendSnapshot); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ForgetSendVolume destroys the snapshots of the <volume> datasets kept
+// by SendVolume.
+func (t T) ForgetSendVolume(volume string, dst pool.Pooler) error {
+	for _, ds := range []*zfs.Filesystem{t.dataset(volume), {Name: dst.Head() + "/" + volume}} {
+		for _, name := range []string{sendNextSnapshot, sendSnapshot} {
+			if err := destroySnapshotIfExists(ds, name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func hasSnapshots(name string, l ...*zfs.Filesystem) (bool, error) {
+	for _, ds := range l {
+		if v, err := zfs.SnapshotExists(ds, name); err != nil {
+			return false, err
+		} else if !v {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func destroySnapshotIfExists(ds *zfs.Filesystem, name string) error {
+	if v, err := zfs.SnapshotExists(ds, name); err != nil {
+		return err
+	} else if !v {
+		return nil
+	}
+	return zfs.SnapshotDestroy(ds, name)
+}
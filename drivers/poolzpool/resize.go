@@ -0,0 +1,34 @@
+//go:build linux || solaris
+
+package poolzpool
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/opensvc/om3/util/zfs"
+)
+
+// ResizeVolume grows the zvol backing a block volume. Filesystem
+// volumes are zfs datasets whose quotas are applied by the fs resource,
+// so there is nothing to do at the pool level for those.
+func (t T) ResizeVolume(volume string, size int64) error {
+	vol := &zfs.Vol{Name: t.poolName() + "/" + volume}
+	if exists, err := vol.Exists(); err != nil {
+		return err
+	} else if !exists {
+		return nil
+	}
+	s, err := vol.GetProperty("volsize")
+	if err != nil {
+		return err
+	}
+	current, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%s: parse volsize %s: %w", vol.Name, s, err)
+	}
+	if current >= size {
+		return nil
+	}
+	return vol.SetProperty("volsize", strconv.FormatInt(size, 10))
+}
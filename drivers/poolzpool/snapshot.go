@@ -0,0 +1,77 @@
+//go:build linux || solaris
+
+package poolzpool
+
+import (
+	"github.com/opensvc/om3/core/pool"
+	"github.com/opensvc/om3/util/zfs"
+)
+
+func (t T) dataset(volume string) *zfs.Filesystem {
+	return &zfs.Filesystem{Name: t.poolName() + "/" + volume}
+}
+
+func (t T) CreateSnapshot(volume, name string) error {
+	return zfs.SnapshotCreate(t.dataset(volume), name)
+}
+
+func (t T) ListSnapshots(volume string) (pool.Snapshots, error) {
+	l, err := zfs.SnapshotList(t.dataset(volume))
+	if err != nil {
+		return nil, err
+	}
+	snaps := make(pool.Snapshots, len(l))
+	for i, e := range l {
+		snaps[i] = pool.Snapshot{
+			Name:      e.Name,
+			Volume:    volume,
+			CreatedAt: e.Creation,
+			Used:      e.Used,
+		}
+	}
+	return snaps, nil
+}
+
+func (t T) DeleteSnapshot(volume, name string) error {
+	if err := t.hasSnapshot(volume, name); err != nil {
+		return err
+	}
+	return zfs.SnapshotDestroy(t.dataset(volume), name)
+}
+
+func (t T) RollbackSnapshot(volume, name string) error {
+	if err := t.hasSnapshot(volume, name); err != nil {
+		return err
+	}
+	return zfs.SnapshotRollback(t.dataset(volume), name)
+}
+
+func (t T) hasSnapshot(volume, name string) error {
+	l, err := t.ListSnapshots(volume)
+	if err != nil {
+		return err
+	}
+	_, err = l.Find(name)
+	return err
+}
+
+// Clone creates the <dst> dataset as a zfs clone of a new snapshot of
+// the <src> dataset. The clone shares its unmodified blocks with the
+// snapshot, which can not be deleted while the clone exists.
+func (t *T) Clone(src, dst string, size int64, format bool, shared bool) ([]string, error) {
+	snapName := "clone-" + dst
+	if err := zfs.SnapshotCreate(t.dataset(src), snapName); err != nil {
+		return nil, err
+	}
+	var props []string
+	if format {
+		props = append(props, "canmount=noauto", "mountpoint="+pool.MountPointFromName(dst))
+	}
+	if err := zfs.SnapshotClone(t.dataset(src), snapName, t.poolName()+"/"+dst, props...); err != nil {
+		return nil, err
+	}
+	if format {
+		return t.Translate(dst, size, shared)
+	}
+	return t.BlkTranslate(dst, size, shared)
+}
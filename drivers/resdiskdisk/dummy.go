@@ -24,3 +24,7 @@ func (t T) unconfigure() error {
 func (t T) configure(force forceMode) error {
 	return nil
 }
+
+func (t T) resize() error {
+	return nil
+}
@@ -106,3 +106,25 @@ func (t T) configure(force forceMode) error {
 	}
 	return nil
 }
+
+// resize makes the kernel reread the size of the disk paths, grown on
+// the array by the pool, then makes multipathd resize the map.
+func (t T) resize() error {
+	for _, dev := range t.ExposedDevices() {
+		slaves, err := dev.Slaves()
+		if err != nil {
+			return fmt.Errorf("%s get slaves: %w", dev, err)
+		}
+		for _, slave := range slaves {
+			if err := slave.Rescan(); err != nil {
+				return fmt.Errorf("%s slave %s rescan: %w", dev, slave, err)
+			}
+		}
+		if err := dev.ResizeMultipath(); err != nil {
+			return fmt.Errorf("%s multipath resize: %w", dev, err)
+		} else {
+			t.Log().Infof("%s multipath resized", dev)
+		}
+	}
+	return nil
+}
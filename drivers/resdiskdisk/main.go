@@ -108,6 +108,16 @@ func (t *T) UnprovisionLeader(ctx context.Context) error {
 	return nil
 }
 
+// CanResize returns nil: the array disk is grown by the pool.
+func (t T) CanResize() error {
+	return nil
+}
+
+// Resize makes the host see the new size of the array disk.
+func (t T) Resize(ctx context.Context, size int64) error {
+	return t.resize()
+}
+
 func (t T) ReservableDevices() device.L {
 	return t.ExposedDevices()
 }
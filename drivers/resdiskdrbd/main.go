@@ -62,6 +62,7 @@ type (
 		IsDefined() (bool, error)
 		Primary() error
 		PrimaryForce() error
+		Resize() error
 		Role() (string, error)
 		Secondary() error
 		Up() error
@@ -0,0 +1,37 @@
+//go:build linux
+
+package resdiskdrbd
+
+import (
+	"context"
+)
+
+// CanResize returns nil: the drbd device can grow online once its
+// backing devices are grown on all peers.
+func (t T) CanResize() error {
+	return nil
+}
+
+// Resize makes the drbd device grow to the size of the smallest backing
+// device of the connected peers. The resize is done from the primary
+// node only, so the volume must be resized on the secondary nodes first.
+func (t T) Resize(ctx context.Context, size int64) error {
+	if !t.isConfigured() {
+		t.Log().Infof("skip: resource not configured")
+		return nil
+	}
+	dev := t.drbd()
+	if ok, err := dev.IsDefined(); err != nil {
+		return err
+	} else if !ok {
+		t.Log().Infof("skip: resource not defined (for this host)")
+		return nil
+	}
+	if role, err := dev.Role(); err != nil {
+		return err
+	} else if role != "Primary" {
+		t.Log().Infof("skip: drbd resize is done from the primary node")
+		return nil
+	}
+	return dev.Resize()
+}
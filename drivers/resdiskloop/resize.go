@@ -0,0 +1,23 @@
+package resdiskloop
+
+import (
+	"context"
+)
+
+// CanResize returns nil: the loop file is grown by the pool.
+func (t T) CanResize() error {
+	return nil
+}
+
+// Resize makes the loop device reread the size of its file, grown by
+// the pool. The loop device is sized on attach, so there is nothing to
+// do if it is not up.
+func (t T) Resize(ctx context.Context, size int64) error {
+	lo := t.loop()
+	if v, err := t.isUp(lo); err != nil {
+		return err
+	} else if !v {
+		return nil
+	}
+	return lo.FileRefresh(t.File)
+}
@@ -21,6 +21,7 @@ type (
 		LVName        string   `json:"name"`
 		VGName        string   `json:"vg"`
 		Size          string   `json:"size"`
+		ThinPool      string   `json:"thinpool"`
 		CreateOptions []string `json:"create_options"`
 	}
 	LVDriver interface {
@@ -35,6 +36,9 @@ type (
 	LVDriverProvisioner interface {
 		Create(string, []string) error
 	}
+	LVDriverThinProvisioner interface {
+		CreateThin(string, string, []string) error
+	}
 	LVDriverUnprovisioner interface {
 		Remove([]string) error
 	}
@@ -69,6 +73,9 @@ func (t T) Info(ctx context.Context) (resource.InfoKeys, error) {
 		{Key: "name", Value: t.LVName},
 		{Key: "vg", Value: t.VGName},
 	}
+	if t.ThinPool != "" {
+		m = append(m, resource.InfoKey{Key: "thinpool", Value: t.ThinPool})
+	}
 	return m, nil
 }
 
@@ -118,10 +125,6 @@ func (t T) Label() string {
 
 func (t T) ProvisionLeader(ctx context.Context) error {
 	lv := t.lv()
-	lvi, ok := lv.(LVDriverProvisioner)
-	if !ok {
-		return fmt.Errorf("lv %s %s driver does not implement provisioning", lv.FQN(), lv.DriverName())
-	}
 	exists, err := lv.Exists()
 	if err != nil {
 		return err
@@ -130,8 +133,22 @@ func (t T) ProvisionLeader(ctx context.Context) error {
 		t.Log().Infof("%s is already provisioned", lv.FQN())
 		return nil
 	}
-	if lvi.Create(t.Size, t.CreateOptions); err != nil {
-		return err
+	if t.ThinPool != "" {
+		lvi, ok := lv.(LVDriverThinProvisioner)
+		if !ok {
+			return fmt.Errorf("lv %s %s driver does not implement thin provisioning", lv.FQN(), lv.DriverName())
+		}
+		if err := lvi.CreateThin(t.ThinPool, t.Size, t.CreateOptions); err != nil {
+			return err
+		}
+	} else {
+		lvi, ok := lv.(LVDriverProvisioner)
+		if !ok {
+			return fmt.Errorf("lv %s %s driver does not implement provisioning", lv.FQN(), lv.DriverName())
+		}
+		if err := lvi.Create(t.Size, t.CreateOptions); err != nil {
+			return err
+		}
 	}
 	actionrollback.Register(ctx, func() error {
 		if lvi, ok := lv.(LVDriverUnprovisioner); ok {
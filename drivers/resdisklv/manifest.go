@@ -52,6 +52,14 @@ func (t T) Manifest() *manifest.T {
 			Text:         keywords.NewText(fs, "text/kw/size"),
 			Example:      "10m",
 		},
+		keywords.Keyword{
+			Option:       "thinpool",
+			Attr:         "ThinPool",
+			Scopable:     true,
+			Provisioning: true,
+			Text:         keywords.NewText(fs, "text/kw/thinpool"),
+			Example:      "tp1",
+		},
 		keywords.Keyword{
 			Option:       "create_options",
 			Attr:         "CreateOptions",
@@ -0,0 +1,18 @@
+//go:build linux
+
+package resdisklv
+
+import (
+	"context"
+)
+
+// CanResize returns nil: the logical volume is extended by the pool.
+func (t T) CanResize() error {
+	return nil
+}
+
+// Resize is a no-op: the logical volume is extended by the pool, and
+// its device size is updated by the kernel.
+func (t T) Resize(ctx context.Context, size int64) error {
+	return nil
+}
@@ -0,0 +1,16 @@
+package resdiskzvol
+
+import (
+	"context"
+)
+
+// CanResize returns nil: the zvol volsize is raised by the pool.
+func (t T) CanResize() error {
+	return nil
+}
+
+// Resize is a no-op: the zvol volsize is raised by the pool, and its
+// device size is updated by the kernel.
+func (t T) Resize(ctx context.Context, size int64) error {
+	return nil
+}
@@ -0,0 +1,19 @@
+//go:build linux
+
+package resfsbtrfssubvol
+
+import (
+	"github.com/opensvc/om3/util/btrfs"
+	"github.com/opensvc/om3/util/capabilities"
+)
+
+func init() {
+	capabilities.Register(capabilitiesScanner)
+}
+
+func capabilitiesScanner() ([]string, error) {
+	if !btrfs.IsCapable() {
+		return []string{}, nil
+	}
+	return []string{drvID.Cap()}, nil
+}
@@ -0,0 +1,339 @@
+//go:build linux
+
+package resfsbtrfssubvol
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/opensvc/om3/core/actionrollback"
+	"github.com/opensvc/om3/core/provisioned"
+	"github.com/opensvc/om3/core/resource"
+	"github.com/opensvc/om3/core/status"
+	"github.com/opensvc/om3/drivers/resfsdir"
+	"github.com/opensvc/om3/util/btrfs"
+	"github.com/opensvc/om3/util/command"
+	"github.com/opensvc/om3/util/df"
+	"github.com/opensvc/om3/util/file"
+	"github.com/opensvc/om3/util/findmnt"
+	"github.com/opensvc/om3/util/sizeconv"
+)
+
+type (
+	T struct {
+		resource.T
+		MountPoint   string         `json:"mnt"`
+		Path         string         `json:"path"`
+		Device       string         `json:"dev"`
+		SubvolID     int            `json:"subvolid"`
+		MountOptions string         `json:"mnt_opt"`
+		StatTimeout  *time.Duration `json:"stat_timeout"`
+		Size         *int64         `json:"size"`
+		User         *user.User     `json:"user"`
+		Group        *user.Group    `json:"group"`
+		Perm         *os.FileMode   `json:"perm"`
+	}
+)
+
+var (
+	// showSubvol returns the subvolume information. It can be replaced
+	// for tests.
+	showSubvol = func(subvol *btrfs.Subvolume) (btrfs.SubvolumeInfo, error) {
+		return subvol.Show()
+	}
+)
+
+func New() resource.Driver {
+	t := &T{}
+	return t
+}
+
+func (t *T) Start(ctx context.Context) error {
+	if err := t.mount(ctx); err != nil {
+		return err
+	}
+	if err := t.fsDir().Start(ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t *T) Stop(ctx context.Context) error {
+	if v, err := t.isMounted(); err != nil {
+		return err
+	} else if !v {
+		t.Log().Infof("%s already umounted from %s", t.Label(), t.mountPoint())
+		return nil
+	}
+	return t.umount()
+}
+
+func (t *T) Status(ctx context.Context) status.T {
+	if t.MountPoint == "" {
+		t.StatusLog().Info("mnt is not defined")
+		return status.NotApplicable
+	}
+	if t.Path == "" && (t.SubvolID == 0 || t.Device == "") {
+		t.StatusLog().Info("path or dev and subvolid are not defined")
+		return status.NotApplicable
+	}
+	if v, err := t.isMounted(); err != nil {
+		t.StatusLog().Error("%s", err)
+		return status.Undef
+	} else if !v {
+		return status.Down
+	}
+	if t.Size != nil && t.Path != "" {
+		if qgroup, err := t.subvol().Qgroup(); err != nil {
+			t.StatusLog().Warn("%s", err)
+		} else if qgroup.Free() == 0 {
+			t.StatusLog().Warn("%s: quota exhausted", qgroup)
+		}
+	}
+	return status.Up
+}
+
+func (t *T) Label() string {
+	var s string
+	switch {
+	case t.Path != "":
+		s = t.Path
+	default:
+		s = fmt.Sprintf("%s:%d", t.Device, t.SubvolID)
+	}
+	if m := t.mountPoint(); m != "" {
+		s += "@" + m
+	}
+	return s
+}
+
+func (t *T) Info(ctx context.Context) (resource.InfoKeys, error) {
+	m := resource.InfoKeys{
+		{Key: "path", Value: t.Path},
+		{Key: "mnt", Value: t.mountPoint()},
+		{Key: "mnt_opt", Value: t.MountOptions},
+	}
+	if t.Path == "" {
+		return m, nil
+	}
+	if info, err := t.subvol().Show(); err == nil {
+		m = append(m, resource.InfoKey{Key: "subvolid", Value: fmt.Sprint(info.ID)})
+	}
+	if qgroup, err := t.subvol().Qgroup(); err == nil {
+		m = append(m,
+			resource.InfoKey{Key: "referenced", Value: sizeconv.BSizeCompact(float64(qgroup.Referenced))},
+			resource.InfoKey{Key: "exclusive", Value: sizeconv.BSizeCompact(float64(qgroup.Exclusive))},
+		)
+		if qgroup.MaxReferenced > 0 {
+			m = append(m, resource.InfoKey{Key: "limit", Value: sizeconv.BSizeCompact(float64(qgroup.MaxReferenced))})
+		}
+	}
+	return m, nil
+}
+
+func (t *T) Head() string {
+	return t.MountPoint
+}
+
+func (t *T) fsDir() *resfsdir.T {
+	r := resfsdir.New().(*resfsdir.T)
+	r.SetRID(t.RID())
+	r.SetObject(t.GetObject())
+	r.Path = t.MountPoint
+	r.User = t.User
+	r.Group = t.Group
+	r.Perm = t.Perm
+	return r
+}
+
+func (t *T) mountPoint() string {
+	return filepath.Clean(t.MountPoint)
+}
+
+func (t *T) subvol() *btrfs.Subvolume {
+	return &btrfs.Subvolume{
+		Path: t.Path,
+		Log:  t.Log(),
+	}
+}
+
+// device returns the btrfs filesystem device to mount the subvolume from.
+func (t *T) device() (string, error) {
+	if t.Device != "" {
+		return t.Device, nil
+	}
+	if t.Path == "" {
+		return "", fmt.Errorf("dev is required to mount by subvolid")
+	}
+	entries, err := df.ContainingMountUsage(t.Path)
+	if err != nil {
+		return "", err
+	}
+	if len(entries) == 0 {
+		return "", fmt.Errorf("no mounted filesystem hosts %s", t.Path)
+	}
+	return entries[0].Device, nil
+}
+
+// mountOptions returns the mount options, including the subvolume
+// selection option.
+func (t *T) mountOptions() (string, error) {
+	l := make([]string, 0)
+	for _, s := range strings.Split(t.MountOptions, ",") {
+		if s == "" || strings.HasPrefix(s, "subvol=") || strings.HasPrefix(s, "subvolid=") {
+			continue
+		}
+		l = append(l, s)
+	}
+	if t.SubvolID > 0 {
+		l = append(l, fmt.Sprintf("subvolid=%d", t.SubvolID))
+	} else {
+		info, err := showSubvol(t.subvol())
+		if err != nil {
+			return "", err
+		}
+		l = append(l, "subvol="+info.Path)
+	}
+	return strings.Join(l, ","), nil
+}
+
+func (t *T) isMounted() (bool, error) {
+	dev, err := t.device()
+	if err != nil {
+		return false, err
+	}
+	return findmnt.Has(dev, t.mountPoint())
+}
+
+func (t *T) mount(ctx context.Context) error {
+	if v, err := t.isMounted(); err != nil {
+		return err
+	} else if v {
+		t.Log().Infof("%s already mounted on %s", t.Label(), t.mountPoint())
+		return nil
+	}
+	dev, err := t.device()
+	if err != nil {
+		return err
+	}
+	opts, err := t.mountOptions()
+	if err != nil {
+		return err
+	}
+	if err := t.createMountPoint(); err != nil {
+		return err
+	}
+	cmd := command.New(
+		command.WithName("mount"),
+		command.WithVarArgs("-t", "btrfs", "-o", opts, dev, t.mountPoint()),
+		command.WithLogger(t.Log()),
+		command.WithTimeout(time.Minute),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	if err := cmd.Run(); err != nil {
+		return err
+	}
+	actionrollback.Register(ctx, func() error {
+		return t.umount()
+	})
+	return nil
+}
+
+func (t *T) umount() error {
+	cmd := command.New(
+		command.WithName("umount"),
+		command.WithVarArgs(t.mountPoint()),
+		command.WithLogger(t.Log()),
+		command.WithTimeout(time.Minute),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	return cmd.Run()
+}
+
+func (t *T) createMountPoint() error {
+	if v, err := file.ExistsAndDir(t.MountPoint); err != nil {
+		return err
+	} else if v {
+		return nil
+	}
+	if file.Exists(t.MountPoint) {
+		return fmt.Errorf("mountpoint %s already exists but is not a directory", t.MountPoint)
+	}
+	t.Log().Infof("create missing mountpoint %s", t.MountPoint)
+	if err := os.MkdirAll(t.MountPoint, 0755); err != nil {
+		return fmt.Errorf("error creating mountpoint %s: %s", t.MountPoint, err)
+	}
+	return nil
+}
+
+func (t *T) ProvisionLeader(ctx context.Context) error {
+	if t.Path == "" {
+		t.Log().Infof("path is not defined: skip subvolume creation")
+		return nil
+	}
+	subvol := t.subvol()
+	if v, err := subvol.Exists(); err != nil {
+		return err
+	} else if v {
+		t.Log().Infof("subvolume %s already exists", t.Path)
+	} else if err := subvol.Create(); err != nil {
+		return err
+	}
+	if t.Size == nil {
+		return nil
+	}
+	if err := subvol.EnableQuota(); err != nil {
+		return err
+	}
+	return subvol.SetLimit(t.Size)
+}
+
+func (t *T) UnprovisionLeader(ctx context.Context) error {
+	if t.Path == "" {
+		t.Log().Infof("path is not defined: skip subvolume deletion")
+		return nil
+	}
+	subvol := t.subvol()
+	if v, err := subvol.Exists(); err != nil {
+		return err
+	} else if !v {
+		t.Log().Infof("subvolume %s is already deleted", t.Path)
+	} else if err := subvol.Delete(); err != nil {
+		return err
+	}
+	return t.removeMountPoint()
+}
+
+func (t *T) Provisioned() (provisioned.T, error) {
+	if t.Path == "" {
+		return provisioned.NotApplicable, nil
+	}
+	v, err := t.subvol().Exists()
+	return provisioned.FromBool(v), err
+}
+
+func (t *T) removeMountPoint() error {
+	mnt := t.mountPoint()
+	if mnt == "" {
+		return nil
+	}
+	if file.IsProtected(mnt) {
+		return fmt.Errorf("dir %s is protected: refuse to remove", mnt)
+	}
+	if !file.Exists(mnt) {
+		t.Log().Infof("dir %s is already removed", mnt)
+		return nil
+	}
+	return os.Remove(mnt)
+}
@@ -0,0 +1,55 @@
+//go:build linux
+
+package resfsbtrfssubvol
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/util/btrfs"
+)
+
+func TestMountOptions(t *testing.T) {
+	ori := showSubvol
+	defer func() { showSubvol = ori }()
+	showSubvol = func(subvol *btrfs.Subvolume) (btrfs.SubvolumeInfo, error) {
+		if subvol.Path != "/srv/pool/vol1" {
+			return btrfs.SubvolumeInfo{}, fmt.Errorf("%s is not a subvolume", subvol.Path)
+		}
+		return btrfs.SubvolumeInfo{Path: "pool/vol1"}, nil
+	}
+
+	cases := map[string]struct {
+		r        T
+		expected string
+	}{
+		"subvolid": {
+			T{Path: "/srv/pool/vol1", SubvolID: 257, MountOptions: "compress=zstd"},
+			"compress=zstd,subvolid=257",
+		},
+		"subvol": {
+			T{Path: "/srv/pool/vol1", MountOptions: "compress=zstd,noatime"},
+			"compress=zstd,noatime,subvol=pool/vol1",
+		},
+		"replaces the configured subvolume selection": {
+			T{Path: "/srv/pool/vol1", MountOptions: "subvol=other,subvolid=5,noatime"},
+			"noatime,subvol=pool/vol1",
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			s, err := c.r.mountOptions()
+			require.NoError(t, err)
+			assert.Equal(t, c.expected, s)
+		})
+	}
+
+	t.Run("not a subvolume", func(t *testing.T) {
+		r := T{Path: "/srv/pool/vol2"}
+		_, err := r.mountOptions()
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,68 @@
+//go:build linux
+
+package resfsbtrfssubvol
+
+import (
+	"embed"
+
+	"github.com/opensvc/om3/core/driver"
+	"github.com/opensvc/om3/core/keywords"
+	"github.com/opensvc/om3/core/manifest"
+	"github.com/opensvc/om3/drivers/resfshost"
+	"github.com/opensvc/om3/util/converters"
+)
+
+var (
+	//go:embed text
+	fs embed.FS
+
+	drvID = driver.NewID(driver.GroupFS, "btrfs_subvol")
+)
+
+func init() {
+	driver.Register(drvID, New)
+}
+
+// Manifest exposes to the core the input expected by the driver.
+func (t T) Manifest() *manifest.T {
+	m := manifest.New(drvID, t)
+	m.Add(
+		resfshost.KeywordMountPoint,
+		resfshost.KeywordMountOptions,
+		resfshost.KeywordStatTimeout,
+		resfshost.KeywordUser,
+		resfshost.KeywordGroup,
+		resfshost.KeywordPerm,
+		keywords.Keyword{
+			Option:   "path",
+			Attr:     "Path",
+			Scopable: true,
+			Example:  "/srv/btrfs/vol1",
+			Text:     keywords.NewText(fs, "text/kw/path"),
+		},
+		keywords.Keyword{
+			Option:   "dev",
+			Attr:     "Device",
+			Scopable: true,
+			Example:  "/dev/sdb",
+			Text:     keywords.NewText(fs, "text/kw/dev"),
+		},
+		keywords.Keyword{
+			Option:    "subvolid",
+			Attr:      "SubvolID",
+			Converter: converters.Int,
+			Scopable:  true,
+			Example:   "258",
+			Text:      keywords.NewText(fs, "text/kw/subvolid"),
+		},
+		keywords.Keyword{
+			Option:       "size",
+			Attr:         "Size",
+			Converter:    converters.Size,
+			Scopable:     true,
+			Text:         keywords.NewText(fs, "text/kw/size"),
+			Provisioning: true,
+		},
+	)
+	return m
+}
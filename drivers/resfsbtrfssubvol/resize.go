@@ -0,0 +1,33 @@
+//go:build linux
+
+package resfsbtrfssubvol
+
+import (
+	"context"
+)
+
+// CanResize returns nil: the subvolume qgroup limit can be changed
+// online.
+func (t *T) CanResize() error {
+	return nil
+}
+
+// Resize raises the subvolume qgroup limit to the new size.
+func (t *T) Resize(ctx context.Context, size int64) error {
+	if t.Path == "" {
+		t.Log().Infof("path is not defined: skip qgroup limit")
+		return nil
+	}
+	subvol := t.subvol()
+	if v, err := subvol.Exists(); err != nil {
+		return err
+	} else if !v {
+		t.Log().Infof("skip: subvolume %s does not exist", t.Path)
+		return nil
+	}
+	if err := subvol.EnableQuota(); err != nil {
+		return err
+	}
+	t.Size = &size
+	return subvol.SetLimit(t.Size)
+}
@@ -92,6 +92,16 @@ func (t T) Provisioned() (provisioned.T, error) {
 	return provisioned.NotApplicable, nil
 }
 
+// CanResize returns nil: the directory has no size of its own.
+func (t T) CanResize() error {
+	return nil
+}
+
+// Resize is a no-op: the directory has no size of its own.
+func (t T) Resize(ctx context.Context, size int64) error {
+	return nil
+}
+
 func (t T) create(ctx context.Context) error {
 	p := t.path()
 	if v, err := file.ExistsAndDir(p); err != nil {
@@ -151,6 +151,16 @@ func (t T) Provisioned() (provisioned.T, error) {
 	return provisioned.NotApplicable, nil
 }
 
+// CanResize returns nil: the flag has no size.
+func (t T) CanResize() error {
+	return nil
+}
+
+// Resize is a no-op: the flag has no size.
+func (t T) Resize(ctx context.Context, size int64) error {
+	return nil
+}
+
 func (t T) exists() bool {
 	return file.Exists(t.file())
 }
@@ -0,0 +1,41 @@
+package resfshost
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/opensvc/om3/util/filesystems"
+)
+
+// CanResize returns an error if the filesystem type can not be grown
+// online, or if the filesystem is not mounted.
+func (t *T) CanResize() error {
+	if _, ok := t.fs().(filesystems.Grower); !ok {
+		return fmt.Errorf("%s: online grow is not supported for type %s", t.RID(), t.Type)
+	}
+	if v, err := t.isMounted(); err != nil {
+		return fmt.Errorf("%s: %w", t.RID(), err)
+	} else if !v {
+		return fmt.Errorf("%s: %s is not mounted, the online grow needs a started volume", t.RID(), t.mountPoint())
+	}
+	return nil
+}
+
+// Resize grows the filesystem to the size of its device. The grow is
+// done online, so the filesystem must be mounted.
+func (t *T) Resize(ctx context.Context, size int64) error {
+	fs, ok := t.fs().(filesystems.Grower)
+	if !ok {
+		return fmt.Errorf("online grow is not supported for type %s", t.Type)
+	}
+	if v, err := t.isMounted(); err != nil {
+		return err
+	} else if !v {
+		return fmt.Errorf("%s is not mounted", t.mountPoint())
+	}
+	devpath := t.devpath()
+	if devpath == "" {
+		return fmt.Errorf("%s real dev path is empty", t.Device)
+	}
+	return fs.Grow(devpath, t.mountPoint())
+}
@@ -0,0 +1,47 @@
+package resfszfs
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// CanResize returns nil: the dataset quotas and reservations can be
+// changed online.
+func (t T) CanResize() error {
+	return nil
+}
+
+// Resize sets the dataset quotas and reservations to their value
+// computed for the new size, so the "x<factor>" expressions follow it.
+func (t T) Resize(ctx context.Context, size int64) error {
+	if v, err := t.fs().Exists(); err != nil {
+		return fmt.Errorf("fs existance check: %w", err)
+	} else if !v {
+		t.Log().Infof("skip: dataset %s does not exist", t.Device)
+		return nil
+	}
+	t.Size = &size
+	props := []struct {
+		name string
+		fn   func() (*int64, error)
+	}{
+		{"refquota", t.refquota},
+		{"quota", t.quota},
+		{"refreservation", t.refreservation},
+		{"reservation", t.reservation},
+	}
+	for _, prop := range props {
+		v, err := prop.fn()
+		if err != nil {
+			return err
+		}
+		if v == nil {
+			continue
+		}
+		if err := t.fs().SetProperty(prop.name, strconv.FormatInt(*v, 10)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"io"
 	"net"
 	"os"
+	"reflect"
 	"strconv"
 	"strings"
 	"time"
@@ -123,8 +124,12 @@ func NewData() Data {
 }
 
 func (t Data) Render() string {
+	title := hostname.Hostname()
+	if s, ok := t.Nodename.Value.(string); ok && s != "" {
+		title = s
+	}
 	tr := tree.New()
-	tr.AddColumn().AddText(hostname.Hostname()).SetColor(rawconfig.Color.Bold)
+	tr.AddColumn().AddText(title).SetColor(rawconfig.Color.Bold)
 	tr.AddColumn().AddText("Value").SetColor(rawconfig.Color.Bold)
 	tr.AddColumn().AddText("Source").SetColor(rawconfig.Color.Bold)
 
@@ -385,3 +390,56 @@ func parseColumned(p string) ([][]string, error) {
 	}
 	return l, nil
 }
+
+// Fields returns the asset values in the Data struct declaration order,
+// with their Name set to the json key.
+func (t Data) Fields() []Value {
+	l := make([]Value, 0)
+	v := reflect.ValueOf(t)
+	for i := 0; i < v.NumField(); i++ {
+		av, ok := v.Field(i).Interface().(Value)
+		if !ok {
+			continue
+		}
+		av.Name = strings.Split(v.Type().Field(i).Tag.Get("json"), ",")[0]
+		l = append(l, av)
+	}
+	return l
+}
+
+// CSVHeader returns the names of the asset values, in the CSVRecord order.
+func (t Data) CSVHeader() []string {
+	fields := t.Fields()
+	l := make([]string, len(fields))
+	for i, av := range fields {
+		l[i] = av.Name
+	}
+	return l
+}
+
+// CSVRecord returns the asset values formatted as strings. The lists
+// (hardware, lan, hba, targets, uids and gids) are not included.
+func (t Data) CSVRecord() []string {
+	fields := t.Fields()
+	l := make([]string, len(fields))
+	for i, av := range fields {
+		if av.Value != nil {
+			l[i] = fmt.Sprint(av.Value)
+		}
+	}
+	return l
+}
+
+// Unstructured returns the asset scalar values indexed by name.
+func (t Data) Unstructured() map[string]any {
+	m := make(map[string]any)
+	for _, av := range t.Fields() {
+		m[av.Name] = map[string]any{
+			"source": av.Source,
+			"title":  av.Title,
+			"value":  av.Value,
+			"error":  av.Error,
+		}
+	}
+	return m
+}
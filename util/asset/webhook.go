@@ -0,0 +1,106 @@
+package asset
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"text/template"
+	"time"
+)
+
+type (
+	// Webhook posts the asset data to a generic http endpoint, for
+	// example a CMDB. The request body is the json asset data, or the
+	// output of Template if set.
+	Webhook struct {
+		URL string
+
+		// Template is a text/template rendering the json request body
+		// from the asset Data. The "json" function formats a value as
+		// json.
+		Template string
+
+		// Headers are the "Name: value" http headers added to the request.
+		Headers []string
+
+		// Insecure disables the server x509 certificate verification.
+		Insecure bool
+
+		Timeout time.Duration
+	}
+)
+
+var (
+	webhookFuncs = template.FuncMap{
+		"json": func(v any) (string, error) {
+			b, err := json.Marshal(v)
+			return string(b), err
+		},
+	}
+)
+
+// Body returns the request body for the asset data.
+func (t Webhook) Body(data Data) ([]byte, error) {
+	if t.Template == "" {
+		return json.Marshal(data)
+	}
+	tmpl, err := template.New("asset").Funcs(webhookFuncs).Parse(t.Template)
+	if err != nil {
+		return nil, fmt.Errorf("asset webhook template: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("asset webhook template: %w", err)
+	}
+	b := buf.Bytes()
+	if !json.Valid(b) {
+		return nil, fmt.Errorf("asset webhook template: the rendered body is not valid json")
+	}
+	return b, nil
+}
+
+// Push posts the asset data to the webhook url.
+func (t Webhook) Push(data Data) error {
+	body, err := t.Body(data)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, t.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for _, s := range t.Headers {
+		name, value, ok := strings.Cut(s, ":")
+		if !ok {
+			return fmt.Errorf("asset webhook header %q: expected 'name: value'", s)
+		}
+		req.Header.Set(strings.TrimSpace(name), strings.TrimSpace(value))
+	}
+	timeout := t.Timeout
+	if timeout == 0 {
+		timeout = 10 * time.Second
+	}
+	client := &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				InsecureSkipVerify: t.Insecure,
+			},
+		},
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("asset webhook %s: %s: %s", t.URL, resp.Status, strings.TrimSpace(string(b)))
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package asset
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhook(t *testing.T) {
+	data := NewData()
+	data.Nodename = Value{Title: "nodename", Source: SrcProbe, Value: "node1"}
+	data.Serial = Value{Title: "serial", Source: SrcConfig, Value: "ABC\"123"}
+
+	var (
+		body   []byte
+		header http.Header
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = io.ReadAll(r.Body)
+		header = r.Header
+	}))
+	defer srv.Close()
+
+	t.Run("default body", func(t *testing.T) {
+		hook := Webhook{URL: srv.URL, Headers: []string{"Authorization: Bearer xx"}}
+		require.NoError(t, hook.Push(data))
+		var got Data
+		require.NoError(t, json.Unmarshal(body, &got))
+		assert.Equal(t, "node1", got.Nodename.Value)
+		assert.Equal(t, "Bearer xx", header.Get("Authorization"))
+		assert.Equal(t, "application/json", header.Get("Content-Type"))
+	})
+
+	t.Run("template", func(t *testing.T) {
+		hook := Webhook{URL: srv.URL, Template: `{"name": {{ json .Nodename.Value }}, "serial": {{ json .Serial.Value }}}`}
+		require.NoError(t, hook.Push(data))
+		assert.JSONEq(t, `{"name": "node1", "serial": "ABC\"123"}`, string(body))
+	})
+
+	t.Run("invalid json template", func(t *testing.T) {
+		hook := Webhook{URL: srv.URL, Template: `{"name": {{ .Nodename.Value }}}`}
+		assert.Error(t, hook.Push(data))
+	})
+
+	t.Run("error status", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "denied", http.StatusForbidden)
+		}))
+		defer srv.Close()
+		hook := Webhook{URL: srv.URL}
+		assert.ErrorContains(t, hook.Push(data), "denied")
+	})
+}
+
+func TestDataCSV(t *testing.T) {
+	data := NewData()
+	data.Nodename = Value{Value: "node1"}
+	data.MemBytes = Value{Value: 1024}
+	header := data.CSVHeader()
+	record := data.CSVRecord()
+	require.Len(t, record, len(header))
+	assert.Equal(t, "nodename", header[0])
+	assert.Equal(t, "node1", record[0])
+	for i, name := range header {
+		if name == "mem_bytes" {
+			assert.Equal(t, "1024", record[i])
+		}
+	}
+}
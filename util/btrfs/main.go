@@ -0,0 +1,14 @@
+// Package btrfs wraps the btrfs commands managing subvolumes and their
+// qgroup quotas.
+package btrfs
+
+import (
+	"os/exec"
+)
+
+func IsCapable() bool {
+	if _, err := exec.LookPath("btrfs"); err != nil {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,56 @@
+package btrfs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSubvolumeShow(t *testing.T) {
+	b := []byte(`pool/vol1
+	Name: 			vol1
+	UUID: 			2f0b8a0e-4b1f-9d4b-9a59-0f4e0f1cbb0a
+	Parent UUID: 		-
+	Received UUID: 		-
+	Creation time: 		2024-03-01 10:12:13 +0100
+	Subvolume ID: 		258
+	Generation: 		31
+	Gen at creation: 	12
+	Parent ID: 		256
+	Top level ID: 		256
+	Flags: 			-
+	Snapshot(s):
+`)
+	info, err := parseSubvolumeShow(b)
+	require.NoError(t, err)
+	assert.Equal(t, SubvolumeInfo{
+		Path:     "pool/vol1",
+		Name:     "vol1",
+		UUID:     "2f0b8a0e-4b1f-9d4b-9a59-0f4e0f1cbb0a",
+		ID:       258,
+		ParentID: 256,
+	}, info)
+
+	_, err = parseSubvolumeShow([]byte("ERROR: not a subvolume: /tmp\n"))
+	assert.Error(t, err)
+}
+
+func TestParseQgroupShow(t *testing.T) {
+	b := []byte(`qgroupid         rfer         excl     max_rfer     max_excl 
+--------         ----         ----     --------     -------- 
+0/5             16384        16384         none         none 
+0/258       104857600     98304000   1073741824         none 
+`)
+	l, err := parseQgroupShow(b)
+	require.NoError(t, err)
+	require.Len(t, l, 2)
+	assert.Equal(t, Qgroup{
+		ID:            "0/258",
+		Referenced:    104857600,
+		Exclusive:     98304000,
+		MaxReferenced: 1073741824,
+	}, l[1])
+	assert.Equal(t, int64(1073741824-104857600), l[1].Free())
+	assert.Equal(t, int64(-1), l[0].Free())
+}
@@ -0,0 +1,154 @@
+package btrfs
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog"
+
+	"github.com/opensvc/om3/util/command"
+	"github.com/opensvc/om3/util/sizeconv"
+)
+
+type (
+	// Qgroup is the space accounting of a subvolume, as reported by
+	// 'btrfs qgroup show'. A zero limit means no limit.
+	Qgroup struct {
+		ID            string
+		Referenced    int64
+		Exclusive     int64
+		MaxReferenced int64
+		MaxExclusive  int64
+	}
+)
+
+var (
+	qgroupIDRegexp = regexp.MustCompile(`^\d+/\d+$`)
+)
+
+// EnableQuota enables the qgroup accounting on the filesystem hosting
+// the subvolume. It is a no-op if the accounting is already enabled.
+func (t *Subvolume) EnableQuota() error {
+	cmd := command.New(
+		command.WithName("btrfs"),
+		command.WithVarArgs("quota", "enable", t.Path),
+		command.WithLogger(t.Log),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	return cmd.Run()
+}
+
+// SetLimit limits the space referenced by the subvolume to <size> bytes.
+// A nil size removes the limit.
+func (t *Subvolume) SetLimit(size *int64) error {
+	limit := "none"
+	if size != nil {
+		limit = fmt.Sprint(*size)
+	}
+	cmd := command.New(
+		command.WithName("btrfs"),
+		command.WithVarArgs("qgroup", "limit", limit, t.Path),
+		command.WithLogger(t.Log),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	return cmd.Run()
+}
+
+// Qgroup returns the space accounting of the subvolume level 0 qgroup.
+func (t *Subvolume) Qgroup() (Qgroup, error) {
+	info, err := t.Show()
+	if err != nil {
+		return Qgroup{}, err
+	}
+	cmd := command.New(
+		command.WithName("btrfs"),
+		command.WithVarArgs("qgroup", "show", "--raw", "-re", "-f", t.Path),
+		command.WithBufferedStdout(),
+		command.WithLogger(t.Log),
+		command.WithCommandLogLevel(zerolog.DebugLevel),
+		command.WithStdoutLogLevel(zerolog.DebugLevel),
+		command.WithStderrLogLevel(zerolog.DebugLevel),
+	)
+	b, err := cmd.Output()
+	if err != nil {
+		return Qgroup{}, err
+	}
+	l, err := parseQgroupShow(b)
+	if err != nil {
+		return Qgroup{}, err
+	}
+	id := fmt.Sprintf("0/%d", info.ID)
+	for _, qgroup := range l {
+		if qgroup.ID == id {
+			return qgroup, nil
+		}
+	}
+	return Qgroup{}, fmt.Errorf("qgroup %s not found: is the quota enabled ?", id)
+}
+
+// Free returns the space left before the referenced limit is reached,
+// or -1 if the qgroup has no limit.
+func (t Qgroup) Free() int64 {
+	if t.MaxReferenced == 0 {
+		return -1
+	}
+	if free := t.MaxReferenced - t.Referenced; free > 0 {
+		return free
+	}
+	return 0
+}
+
+func (t Qgroup) String() string {
+	s := fmt.Sprintf("qgroup %s referenced %s exclusive %s", t.ID,
+		sizeconv.BSizeCompact(float64(t.Referenced)),
+		sizeconv.BSizeCompact(float64(t.Exclusive)),
+	)
+	if t.MaxReferenced > 0 {
+		s += fmt.Sprintf(" limit %s", sizeconv.BSizeCompact(float64(t.MaxReferenced)))
+	}
+	return s
+}
+
+func parseQgroupShow(b []byte) ([]Qgroup, error) {
+	parseLimit := func(s string) (int64, error) {
+		if s == "none" || s == "-" {
+			return 0, nil
+		}
+		return strconv.ParseInt(s, 10, 64)
+	}
+	l := make([]Qgroup, 0)
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 5 || !qgroupIDRegexp.MatchString(fields[0]) {
+			// headers and separators
+			continue
+		}
+		var (
+			qgroup = Qgroup{ID: fields[0]}
+			err    error
+		)
+		if qgroup.Referenced, err = strconv.ParseInt(fields[1], 10, 64); err != nil {
+			return nil, fmt.Errorf("qgroup %s referenced: %w", qgroup.ID, err)
+		}
+		if qgroup.Exclusive, err = strconv.ParseInt(fields[2], 10, 64); err != nil {
+			return nil, fmt.Errorf("qgroup %s exclusive: %w", qgroup.ID, err)
+		}
+		if qgroup.MaxReferenced, err = parseLimit(fields[3]); err != nil {
+			return nil, fmt.Errorf("qgroup %s max referenced: %w", qgroup.ID, err)
+		}
+		if qgroup.MaxExclusive, err = parseLimit(fields[4]); err != nil {
+			return nil, fmt.Errorf("qgroup %s max exclusive: %w", qgroup.ID, err)
+		}
+		l = append(l, qgroup)
+	}
+	return l, nil
+}
@@ -0,0 +1,135 @@
+package btrfs
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog"
+
+	"github.com/opensvc/om3/util/command"
+	"github.com/opensvc/om3/util/plog"
+)
+
+type (
+	// Subvolume is a btrfs subvolume, designated by its path on a mounted
+	// btrfs filesystem.
+	Subvolume struct {
+		Path string
+		Log  *plog.Logger
+	}
+
+	// SubvolumeInfo is the parsed output of 'btrfs subvolume show'.
+	SubvolumeInfo struct {
+		// Path is the subvolume path relative to the filesystem top-level
+		// subvolume, as expected by the subvol= mount option.
+		Path     string
+		Name     string
+		UUID     string
+		ID       int
+		ParentID int
+	}
+)
+
+// Exists returns true if Path is a btrfs subvolume.
+func (t *Subvolume) Exists() (bool, error) {
+	if _, err := os.Stat(t.Path); errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	if _, err := t.Show(); err != nil {
+		return false, fmt.Errorf("%s exists but is not a btrfs subvolume: %w", t.Path, err)
+	}
+	return true, nil
+}
+
+// Create creates the subvolume.
+func (t *Subvolume) Create() error {
+	cmd := command.New(
+		command.WithName("btrfs"),
+		command.WithVarArgs("subvolume", "create", t.Path),
+		command.WithLogger(t.Log),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	return cmd.Run()
+}
+
+// Delete deletes the subvolume and its qgroup.
+func (t *Subvolume) Delete() error {
+	cmd := command.New(
+		command.WithName("btrfs"),
+		command.WithVarArgs("subvolume", "delete", "--commit-after", t.Path),
+		command.WithLogger(t.Log),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	return cmd.Run()
+}
+
+// Show returns the subvolume information.
+func (t *Subvolume) Show() (SubvolumeInfo, error) {
+	cmd := command.New(
+		command.WithName("btrfs"),
+		command.WithVarArgs("subvolume", "show", t.Path),
+		command.WithBufferedStdout(),
+		command.WithLogger(t.Log),
+		command.WithCommandLogLevel(zerolog.DebugLevel),
+		command.WithStdoutLogLevel(zerolog.DebugLevel),
+		command.WithStderrLogLevel(zerolog.DebugLevel),
+	)
+	b, err := cmd.Output()
+	if err != nil {
+		return SubvolumeInfo{}, err
+	}
+	return parseSubvolumeShow(b)
+}
+
+func parseSubvolumeShow(b []byte) (SubvolumeInfo, error) {
+	var data SubvolumeInfo
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	first := true
+	for scanner.Scan() {
+		line := scanner.Text()
+		if first {
+			data.Path = strings.TrimSpace(line)
+			first = false
+			continue
+		}
+		k, v, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		v = strings.TrimSpace(v)
+		switch strings.TrimSpace(k) {
+		case "Name":
+			data.Name = v
+		case "UUID":
+			data.UUID = v
+		case "Subvolume ID":
+			i, err := strconv.Atoi(v)
+			if err != nil {
+				return data, fmt.Errorf("subvolume id %s: %w", v, err)
+			}
+			data.ID = i
+		case "Parent ID":
+			i, err := strconv.Atoi(v)
+			if err != nil {
+				return data, fmt.Errorf("subvolume parent id %s: %w", v, err)
+			}
+			data.ParentID = i
+		}
+	}
+	if data.ID == 0 {
+		return data, fmt.Errorf("unexpected 'btrfs subvolume show' output: %s", string(b))
+	}
+	return data, nil
+}
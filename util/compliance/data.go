@@ -71,6 +71,9 @@ func (t T) GetObjectData(p naming.Path, modsets []string) (Data, error) {
 }
 
 func (t T) GetData(modsets []string) (Data, error) {
+	if t.local != nil {
+		return t.local.Data(t.labels, modsets)
+	}
 	if t.objectPath.IsZero() {
 		return t.GetNodeData(modsets)
 	} else {
@@ -227,5 +230,8 @@ func (t Data) Ruleset(name string) Ruleset {
 }
 
 func (t Data) RulesetsMD5() string {
+	if rset, ok := t.Rsets[localRulesetName]; ok {
+		return rset.GetString("ruleset_md5")
+	}
 	return t.Ruleset("osvc_collector").GetString("ruleset_md5")
 }
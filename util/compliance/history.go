@@ -0,0 +1,132 @@
+package compliance
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/opensvc/om3/core/rawconfig"
+)
+
+type (
+	// RunHistory is the list of the past runs, most recent first.
+	RunHistory []*Run
+)
+
+const (
+	// historySize is the number of runs kept in the local history of a
+	// node or object.
+	historySize = 100
+
+	historyTimeFormat = "20060102T150405.000000000"
+)
+
+func (t T) subjectHistoryDir() string {
+	if t.objectPath.IsZero() {
+		return filepath.Join(t.historyDir, "node")
+	}
+	return filepath.Join(t.historyDir, "object", t.objectPath.Namespace, t.objectPath.Kind.String(), t.objectPath.Name)
+}
+
+// Save records the run in the local history, and purges the oldest runs.
+func (t *Run) Save() error {
+	if len(t.ModuleActions) == 0 {
+		return nil
+	}
+	dir := t.main.subjectHistoryDir()
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	b, err := json.Marshal(t)
+	if err != nil {
+		return err
+	}
+	filename := filepath.Join(dir, t.BeginAt.UTC().Format(historyTimeFormat)+".json")
+	if err := os.WriteFile(filename, b, 0600); err != nil {
+		return err
+	}
+	files, err := t.main.historyFiles()
+	if err != nil {
+		return err
+	}
+	for i := historySize; i < len(files); i++ {
+		if err := os.Remove(files[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// historyFiles returns the local history files, most recent first.
+func (t T) historyFiles() ([]string, error) {
+	files, err := filepath.Glob(filepath.Join(t.subjectHistoryDir(), "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(files)))
+	return files, nil
+}
+
+// History returns the last n runs recorded in the local history, most
+// recent first. All the recorded runs are returned if n is zero.
+func (t T) History(n int) (RunHistory, error) {
+	files, err := t.historyFiles()
+	if err != nil {
+		return nil, err
+	}
+	if n > 0 && len(files) > n {
+		files = files[:n]
+	}
+	l := make(RunHistory, 0, len(files))
+	for _, filename := range files {
+		b, err := os.ReadFile(filename)
+		if err != nil {
+			return nil, err
+		}
+		run := &Run{}
+		if err := json.Unmarshal(b, run); err != nil {
+			return nil, fmt.Errorf("%s: %w", filename, err)
+		}
+		l = append(l, run)
+	}
+	return l, nil
+}
+
+func (t RunHistory) Render() string {
+	buff := ""
+	for _, run := range t {
+		stat := run.Stat()
+		buff += fmt.Sprintf("- %s %s\n", run.BeginAt.Local().Format(time.RFC3339), rawconfig.Colorize.Bold(run.Action))
+		if len(run.Modsets) > 0 {
+			buff += fmt.Sprintf("  Modulesets:        %s\n", strings.Join(run.Modsets, ","))
+		}
+		if len(run.Mods) > 0 {
+			buff += fmt.Sprintf("  Modules:           %s\n", strings.Join(run.Mods, ","))
+		}
+		buff += fmt.Sprintf("  Modules Execution: %s\n", run.runDuration())
+		buff += fmt.Sprintf("  Modules Count:     %d\n", stat.Total)
+		if stat.Total > 0 {
+			buff += fmt.Sprintf("  Checks by State:   %d ok, %d nok, %d n/a\n", stat.Ok, stat.Nok, stat.NA)
+		}
+		// the last check of each module gives its status
+		modules := make([]string, 0)
+		last := make(map[string]*ModuleAction)
+		for _, ma := range run.ModuleActions {
+			if ma.Action != ActionCheck {
+				continue
+			}
+			if _, ok := last[ma.Module]; !ok {
+				modules = append(modules, ma.Module)
+			}
+			last[ma.Module] = ma
+		}
+		for _, name := range modules {
+			buff += fmt.Sprintf("   %-16s %s\n", name, last[name].Status())
+		}
+	}
+	return buff
+}
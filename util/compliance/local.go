@@ -0,0 +1,356 @@
+package compliance
+
+import (
+	"crypto/md5"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/danwakefield/fnmatch"
+	"sigs.k8s.io/yaml"
+)
+
+type (
+	// Local is the set of rulesets and modulesets defined in local files
+	// or cfg objects, used instead of the collector ones when the node has
+	// no collector.
+	//
+	// The rulesets and modulesets are attached to the nodes and objects
+	// whose labels match their selector.
+	Local struct {
+		Rulesets   map[string]LocalRuleset
+		Modulesets map[string]LocalModuleset
+
+		sources []string
+		sum     []byte
+	}
+
+	// LocalDocument is the format of a local compliance definition file,
+	// in json or yaml.
+	LocalDocument struct {
+		Rulesets   []LocalRuleset   `json:"rulesets,omitempty"`
+		Modulesets []LocalModuleset `json:"modulesets,omitempty"`
+	}
+
+	LocalRuleset struct {
+		Name string `json:"name"`
+
+		// Selector is the label selector of the nodes and objects the
+		// ruleset is attached to. A ruleset without selector is only used
+		// by the modulesets referencing it.
+		Selector string     `json:"selector,omitempty"`
+		Vars     []LocalVar `json:"vars,omitempty"`
+	}
+
+	LocalVar struct {
+		Name  string `json:"name"`
+		Class string `json:"class,omitempty"`
+		Value any    `json:"value"`
+	}
+
+	LocalModuleset struct {
+		Name string `json:"name"`
+
+		// Selector is the label selector of the nodes and objects the
+		// moduleset is attached to. A moduleset without selector is only
+		// used as the child of an attached moduleset.
+		Selector string        `json:"selector,omitempty"`
+		Modules  []LocalModule `json:"modules,omitempty"`
+
+		// Modulesets is the list of the children modulesets names.
+		Modulesets []string `json:"modulesets,omitempty"`
+
+		// Rulesets is the list of the rulesets names exported only to the
+		// modules of this moduleset.
+		Rulesets []string `json:"rulesets,omitempty"`
+	}
+
+	LocalModule struct {
+		Name    string `json:"name"`
+		AutoFix bool   `json:"autofix,omitempty"`
+	}
+)
+
+const (
+	// localRulesetName is the name of the builtin ruleset holding the
+	// local definitions checksum, the equivalent of the collector
+	// "osvc_collector" ruleset.
+	localRulesetName = "osvc_local"
+
+	filterViaModuleset = "explicit attachment via moduleset"
+)
+
+var (
+	ErrLocalAttach = errors.New("the local rulesets and modulesets are attached by label selector")
+	ErrLocalNoSuch = errors.New("no such local definition")
+)
+
+func NewLocal() *Local {
+	return &Local{
+		Rulesets:   make(map[string]LocalRuleset),
+		Modulesets: make(map[string]LocalModuleset),
+	}
+}
+
+// LoadDir adds the definitions found in the json and yaml files of the
+// directory. A missing directory is not an error.
+func (t *Local) LoadDir(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		switch filepath.Ext(e.Name()) {
+		case ".json", ".yaml", ".yml":
+		default:
+			continue
+		}
+		filename := filepath.Join(dir, e.Name())
+		b, err := os.ReadFile(filename)
+		if err != nil {
+			return err
+		}
+		if err := t.Load(b, filename); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Load adds the definitions of the json or yaml document b. The source is
+// used to report the duplicate definitions.
+func (t *Local) Load(b []byte, source string) error {
+	var doc LocalDocument
+	if err := yaml.UnmarshalStrict(b, &doc); err != nil {
+		return fmt.Errorf("%s: %w", source, err)
+	}
+	for _, rset := range doc.Rulesets {
+		if rset.Name == "" {
+			return fmt.Errorf("%s: ruleset has no name", source)
+		}
+		if _, ok := t.Rulesets[rset.Name]; ok {
+			return fmt.Errorf("%s: ruleset %s is already defined", source, rset.Name)
+		}
+		if err := ValidateSelector(rset.Selector); err != nil {
+			return fmt.Errorf("%s: ruleset %s: %w", source, rset.Name, err)
+		}
+		t.Rulesets[rset.Name] = rset
+	}
+	for _, modset := range doc.Modulesets {
+		if modset.Name == "" {
+			return fmt.Errorf("%s: moduleset has no name", source)
+		}
+		if _, ok := t.Modulesets[modset.Name]; ok {
+			return fmt.Errorf("%s: moduleset %s is already defined", source, modset.Name)
+		}
+		if err := ValidateSelector(modset.Selector); err != nil {
+			return fmt.Errorf("%s: moduleset %s: %w", source, modset.Name, err)
+		}
+		t.Modulesets[modset.Name] = modset
+	}
+	sum := md5.Sum(append(t.sum, b...))
+	t.sum = sum[:]
+	t.sources = append(t.sources, source)
+	return nil
+}
+
+// Sources returns the list of the loaded definition sources.
+func (t *Local) Sources() []string {
+	return t.sources
+}
+
+// MD5 returns the checksum of the loaded definitions.
+func (t *Local) MD5() string {
+	return fmt.Sprintf("%x", t.sum)
+}
+
+// ValidateSelector returns an error if the label selector is malformed.
+//
+// A selector is a comma separated list of terms, all of which must match:
+//
+//	key=value  the label key is set to value
+//	key!=value the label key is not set to value
+//	key        the label key is set
+//	!key       the label key is not set
+func ValidateSelector(s string) error {
+	for _, term := range strings.Split(s, ",") {
+		term = strings.TrimSpace(term)
+		if s != "" && term == "" {
+			return fmt.Errorf("invalid selector %s: empty term", s)
+		}
+		k, _, _ := strings.Cut(strings.TrimPrefix(term, "!"), "=")
+		if s != "" && strings.TrimSuffix(k, "!") == "" {
+			return fmt.Errorf("invalid selector %s: empty label key in %s", s, term)
+		}
+	}
+	return nil
+}
+
+// MatchSelector returns true if the labels match all the terms of the
+// selector. An empty selector matches nothing.
+func MatchSelector(s string, labels map[string]string) bool {
+	if s == "" {
+		return false
+	}
+	for _, term := range strings.Split(s, ",") {
+		term = strings.TrimSpace(term)
+		var ok bool
+		switch {
+		case strings.Contains(term, "!="):
+			k, v, _ := strings.Cut(term, "!=")
+			current, has := labels[k]
+			ok = !has || current != v
+		case strings.Contains(term, "="):
+			k, v, _ := strings.Cut(term, "=")
+			current, has := labels[k]
+			ok = has && current == v
+		case strings.HasPrefix(term, "!"):
+			_, has := labels[term[1:]]
+			ok = !has
+		default:
+			_, ok = labels[term]
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Data returns the compliance data of the node or object having the
+// labels, in the format returned by the collector. The modsets are added
+// to the attached modulesets.
+func (t *Local) Data(labels map[string]string, modsets []string) (Data, error) {
+	data := Data{
+		Modsets:             make(Modulesets),
+		Rsets:               make(Rulesets),
+		ModsetRsetRelations: make(ModulesetRulesetRelations),
+		ModsetRelations:     make(ModulesetRelations),
+	}
+	var addModset func(name string) error
+	addModset = func(name string) error {
+		if _, ok := data.Modsets[name]; ok {
+			return nil
+		}
+		modset, ok := t.Modulesets[name]
+		if !ok {
+			return fmt.Errorf("%w: moduleset %s", ErrLocalNoSuch, name)
+		}
+		mods := make(Moduleset, len(modset.Modules))
+		for i, mod := range modset.Modules {
+			mods[i] = ModulesetModule{Name: mod.Name, AutoFix: mod.AutoFix}
+		}
+		data.Modsets[name] = mods
+		if len(modset.Rulesets) > 0 {
+			data.ModsetRsetRelations[name] = modset.Rulesets
+		}
+		if len(modset.Modulesets) > 0 {
+			data.ModsetRelations[name] = modset.Modulesets
+		}
+		for _, child := range modset.Modulesets {
+			if err := addModset(child); err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+		}
+		return nil
+	}
+	for _, name := range t.modulesetNames() {
+		if MatchSelector(t.Modulesets[name].Selector, labels) {
+			if err := addModset(name); err != nil {
+				return data, err
+			}
+		}
+	}
+	for _, name := range modsets {
+		if err := addModset(name); err != nil {
+			return data, err
+		}
+	}
+	viaModset := make(map[string]bool)
+	for _, l := range data.ModsetRsetRelations {
+		for _, name := range l {
+			viaModset[name] = true
+		}
+	}
+	for name, rset := range t.Rulesets {
+		matched := MatchSelector(rset.Selector, labels)
+		var filter string
+		switch {
+		case matched && viaModset[name]:
+			filter = rset.Selector + " matching ruleset shown via moduleset"
+		case matched:
+			filter = rset.Selector
+		case viaModset[name]:
+			filter = filterViaModuleset
+		default:
+			continue
+		}
+		vars := make(Vars, len(rset.Vars))
+		for i, v := range rset.Vars {
+			vars[i] = Var{Name: v.Name, Value: v.Value, Class: v.Class}
+			if vars[i].Class == "" {
+				vars[i].Class = "raw"
+			}
+		}
+		data.Rsets[name] = Ruleset{Name: name, Filter: filter, Vars: vars}
+	}
+	for name := range viaModset {
+		if _, ok := t.Rulesets[name]; !ok {
+			return data, fmt.Errorf("%w: ruleset %s", ErrLocalNoSuch, name)
+		}
+	}
+	data.Rsets[localRulesetName] = Ruleset{
+		Name: localRulesetName,
+		Vars: Vars{{Name: "ruleset_md5", Value: t.MD5(), Class: "raw"}},
+	}
+	return data, nil
+}
+
+// ListRulesets returns the sorted names of the local rulesets matching the
+// filter. The filter accepts the collector '%' wildcard.
+func (t *Local) ListRulesets(filter string) []string {
+	l := make([]string, 0)
+	for name := range t.Rulesets {
+		if matchFilter(filter, name) {
+			l = append(l, name)
+		}
+	}
+	sort.Strings(l)
+	return l
+}
+
+// ListModulesets returns the sorted names of the local modulesets matching
+// the filter. The filter accepts the collector '%' wildcard.
+func (t *Local) ListModulesets(filter string) []string {
+	l := make([]string, 0)
+	for _, name := range t.modulesetNames() {
+		if matchFilter(filter, name) {
+			l = append(l, name)
+		}
+	}
+	return l
+}
+
+func (t *Local) modulesetNames() []string {
+	l := make([]string, 0, len(t.Modulesets))
+	for name := range t.Modulesets {
+		l = append(l, name)
+	}
+	sort.Strings(l)
+	return l
+}
+
+func matchFilter(filter, name string) bool {
+	if filter == "" {
+		return true
+	}
+	return fnmatch.Match(strings.ReplaceAll(filter, "%", "*"), name, 0)
+}
@@ -0,0 +1,91 @@
+package compliance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchSelector(t *testing.T) {
+	labels := map[string]string{"env": "prod", "role": "db"}
+	cases := map[string]bool{
+		"":                   false,
+		"env=prod":           true,
+		"env=dev":            false,
+		"env=prod,role=db":   true,
+		"env=prod,role=web":  false,
+		"env!=dev":           true,
+		"env!=prod":          false,
+		"role":               true,
+		"zone":               false,
+		"!zone":              true,
+		"!env":               false,
+		"env=prod, !zone":    true,
+		"env=prod,zone!=eu1": true,
+	}
+	for selector, expected := range cases {
+		assert.Equalf(t, expected, MatchSelector(selector, labels), "selector %q", selector)
+	}
+}
+
+func TestLocalData(t *testing.T) {
+	doc := `
+rulesets:
+- name: base
+  selector: env=prod
+  vars:
+  - name: motd
+    class: file
+    value: {"path": "/etc/motd", "fmt": "hello"}
+- name: db
+  vars:
+  - name: sysctl
+    class: sysctl
+    value: {"key": "vm.swappiness", "value": 10}
+- name: dev
+  selector: env=dev
+modulesets:
+- name: base
+  selector: env=prod
+  modules:
+  - name: base
+    autofix: true
+  modulesets: [db]
+- name: db
+  rulesets: [db]
+  modules:
+  - name: db
+`
+	local := NewLocal()
+	require.NoError(t, local.Load([]byte(doc), "test"))
+	assert.Error(t, local.Load([]byte(doc), "test"), "duplicate definitions are refused")
+
+	data, err := local.Data(map[string]string{"env": "prod"}, nil)
+	require.NoError(t, err)
+	assert.Contains(t, data.Rsets, "base")
+	assert.Contains(t, data.Rsets, "db", "the rulesets referenced by attached modulesets are included")
+	assert.NotContains(t, data.Rsets, "dev")
+	assert.Equal(t, filterViaModuleset, data.Rsets["db"].Filter)
+	assert.Equal(t, "file", data.Rsets["base"].Vars[0].Class)
+	assert.Equal(t, local.MD5(), data.RulesetsMD5())
+
+	assert.ElementsMatch(t, []string{"base"}, data.HeadModulesets())
+	assert.Equal(t, map[string]string{"base": "base", "db": "db"}, data.CascadingModulesetModules("base"))
+
+	data, err = local.Data(map[string]string{"env": "dev"}, nil)
+	require.NoError(t, err)
+	assert.Empty(t, data.Modsets)
+	assert.Contains(t, data.Rsets, "dev")
+
+	data, err = local.Data(map[string]string{"env": "dev"}, []string{"db"})
+	require.NoError(t, err)
+	assert.Contains(t, data.Modsets, "db", "the requested modulesets are included")
+
+	_, err = local.Data(nil, []string{"unknown"})
+	assert.ErrorIs(t, err, ErrLocalNoSuch)
+
+	assert.Equal(t, []string{"base", "db", "dev"}, local.ListRulesets(""))
+	assert.Equal(t, []string{"db", "dev"}, local.ListRulesets("d%"))
+	assert.Equal(t, []string{"base"}, local.ListModulesets("ba%"))
+}
@@ -15,6 +15,12 @@ type (
 		objectPath      naming.Path
 		log             *plog.Logger
 		varDir          string
+		historyDir      string
+
+		// local is the set of local rulesets and modulesets, used instead
+		// of the collector ones when set.
+		local  *Local
+		labels map[string]string
 
 		// variable
 		rulesets Rulesets
@@ -23,8 +29,9 @@ type (
 
 func New() *T {
 	t := &T{
-		log:    plog.NewDefaultLogger().WithPrefix("compliance: ").Attr("pkg", "util/compliance"),
-		varDir: filepath.Join(rawconfig.Paths.Var, "compliance"),
+		log:        plog.NewDefaultLogger().WithPrefix("compliance: ").Attr("pkg", "util/compliance"),
+		varDir:     filepath.Join(rawconfig.Paths.Var, "compliance"),
+		historyDir: filepath.Join(rawconfig.Paths.Var, "compliance_history"),
 	}
 	return t
 }
@@ -44,3 +51,20 @@ func (t *T) SetObjectPath(s naming.Path) {
 func (t *T) SetVarDir(s string) {
 	t.varDir = s
 }
+
+func (t *T) SetHistoryDir(s string) {
+	t.historyDir = s
+}
+
+// SetLocal sets the local rulesets and modulesets to use instead of the
+// collector ones, and the labels of the node or object matched against
+// their selectors.
+func (t *T) SetLocal(local *Local, labels map[string]string) {
+	t.local = local
+	t.labels = labels
+}
+
+// IsLocal returns true if the rulesets and modulesets are the local ones.
+func (t *T) IsLocal() bool {
+	return t.local != nil
+}
@@ -121,6 +121,9 @@ func (t ModulesetRulesetRelations) Render() string {
 
 func (t T) ListModulesets(filter string) ([]string, error) {
 	var err error
+	if t.local != nil {
+		return t.local.ListModulesets(filter), nil
+	}
 	data := make([]string, 0)
 	if filter == "" {
 		filter = "%"
@@ -146,6 +149,9 @@ func (t T) AttachModuleset(s string) error {
 		response *jsonrpc.RPCResponse
 		err      error
 	)
+	if t.local != nil {
+		return ErrLocalAttach
+	}
 	if t.objectPath.IsZero() {
 		response, err = t.collectorClient.Call("comp_attach_moduleset", hostname.Hostname(), s)
 	} else {
@@ -163,6 +169,9 @@ func (t T) DetachModuleset(s string) error {
 		response *jsonrpc.RPCResponse
 		err      error
 	)
+	if t.local != nil {
+		return ErrLocalAttach
+	}
 	if t.objectPath.IsZero() {
 		response, err = t.collectorClient.Call("comp_detach_moduleset", hostname.Hostname(), s)
 	} else {
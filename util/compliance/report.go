@@ -0,0 +1,80 @@
+package compliance
+
+import (
+	"fmt"
+
+	"github.com/opensvc/om3/util/testreport"
+)
+
+// TestSuites returns the run as a test suite, with a case per module. The
+// module state is given by its last check, or by its last action if the
+// module was not checked.
+func (t Run) TestSuites() []testreport.Suite {
+	suite := testreport.Suite{
+		Name:      "compliance",
+		Timestamp: t.BeginAt,
+		Duration:  t.runDuration(),
+		Cases:     make([]testreport.Case, 0),
+	}
+	subject := "node"
+	if t.main != nil && !t.main.objectPath.IsZero() {
+		subject = t.main.objectPath.String()
+		suite.Name += " " + subject
+	}
+	names := make([]string, 0)
+	actions := make(map[string]ModuleActions)
+	for _, ma := range t.ModuleActions {
+		if _, ok := actions[ma.Module]; !ok {
+			names = append(names, ma.Module)
+		}
+		actions[ma.Module] = append(actions[ma.Module], ma)
+	}
+	for _, name := range names {
+		suite.Cases = append(suite.Cases, moduleTestCase(subject, name, actions[name]))
+	}
+	return []testreport.Suite{suite}
+}
+
+func moduleTestCase(subject, name string, l ModuleActions) testreport.Case {
+	c := testreport.Case{
+		Name:      name,
+		ClassName: "compliance",
+	}
+	var last, fixable *ModuleAction
+	for _, ma := range l {
+		switch ma.Action {
+		case ActionCheck:
+			last = ma
+		case ActionFixable:
+			fixable = ma
+		}
+		c.Duration += ma.Duration()
+		c.Output += fmt.Sprintf("%s:\n%s", ma.Action, ma.Log.RenderForCollector())
+	}
+	if last == nil {
+		last = l[len(l)-1]
+	}
+	switch last.ExitCode {
+	case ExitCodeOk:
+		c.State = testreport.StatePass
+	case ExitCodeNA:
+		c.State = testreport.StateNA
+		c.Message = fmt.Sprintf("%s not applicable", last.Action)
+	default:
+		c.State = testreport.StateFail
+		c.Message = fmt.Sprintf("%s failed with exit code %d", last.Action, last.ExitCode)
+		c.Hint = moduleFixHint(subject, name, fixable)
+	}
+	return c
+}
+
+func moduleFixHint(subject, name string, fixable *ModuleAction) string {
+	switch {
+	case fixable == nil:
+		return fmt.Sprintf("verify the module is fixable with 'om %s compliance fixable --module %s'", subject, name)
+	case fixable.ExitCode == ExitCodeOk:
+		return fmt.Sprintf("fix with 'om %s compliance fix --module %s'", subject, name)
+	default:
+		return "the module is not automatically fixable, see the module output"
+	}
+}
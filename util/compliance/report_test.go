@@ -0,0 +1,34 @@
+package compliance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/util/testreport"
+)
+
+func TestRunTestSuites(t *testing.T) {
+	run := Run{
+		ModuleActions: ModuleActions{
+			{Action: ActionCheck, Module: "motd", ExitCode: ExitCodeOk},
+			{Action: ActionCheck, Module: "sysctl", ExitCode: ExitCodeNok},
+			{Action: ActionFixable, Module: "sysctl", ExitCode: ExitCodeOk},
+			{Action: ActionCheck, Module: "nfs", ExitCode: ExitCodeNA},
+			{Action: ActionCheck, Module: "ntp", ExitCode: ExitCodeNok},
+			{Action: ActionFix, Module: "ntp", ExitCode: ExitCodeOk},
+			{Action: ActionCheck, Module: "ntp", ExitCode: ExitCodeOk},
+		},
+	}
+	suites := run.TestSuites()
+	require.Len(t, suites, 1)
+	cases := suites[0].Cases
+	require.Len(t, cases, 4)
+	assert.Equal(t, "motd", cases[0].Name)
+	assert.Equal(t, testreport.StatePass, cases[0].State)
+	assert.Equal(t, testreport.StateFail, cases[1].State)
+	assert.Equal(t, "fix with 'om node compliance fix --module sysctl'", cases[1].Hint)
+	assert.Equal(t, testreport.StateNA, cases[2].State)
+	assert.Equal(t, testreport.StatePass, cases[3].State, "the state is given by the last check")
+}
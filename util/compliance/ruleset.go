@@ -18,6 +18,13 @@ type (
 )
 
 func (t T) GetRulesets() (Rulesets, error) {
+	if t.local != nil {
+		data, err := t.local.Data(t.labels, nil)
+		if err != nil {
+			return nil, err
+		}
+		return data.Rsets, nil
+	}
 	rulesets := make(Rulesets)
 	err := t.collectorClient.CallFor(&rulesets, "comp_get_ruleset", hostname.Hostname())
 	if err != nil {
@@ -68,6 +75,9 @@ func (t Rulesets) Render() string {
 
 func (t T) ListRulesets(filter string) ([]string, error) {
 	var err error
+	if t.local != nil {
+		return t.local.ListRulesets(filter), nil
+	}
 	data := make([]string, 0)
 	if filter == "" {
 		filter = "%"
@@ -84,6 +94,9 @@ func (t T) AttachRuleset(s string) error {
 		response *jsonrpc.RPCResponse
 		err      error
 	)
+	if t.local != nil {
+		return ErrLocalAttach
+	}
 	if t.objectPath.IsZero() {
 		response, err = t.collectorClient.Call("comp_attach_ruleset", hostname.Hostname(), s)
 	} else {
@@ -101,6 +114,9 @@ func (t T) DetachRuleset(s string) error {
 		response *jsonrpc.RPCResponse
 		err      error
 	)
+	if t.local != nil {
+		return ErrLocalAttach
+	}
 	if t.objectPath.IsZero() {
 		response, err = t.collectorClient.Call("comp_detach_ruleset", hostname.Hostname(), s)
 	} else {
@@ -18,6 +18,7 @@ import (
 
 type (
 	Run struct {
+		Action  Action
 		Modsets []string
 		Mods    []string
 		Attach  bool
@@ -76,7 +77,12 @@ func (t *T) NewRun() *Run {
 
 func (t *Run) Close() {
 	t.EndAt = time.Now()
-	t.Push()
+	if err := t.Save(); err != nil {
+		t.main.log.Warnf("save run history: %s", err)
+	}
+	if t.main.collectorClient != nil {
+		t.Push()
+	}
 }
 
 func (t *Run) SetModulesetsExpr(s string) {
@@ -183,6 +189,7 @@ func (t Run) autoAction(action Action, mod *Module) Action {
 }
 
 func (t *Run) do(action Action) error {
+	t.Action = action
 	defer t.Close()
 	if err := t.init(); err != nil {
 		return err
@@ -0,0 +1,650 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/opensvc/om3/util/file"
+)
+
+type (
+	CompKernelCmdlines struct {
+		*Obj
+	}
+	CompKernelCmdline struct {
+		Param string `json:"param"`
+		Value string `json:"value,omitempty"`
+		State string `json:"state"`
+	}
+)
+
+var (
+	// for mocking purposes
+	kcmdlineProcPath    = "/proc/cmdline"
+	kcmdlineGrubDefault = "/etc/default/grub"
+	kcmdlineMkconfig    = updateGrubConfig
+
+	kcmdlineGrubDropIns = "/etc/default/grub.d/*.cfg"
+
+	reGrubCmdline = regexp.MustCompile(`^(\s*(?:export\s+)?(GRUB_CMDLINE_LINUX|GRUB_CMDLINE_LINUX_DEFAULT)=)(.*)$`)
+
+	compKernelCmdlineInfo = ObjInfo{
+		DefaultPrefix: "OSVC_COMP_KCMDLINE_",
+		ExampleValue: []CompKernelCmdline{
+			{
+				Param: "transparent_hugepage",
+				Value: "never",
+				State: "present",
+			},
+			{
+				Param: "quiet",
+				State: "absent",
+			},
+		},
+		Description: `* Verify a kernel boot parameter is present or absent in the running kernel command line (/proc/cmdline)
+* Verify a kernel boot parameter is present or absent in the bootloader default configuration (GRUB_CMDLINE_LINUX and GRUB_CMDLINE_LINUX_DEFAULT in /etc/default/grub)
+* If value is set, a present parameter must be set to this value, and an absent parameter must not be set to this value
+* If value is not set, a present parameter can have any value, and an absent parameter must not be set at all
+* In the 'fix', the last GRUB_CMDLINE_LINUX and GRUB_CMDLINE_LINUX_DEFAULT assignments are modified and the grub configuration is regenerated. The running kernel command line changes on the next reboot.
+* The 'fix' is not applicable if one of these variables uses a shell expansion or is set by a /etc/default/grub.d/*.cfg drop-in file.
+`,
+		FormDefinition: `Desc: |
+  A rule to set the list of kernel boot parameters to add to or remove from the kernel command line.
+Css: comp48
+
+Outputs:
+  -
+    Dest: compliance variable
+    Type: json
+    Format: list of dict
+    Class: kcmdline
+
+Inputs:
+  -
+    Id: param
+    Label: Parameter
+    DisplayModeLabel: param
+    LabelCss: action16
+    Mandatory: Yes
+    Type: string
+    Help: The kernel boot parameter name. Example: transparent_hugepage.
+
+  -
+    Id: value
+    Label: Value
+    DisplayModeLabel: value
+    LabelCss: action16
+    Mandatory: No
+    Type: string
+    Help: The kernel boot parameter value. Example: never.
+
+  -
+    Id: state
+    Label: State
+    DisplayModeLabel: state
+    LabelCss: action16
+    Mandatory: Yes
+    Type: string
+    Candidates:
+      - present
+      - absent
+    Help: The expected kernel boot parameter state.
+`,
+	}
+)
+
+func init() {
+	m["kcmdline"] = NewCompKernelCmdlines
+}
+
+func NewCompKernelCmdlines() interface{} {
+	return &CompKernelCmdlines{
+		Obj: NewObj(),
+	}
+}
+
+func (t *CompKernelCmdlines) Add(s string) error {
+	var data []CompKernelCmdline
+	if err := json.Unmarshal([]byte(s), &data); err != nil {
+		return err
+	}
+	for _, rule := range data {
+		if rule.Param == "" {
+			return fmt.Errorf("param is mandatory in dict : %s", s)
+		}
+		if strings.ContainsAny(rule.Param, " \t\"'=") || strings.ContainsAny(rule.Value, " \t\"'") {
+			return fmt.Errorf("param and value must not contain spaces, quotes or '=' in dict : %s", s)
+		}
+		switch rule.State {
+		case "present", "absent":
+		default:
+			return fmt.Errorf("state must be present or absent in dict : %s", s)
+		}
+		t.Obj.Add(rule)
+	}
+	return nil
+}
+
+func (t CompKernelCmdline) String() string {
+	if t.Value == "" {
+		return t.Param
+	}
+	return t.Param + "=" + t.Value
+}
+
+// hasName returns true if the token is the parameter, with or without a
+// value.
+func (t CompKernelCmdline) hasName(token string) bool {
+	name, _, _ := strings.Cut(token, "=")
+	return name == t.Param
+}
+
+// matches returns true if the token is the parameter with the rule value,
+// or with any value if the rule has no value.
+func (t CompKernelCmdline) matches(token string) bool {
+	if t.Value == "" {
+		return t.hasName(token)
+	}
+	return token == t.String()
+}
+
+// isOnTarget returns true if the tokens of a command line satisfy the rule.
+func (t CompKernelCmdline) isOnTarget(tokens []string) bool {
+	var found, conflict bool
+	for _, token := range tokens {
+		switch {
+		case t.matches(token):
+			found = true
+		case t.hasName(token):
+			conflict = true
+		}
+	}
+	switch t.State {
+	case "present":
+		return found && !conflict
+	case "absent":
+		return !found
+	default:
+		return false
+	}
+}
+
+// fixTokens returns the tokens with the rule parameter removed, and with
+// the rule parameter appended if add is true.
+func (t CompKernelCmdline) fixTokens(tokens []string, add bool) []string {
+	l := make([]string, 0, len(tokens)+1)
+	for _, token := range tokens {
+		if t.State == "absent" && !t.matches(token) {
+			l = append(l, token)
+		} else if t.State == "present" && !t.hasName(token) {
+			l = append(l, token)
+		}
+	}
+	if add {
+		l = append(l, t.String())
+	}
+	return l
+}
+
+// grubAssignment is a kernel command line variable assignment in a
+// bootloader default configuration file.
+type grubAssignment struct {
+	name string
+
+	// line is the index of the assignment in the file lines, or -1 for
+	// an assignment added by the fix.
+	line int
+
+	// prefix is the line content up to the '=', and trailer the line
+	// content after the value, like a comment. Both are kept untouched
+	// when the value is rewritten.
+	prefix  string
+	trailer string
+
+	// tokens is the value split on whitespace, with the variable
+	// references expanded.
+	tokens []string
+
+	// expands is true if the value references a variable or a command,
+	// so it can not be rewritten as a token list.
+	expands bool
+
+	changed bool
+}
+
+// grubDefault is the content of the bootloader default configuration file,
+// with the kernel command line variables parsed.
+type grubDefault struct {
+	lines []string
+
+	// assignments is the kernel command line variable assignments of the
+	// bootloader default configuration file, in file order.
+	assignments []*grubAssignment
+
+	// vars is the tokens of the kernel command line variables, indexed
+	// by variable name, as set after the bootloader default configuration
+	// file and its drop-in files are sourced.
+	vars map[string][]string
+
+	// dropIns is the drop-in file setting a kernel command line variable,
+	// indexed by variable name.
+	dropIns map[string]string
+
+	// err is set if a kernel command line variable assignment can not be
+	// parsed.
+	err error
+}
+
+// parseGrubValue parses the value of a shell variable assignment, and
+// returns the value with the quotes removed and the variable references
+// expanded by lookup, the line content after the value, and true if the
+// value uses an expansion.
+func parseGrubValue(s string, lookup func(string) string) (string, string, bool, error) {
+	var (
+		buf     strings.Builder
+		expands bool
+	)
+	expand := func(i int) int {
+		// s[i] is '$'
+		expands = true
+		rest := s[i+1:]
+		if strings.HasPrefix(rest, "{") {
+			if end := strings.IndexByte(rest, '}'); end > 0 {
+				buf.WriteString(lookup(rest[1:end]))
+				return i + end + 2
+			}
+		}
+		n := 0
+		for n < len(rest) && (rest[n] == '_' || (rest[n] >= 'a' && rest[n] <= 'z') || (rest[n] >= 'A' && rest[n] <= 'Z') || (n > 0 && rest[n] >= '0' && rest[n] <= '9')) {
+			n++
+		}
+		if n == 0 {
+			buf.WriteByte('$')
+			return i + 1
+		}
+		buf.WriteString(lookup(rest[:n]))
+		return i + n + 1
+	}
+	i := 0
+	for i < len(s) {
+		switch c := s[i]; c {
+		case ' ', '\t':
+			rest := s[i:]
+			if trimmed := strings.TrimSpace(rest); trimmed != "" && !strings.HasPrefix(trimmed, "#") {
+				return "", "", false, fmt.Errorf("unsupported content after the value: %s", trimmed)
+			}
+			return buf.String(), rest, expands, nil
+		case '\\':
+			if i+1 >= len(s) {
+				return "", "", false, fmt.Errorf("unsupported line continuation")
+			}
+			buf.WriteByte(s[i+1])
+			i += 2
+		case '\'':
+			end := strings.IndexByte(s[i+1:], '\'')
+			if end < 0 {
+				return "", "", false, fmt.Errorf("unterminated single quote")
+			}
+			buf.WriteString(s[i+1 : i+1+end])
+			i += end + 2
+		case '"':
+			i++
+			for {
+				if i >= len(s) {
+					return "", "", false, fmt.Errorf("unterminated double quote")
+				}
+				c := s[i]
+				if c == '"' {
+					i++
+					break
+				}
+				switch {
+				case c == '\\' && i+1 < len(s) && strings.IndexByte("\"\\$`", s[i+1]) >= 0:
+					buf.WriteByte(s[i+1])
+					i += 2
+				case c == '$':
+					i = expand(i)
+				case c == '`':
+					expands = true
+					buf.WriteByte(c)
+					i++
+				default:
+					buf.WriteByte(c)
+					i++
+				}
+			}
+		case '$':
+			i = expand(i)
+		case '`':
+			expands = true
+			buf.WriteByte(c)
+			i++
+		default:
+			buf.WriteByte(c)
+			i++
+		}
+	}
+	return buf.String(), "", expands, nil
+}
+
+// quoteGrubValue returns the tokens as a double quoted shell value.
+func quoteGrubValue(tokens []string) string {
+	var buf strings.Builder
+	buf.WriteByte('"')
+	for _, c := range strings.Join(tokens, " ") {
+		if strings.ContainsRune("\"\\$`", c) {
+			buf.WriteByte('\\')
+		}
+		buf.WriteRune(c)
+	}
+	buf.WriteByte('"')
+	return buf.String()
+}
+
+// parse parses the kernel command line variable assignments of the lines
+// of a file, in file order, and updates the variables tokens. It returns
+// the assignments.
+func (t *grubDefault) parse(filename string, lines []string) []*grubAssignment {
+	l := make([]*grubAssignment, 0)
+	lookup := func(name string) string {
+		return strings.Join(t.vars[name], " ")
+	}
+	for i, line := range lines {
+		match := reGrubCmdline.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		value, trailer, expands, err := parseGrubValue(match[3], lookup)
+		if err != nil {
+			t.err = errors.Join(t.err, fmt.Errorf("%s line %d: %s: %w", filename, i+1, match[2], err))
+			continue
+		}
+		a := &grubAssignment{
+			name:    match[2],
+			line:    i,
+			prefix:  match[1],
+			trailer: trailer,
+			tokens:  strings.Fields(value),
+			expands: expands,
+		}
+		t.vars[a.name] = a.tokens
+		l = append(l, a)
+	}
+	return l
+}
+
+func splitLines(b []byte) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	return lines, scanner.Err()
+}
+
+func (t CompKernelCmdlines) loadGrubDefault() (*grubDefault, error) {
+	b, err := osReadFile(kcmdlineGrubDefault)
+	if err != nil {
+		return nil, err
+	}
+	g := &grubDefault{
+		vars:    make(map[string][]string),
+		dropIns: make(map[string]string),
+	}
+	if g.lines, err = splitLines(b); err != nil {
+		return nil, err
+	}
+	g.assignments = g.parse(kcmdlineGrubDefault, g.lines)
+
+	// the drop-in files are sourced after the default configuration file
+	dropIns, err := filepath.Glob(kcmdlineGrubDropIns)
+	if err != nil {
+		return nil, err
+	}
+	for _, filename := range dropIns {
+		b, err := osReadFile(filename)
+		if err != nil {
+			return nil, err
+		}
+		lines, err := splitLines(b)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range g.parse(filename, lines) {
+			g.dropIns[a.name] = filename
+		}
+	}
+	return g, nil
+}
+
+func (t grubDefault) tokens() []string {
+	return append(append([]string{}, t.vars["GRUB_CMDLINE_LINUX"]...), t.vars["GRUB_CMDLINE_LINUX_DEFAULT"]...)
+}
+
+// last returns the last assignment of the <name> variable in the
+// bootloader default configuration file, or nil if not assigned.
+func (t grubDefault) last(name string) *grubAssignment {
+	for i := len(t.assignments) - 1; i >= 0; i-- {
+		if t.assignments[i].name == name {
+			return t.assignments[i]
+		}
+	}
+	return nil
+}
+
+// canFix returns an error if the kernel command line variables can not be
+// safely rewritten as token lists in the bootloader default configuration
+// file.
+func (t grubDefault) canFix() error {
+	if t.err != nil {
+		return t.err
+	}
+	for _, name := range []string{"GRUB_CMDLINE_LINUX", "GRUB_CMDLINE_LINUX_DEFAULT"} {
+		if filename, ok := t.dropIns[name]; ok {
+			return fmt.Errorf("%s is set by the drop-in file %s", name, filename)
+		}
+		if a := t.last(name); a != nil && a.expands {
+			return fmt.Errorf("%s line %d: %s uses a shell expansion", kcmdlineGrubDefault, a.line+1, name)
+		}
+	}
+	return nil
+}
+
+// setTokens sets the tokens of the last assignment of the <name>
+// variable, adding an assignment if the variable is not assigned.
+func (t *grubDefault) setTokens(name string, tokens []string) {
+	a := t.last(name)
+	if a == nil {
+		a = &grubAssignment{name: name, line: -1, prefix: name + "="}
+		t.assignments = append(t.assignments, a)
+	}
+	a.tokens = tokens
+	a.changed = true
+	t.vars[name] = tokens
+}
+
+// Bytes returns the bootloader default configuration file content, with
+// only the changed assignments values rewritten.
+func (t grubDefault) Bytes() []byte {
+	lines := append([]string{}, t.lines...)
+	for _, a := range t.assignments {
+		if !a.changed {
+			continue
+		}
+		line := a.prefix + quoteGrubValue(a.tokens) + a.trailer
+		if a.line < 0 {
+			lines = append(lines, line)
+		} else {
+			lines[a.line] = line
+		}
+	}
+	var buf bytes.Buffer
+	for _, line := range lines {
+		buf.WriteString(line + "\n")
+	}
+	return buf.Bytes()
+}
+
+func (t CompKernelCmdlines) liveTokens() ([]string, error) {
+	b, err := osReadFile(kcmdlineProcPath)
+	if err != nil {
+		return nil, err
+	}
+	return strings.Fields(string(b)), nil
+}
+
+func (t CompKernelCmdlines) checkRule(rule CompKernelCmdline, live []string, g *grubDefault) ExitCode {
+	e := ExitOk
+	if rule.isOnTarget(live) {
+		t.VerboseInfof("kernel parameter %s is %s in %s\n", rule, rule.State, kcmdlineProcPath)
+	} else {
+		t.VerboseErrorf("kernel parameter %s is not %s in %s\n", rule, rule.State, kcmdlineProcPath)
+		e = ExitNok
+	}
+	if g == nil {
+		return e
+	}
+	if g.err != nil {
+		t.VerboseErrorf("kernel parameter %s can not be verified in %s: %s\n", rule, kcmdlineGrubDefault, g.err)
+		return ExitNok
+	}
+	if rule.isOnTarget(g.tokens()) {
+		t.VerboseInfof("kernel parameter %s is %s in %s\n", rule, rule.State, kcmdlineGrubDefault)
+		if e == ExitNok {
+			t.VerboseInfof("kernel parameter %s change in %s needs a reboot\n", rule, kcmdlineGrubDefault)
+		}
+	} else {
+		t.VerboseErrorf("kernel parameter %s is not %s in %s\n", rule, rule.State, kcmdlineGrubDefault)
+		e = ExitNok
+	}
+	return e
+}
+
+func (t CompKernelCmdlines) Check() ExitCode {
+	if !file.Exists(kcmdlineProcPath) {
+		t.Infof("%s does not exist, kernel boot parameters are not supported on this node\n", kcmdlineProcPath)
+		return ExitNotApplicable
+	}
+	t.SetVerbose(true)
+	live, err := t.liveTokens()
+	if err != nil {
+		t.Errorf("%s\n", err)
+		return ExitNok
+	}
+	var g *grubDefault
+	if file.Exists(kcmdlineGrubDefault) {
+		if g, err = t.loadGrubDefault(); err != nil {
+			t.Errorf("%s\n", err)
+			return ExitNok
+		}
+	} else {
+		t.VerboseInfof("%s does not exist, only verify the running kernel command line\n", kcmdlineGrubDefault)
+	}
+	e := ExitOk
+	for _, i := range t.Rules() {
+		rule := i.(CompKernelCmdline)
+		e = e.Merge(t.checkRule(rule, live, g))
+	}
+	return e
+}
+
+func (t CompKernelCmdlines) fixRule(rule CompKernelCmdline, g *grubDefault) {
+	for _, name := range []string{"GRUB_CMDLINE_LINUX", "GRUB_CMDLINE_LINUX_DEFAULT"} {
+		if a := g.last(name); a != nil {
+			if tokens := rule.fixTokens(a.tokens, false); len(tokens) != len(a.tokens) {
+				g.setTokens(name, tokens)
+			}
+		}
+	}
+	if rule.State == "present" {
+		g.setTokens("GRUB_CMDLINE_LINUX", rule.fixTokens(g.vars["GRUB_CMDLINE_LINUX"], true))
+		t.Infof("add kernel parameter %s to GRUB_CMDLINE_LINUX\n", rule)
+	} else {
+		t.Infof("remove kernel parameter %s from the kernel command line variables\n", rule)
+	}
+}
+
+func (t CompKernelCmdlines) Fix() ExitCode {
+	if !file.Exists(kcmdlineProcPath) {
+		t.Infof("%s does not exist, kernel boot parameters are not supported on this node\n", kcmdlineProcPath)
+		return ExitNotApplicable
+	}
+	t.SetVerbose(false)
+	g, err := t.loadGrubDefault()
+	if err != nil {
+		t.Errorf("%s\n", err)
+		return ExitNok
+	}
+	var changed bool
+	for _, i := range t.Rules() {
+		rule := i.(CompKernelCmdline)
+		if rule.isOnTarget(g.tokens()) {
+			continue
+		}
+		if err := g.canFix(); err != nil {
+			t.Infof("kernel parameter %s can not be fixed in %s: %s\n", rule, kcmdlineGrubDefault, err)
+			return ExitNotApplicable
+		}
+		t.fixRule(rule, g)
+		changed = true
+	}
+	if !changed {
+		return ExitOk
+	}
+	if _, err := backup(kcmdlineGrubDefault); err != nil {
+		t.Errorf("%s\n", err)
+		return ExitNok
+	}
+	fi, err := os.Stat(kcmdlineGrubDefault)
+	if err != nil {
+		t.Errorf("%s\n", err)
+		return ExitNok
+	}
+	if err := os.WriteFile(kcmdlineGrubDefault, g.Bytes(), fi.Mode()); err != nil {
+		t.Errorf("%s\n", err)
+		return ExitNok
+	}
+	t.Infof("write %s\n", kcmdlineGrubDefault)
+	if err := kcmdlineMkconfig(); err != nil {
+		t.Errorf("%s\n", err)
+		return ExitNok
+	}
+	t.Infof("the kernel command line changes apply on the next reboot\n")
+	return ExitOk
+}
+
+// updateGrubConfig regenerates the grub configuration from the bootloader
+// default configuration, using the first generator found.
+func updateGrubConfig() error {
+	for _, argv := range [][]string{
+		{"update-grub"},
+		{"grub2-mkconfig", "-o", "/boot/grub2/grub.cfg"},
+		{"grub-mkconfig", "-o", "/boot/grub/grub.cfg"},
+	} {
+		if _, err := execLookPath(argv[0]); err != nil {
+			continue
+		}
+		cmd := exec.Command(argv[0], argv[1:]...)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("%s: %w: %s", cmd, err, out)
+		}
+		return nil
+	}
+	return fmt.Errorf("no grub configuration generator found")
+}
+
+func (t CompKernelCmdlines) Fixable() ExitCode {
+	return ExitNotApplicable
+}
+
+func (t CompKernelCmdlines) Info() ObjInfo {
+	return compKernelCmdlineInfo
+}
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKernelCmdlineIsOnTarget(t *testing.T) {
+	tokens := []string{"ro", "quiet", "transparent_hugepage=always", "crashkernel=auto"}
+	testCases := map[string]struct {
+		rule     CompKernelCmdline
+		expected bool
+	}{
+		"present flag":                {CompKernelCmdline{Param: "quiet", State: "present"}, true},
+		"absent flag":                 {CompKernelCmdline{Param: "quiet", State: "absent"}, false},
+		"present with any value":      {CompKernelCmdline{Param: "crashkernel", State: "present"}, true},
+		"present with other value":    {CompKernelCmdline{Param: "transparent_hugepage", Value: "never", State: "present"}, false},
+		"absent with other value":     {CompKernelCmdline{Param: "transparent_hugepage", Value: "never", State: "absent"}, true},
+		"absent with same value":      {CompKernelCmdline{Param: "transparent_hugepage", Value: "always", State: "absent"}, false},
+		"present param not in tokens": {CompKernelCmdline{Param: "nosmt", State: "present"}, false},
+	}
+	for name, c := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, c.expected, c.rule.isOnTarget(tokens))
+		})
+	}
+}
+
+func TestKernelCmdlines(t *testing.T) {
+	oriProcPath := kcmdlineProcPath
+	oriGrubDefault := kcmdlineGrubDefault
+	oriGrubDropIns := kcmdlineGrubDropIns
+	oriMkconfig := kcmdlineMkconfig
+	defer func() {
+		kcmdlineProcPath = oriProcPath
+		kcmdlineGrubDefault = oriGrubDefault
+		kcmdlineGrubDropIns = oriGrubDropIns
+		kcmdlineMkconfig = oriMkconfig
+	}()
+
+	dir := t.TempDir()
+	kcmdlineProcPath = filepath.Join(dir, "cmdline")
+	kcmdlineGrubDefault = filepath.Join(dir, "grub")
+	kcmdlineGrubDropIns = filepath.Join(dir, "grub.d", "*.cfg")
+	var mkconfig bool
+	kcmdlineMkconfig = func() error { mkconfig = true; return nil }
+
+	require.NoError(t, os.WriteFile(kcmdlineProcPath, []byte("BOOT_IMAGE=/vmlinuz ro quiet transparent_hugepage=always\n"), 0444))
+	require.NoError(t, os.WriteFile(kcmdlineGrubDefault, []byte(`GRUB_TIMEOUT=5
+GRUB_CMDLINE_LINUX_DEFAULT="quiet splash"
+GRUB_CMDLINE_LINUX="transparent_hugepage=always crashkernel=auto"
+`), 0644))
+
+	obj := NewCompKernelCmdlines().(I)
+	require.NoError(t, obj.Add(`[
+		{"param": "transparent_hugepage", "value": "never", "state": "present"},
+		{"param": "quiet", "state": "absent"}
+	]`))
+	assert.Error(t, obj.Add(`[{"param": "quiet", "state": "on"}]`))
+	assert.Error(t, obj.Add(`[{"param": "transparent_hugepage=never", "state": "present"}]`))
+
+	assert.Equal(t, ExitNok, obj.Check())
+	assert.Equal(t, ExitOk, obj.Fix())
+	assert.True(t, mkconfig, "the grub configuration is regenerated")
+
+	b, err := os.ReadFile(kcmdlineGrubDefault)
+	require.NoError(t, err)
+	assert.Equal(t, `GRUB_TIMEOUT=5
+GRUB_CMDLINE_LINUX_DEFAULT="splash"
+GRUB_CMDLINE_LINUX="crashkernel=auto transparent_hugepage=never"
+`, string(b))
+
+	// the running kernel command line is not on target until the next reboot
+	assert.Equal(t, ExitNok, obj.Check())
+	require.NoError(t, os.WriteFile(kcmdlineProcPath, []byte("BOOT_IMAGE=/vmlinuz ro splash transparent_hugepage=never\n"), 0444))
+	assert.Equal(t, ExitOk, obj.Check())
+
+	mkconfig = false
+	assert.Equal(t, ExitOk, obj.Fix())
+	assert.False(t, mkconfig, "the grub configuration is not regenerated when on target")
+}
+
+func TestKernelCmdlinesGrubDefault(t *testing.T) {
+	oriProcPath := kcmdlineProcPath
+	oriGrubDefault := kcmdlineGrubDefault
+	oriGrubDropIns := kcmdlineGrubDropIns
+	oriMkconfig := kcmdlineMkconfig
+	defer func() {
+		kcmdlineProcPath = oriProcPath
+		kcmdlineGrubDefault = oriGrubDefault
+		kcmdlineGrubDropIns = oriGrubDropIns
+		kcmdlineMkconfig = oriMkconfig
+	}()
+
+	testCases := map[string]struct {
+		grub     string
+		dropIn   string
+		check    ExitCode
+		fix      ExitCode
+		expected string
+	}{
+		"keeps the trailing comment": {
+			grub:     "GRUB_CMDLINE_LINUX=\"quiet transparent_hugepage=always\"  # c\n",
+			check:    ExitNok,
+			fix:      ExitOk,
+			expected: "GRUB_CMDLINE_LINUX=\"transparent_hugepage=never\"  # c\n",
+		},
+		"edits only the last assignment": {
+			grub:     "GRUB_CMDLINE_LINUX=\"rd.lvm.lv=vg/root\"\nGRUB_CMDLINE_LINUX=\"quiet crashkernel=auto\"\n",
+			check:    ExitNok,
+			fix:      ExitOk,
+			expected: "GRUB_CMDLINE_LINUX=\"rd.lvm.lv=vg/root\"\nGRUB_CMDLINE_LINUX=\"crashkernel=auto transparent_hugepage=never\"\n",
+		},
+		"parses the single quoted values": {
+			grub:     "export GRUB_CMDLINE_LINUX='quiet'\n",
+			check:    ExitNok,
+			fix:      ExitOk,
+			expected: "export GRUB_CMDLINE_LINUX=\"transparent_hugepage=never\"\n",
+		},
+		"adds the missing assignment": {
+			grub:     "GRUB_TIMEOUT=5\n",
+			check:    ExitNok,
+			fix:      ExitOk,
+			expected: "GRUB_TIMEOUT=5\nGRUB_CMDLINE_LINUX=\"transparent_hugepage=never\"\n",
+		},
+		"checks the expanded value": {
+			grub:  "GRUB_CMDLINE_LINUX=\"transparent_hugepage=never\"\nGRUB_CMDLINE_LINUX_DEFAULT=\"$GRUB_CMDLINE_LINUX\"\n",
+			check: ExitOk,
+			fix:   ExitOk,
+		},
+		"does not fix the expanded value": {
+			grub:  "GRUB_CMDLINE_LINUX=\"quiet\"\nGRUB_CMDLINE_LINUX=\"${GRUB_CMDLINE_LINUX} splash\"\n",
+			check: ExitNok,
+			fix:   ExitNotApplicable,
+		},
+		"does not fix the value set by a drop-in": {
+			grub:   "GRUB_CMDLINE_LINUX=\"transparent_hugepage=never\"\n",
+			dropIn: "GRUB_CMDLINE_LINUX=\"quiet\"\n",
+			check:  ExitNok,
+			fix:    ExitNotApplicable,
+		},
+		"does not fix the unterminated value": {
+			grub:  "GRUB_CMDLINE_LINUX=\"quiet\n",
+			check: ExitNok,
+			fix:   ExitNotApplicable,
+		},
+	}
+	for name, c := range testCases {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			kcmdlineProcPath = filepath.Join(dir, "cmdline")
+			kcmdlineGrubDefault = filepath.Join(dir, "grub")
+			kcmdlineGrubDropIns = filepath.Join(dir, "grub.d", "*.cfg")
+			kcmdlineMkconfig = func() error { return nil }
+
+			require.NoError(t, os.WriteFile(kcmdlineProcPath, []byte("BOOT_IMAGE=/vmlinuz ro transparent_hugepage=never\n"), 0444))
+			require.NoError(t, os.WriteFile(kcmdlineGrubDefault, []byte(c.grub), 0644))
+			if c.dropIn != "" {
+				require.NoError(t, os.Mkdir(filepath.Join(dir, "grub.d"), 0755))
+				require.NoError(t, os.WriteFile(filepath.Join(dir, "grub.d", "50-custom.cfg"), []byte(c.dropIn), 0644))
+			}
+
+			obj := NewCompKernelCmdlines().(I)
+			require.NoError(t, obj.Add(`[
+				{"param": "transparent_hugepage", "value": "never", "state": "present"},
+				{"param": "quiet", "state": "absent"}
+			]`))
+			assert.Equal(t, c.check, obj.Check())
+			assert.Equal(t, c.fix, obj.Fix())
+
+			b, err := os.ReadFile(kcmdlineGrubDefault)
+			require.NoError(t, err)
+			if c.expected == "" {
+				assert.Equal(t, c.grub, string(b), "the file is not modified")
+			} else {
+				assert.Equal(t, c.expected, string(b))
+			}
+		})
+	}
+}
@@ -0,0 +1,356 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/opensvc/om3/util/file"
+)
+
+type (
+	CompKernelModules struct {
+		*Obj
+	}
+	CompKernelModule struct {
+		Module string `json:"module"`
+		State  string `json:"state"`
+	}
+)
+
+var (
+	// for mocking purposes
+	kmodProcModulesPath = "/proc/modules"
+	kmodLoadDir         = "/etc/modules-load.d"
+	kmodProbeDir        = "/etc/modprobe.d"
+	execModprobe        = func(args ...string) *exec.Cmd { return exec.Command("modprobe", args...) }
+
+	compKernelModuleInfo = ObjInfo{
+		DefaultPrefix: "OSVC_COMP_KMOD_",
+		ExampleValue: []CompKernelModule{
+			{
+				Module: "usb-storage",
+				State:  "blacklisted",
+			},
+			{
+				Module: "dm_multipath",
+				State:  "loaded",
+			},
+		},
+		Description: `* Verify a kernel module is loaded, and loaded at boot through a /etc/modules-load.d/ file
+* Verify a kernel module is not loaded, and blacklisted through a /etc/modprobe.d/ file
+* Dashes and underscores are equivalent in module names
+* In the 'fix', a loaded module is added to /etc/modules-load.d/opensvc.conf and probed, a blacklisted module is added to /etc/modprobe.d/opensvc-blacklist.conf and removed
+`,
+		FormDefinition: `Desc: |
+  A rule to set the list of kernel modules to load or to blacklist.
+Css: comp48
+
+Outputs:
+  -
+    Dest: compliance variable
+    Type: json
+    Format: list of dict
+    Class: kmod
+
+Inputs:
+  -
+    Id: module
+    Label: Module
+    DisplayModeLabel: module
+    LabelCss: action16
+    Mandatory: Yes
+    Type: string
+    Help: The kernel module name. Example: usb-storage.
+
+  -
+    Id: state
+    Label: State
+    DisplayModeLabel: state
+    LabelCss: action16
+    Mandatory: Yes
+    Type: string
+    Candidates:
+      - loaded
+      - blacklisted
+    Help: The expected kernel module state.
+`,
+	}
+)
+
+func init() {
+	m["kmod"] = NewCompKernelModules
+}
+
+func NewCompKernelModules() interface{} {
+	return &CompKernelModules{
+		Obj: NewObj(),
+	}
+}
+
+func (t *CompKernelModules) Add(s string) error {
+	var data []CompKernelModule
+	if err := json.Unmarshal([]byte(s), &data); err != nil {
+		return err
+	}
+	for _, rule := range data {
+		if rule.Module == "" {
+			return fmt.Errorf("module is mandatory in dict : %s", s)
+		}
+		if strings.ContainsAny(rule.Module, " \t/") {
+			return fmt.Errorf("module must be a module name in dict : %s", s)
+		}
+		switch rule.State {
+		case "loaded", "blacklisted":
+		default:
+			return fmt.Errorf("state must be loaded or blacklisted in dict : %s", s)
+		}
+		t.Obj.Add(rule)
+	}
+	return nil
+}
+
+// kmodName returns the module name as listed in /proc/modules, where the
+// dashes are replaced by underscores.
+func kmodName(s string) string {
+	return strings.ReplaceAll(s, "-", "_")
+}
+
+func (t CompKernelModules) isLoaded(module string) (bool, error) {
+	b, err := osReadFile(kmodProcModulesPath)
+	if err != nil {
+		return false, err
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) > 0 && fields[0] == kmodName(module) {
+			return true, nil
+		}
+	}
+	return false, scanner.Err()
+}
+
+// findInConf returns the path of the first dir/*.conf file with a line
+// whose fields match the words. The module names are compared with dashes
+// and underscores equivalent. A missing dir is not an error.
+func (t CompKernelModules) findInConf(dir string, words ...string) (string, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.conf"))
+	if err != nil {
+		return "", err
+	}
+	for _, p := range files {
+		b, err := osReadFile(p)
+		if err != nil {
+			return "", err
+		}
+		scanner := bufio.NewScanner(bytes.NewReader(b))
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+				continue
+			}
+			fields := strings.Fields(line)
+			if len(fields) != len(words) {
+				continue
+			}
+			match := true
+			for i, word := range words {
+				if kmodName(fields[i]) != kmodName(word) {
+					match = false
+					break
+				}
+			}
+			if match {
+				return p, nil
+			}
+		}
+	}
+	return "", nil
+}
+
+func (t CompKernelModules) checkLoaded(rule CompKernelModule) ExitCode {
+	e := ExitOk
+	if loaded, err := t.isLoaded(rule.Module); err != nil {
+		t.Errorf("%s\n", err)
+		return ExitNok
+	} else if !loaded {
+		t.VerboseErrorf("kernel module %s is not loaded, should be\n", rule.Module)
+		e = ExitNok
+	} else {
+		t.VerboseInfof("kernel module %s is loaded\n", rule.Module)
+	}
+	if p, err := t.findInConf(kmodLoadDir, rule.Module); err != nil {
+		t.Errorf("%s\n", err)
+		return ExitNok
+	} else if p == "" {
+		t.VerboseErrorf("kernel module %s is not loaded at boot by a %s/*.conf file\n", rule.Module, kmodLoadDir)
+		e = ExitNok
+	} else {
+		t.VerboseInfof("kernel module %s is loaded at boot by %s\n", rule.Module, p)
+	}
+	return e
+}
+
+func (t CompKernelModules) checkBlacklisted(rule CompKernelModule) ExitCode {
+	e := ExitOk
+	if loaded, err := t.isLoaded(rule.Module); err != nil {
+		t.Errorf("%s\n", err)
+		return ExitNok
+	} else if loaded {
+		t.VerboseErrorf("kernel module %s is loaded, should not be\n", rule.Module)
+		e = ExitNok
+	} else {
+		t.VerboseInfof("kernel module %s is not loaded\n", rule.Module)
+	}
+	if p, err := t.findInConf(kmodProbeDir, "blacklist", rule.Module); err != nil {
+		t.Errorf("%s\n", err)
+		return ExitNok
+	} else if p == "" {
+		t.VerboseErrorf("kernel module %s is not blacklisted by a %s/*.conf file\n", rule.Module, kmodProbeDir)
+		e = ExitNok
+	} else {
+		t.VerboseInfof("kernel module %s is blacklisted by %s\n", rule.Module, p)
+	}
+	return e
+}
+
+func (t CompKernelModules) checkRule(rule CompKernelModule) ExitCode {
+	switch rule.State {
+	case "loaded":
+		return t.checkLoaded(rule)
+	case "blacklisted":
+		return t.checkBlacklisted(rule)
+	default:
+		return ExitNok
+	}
+}
+
+func (t CompKernelModules) appendLine(p, line string) error {
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		return err
+	}
+	if _, err := backup(p); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(p, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			t.Errorf("can't close file %s: %s\n", p, err)
+		}
+	}()
+	_, err = f.Write([]byte(line + "\n"))
+	return err
+}
+
+func (t CompKernelModules) modprobe(args ...string) ExitCode {
+	cmd := execModprobe(args...)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Errorf("%s: %s: %s\n", cmd, err, out)
+		return ExitNok
+	}
+	t.Infof("%s\n", cmd)
+	return ExitOk
+}
+
+func (t CompKernelModules) fixLoaded(rule CompKernelModule) ExitCode {
+	e := ExitOk
+	if p, err := t.findInConf(kmodLoadDir, rule.Module); err != nil {
+		t.Errorf("%s\n", err)
+		return ExitNok
+	} else if p == "" {
+		p = filepath.Join(kmodLoadDir, "opensvc.conf")
+		if err := t.appendLine(p, rule.Module); err != nil {
+			t.Errorf("add kernel module %s to %s: %s\n", rule.Module, p, err)
+			return ExitNok
+		}
+		t.Infof("add kernel module %s to %s\n", rule.Module, p)
+	}
+	if loaded, err := t.isLoaded(rule.Module); err != nil {
+		t.Errorf("%s\n", err)
+		return ExitNok
+	} else if !loaded {
+		e = e.Merge(t.modprobe(rule.Module))
+	}
+	return e
+}
+
+func (t CompKernelModules) fixBlacklisted(rule CompKernelModule) ExitCode {
+	e := ExitOk
+	if p, err := t.findInConf(kmodProbeDir, "blacklist", rule.Module); err != nil {
+		t.Errorf("%s\n", err)
+		return ExitNok
+	} else if p == "" {
+		p = filepath.Join(kmodProbeDir, "opensvc-blacklist.conf")
+		if err := t.appendLine(p, "blacklist "+rule.Module); err != nil {
+			t.Errorf("blacklist kernel module %s in %s: %s\n", rule.Module, p, err)
+			return ExitNok
+		}
+		t.Infof("blacklist kernel module %s in %s\n", rule.Module, p)
+	}
+	if loaded, err := t.isLoaded(rule.Module); err != nil {
+		t.Errorf("%s\n", err)
+		return ExitNok
+	} else if loaded {
+		e = e.Merge(t.modprobe("-r", rule.Module))
+	}
+	return e
+}
+
+func (t CompKernelModules) fixRule(rule CompKernelModule) ExitCode {
+	switch rule.State {
+	case "loaded":
+		return t.fixLoaded(rule)
+	case "blacklisted":
+		return t.fixBlacklisted(rule)
+	default:
+		return ExitNok
+	}
+}
+
+func (t CompKernelModules) Check() ExitCode {
+	if !file.Exists(kmodProcModulesPath) {
+		t.Infof("%s does not exist, kernel modules are not supported on this node\n", kmodProcModulesPath)
+		return ExitNotApplicable
+	}
+	t.SetVerbose(true)
+	e := ExitOk
+	for _, i := range t.Rules() {
+		rule := i.(CompKernelModule)
+		e = e.Merge(t.checkRule(rule))
+	}
+	return e
+}
+
+func (t CompKernelModules) Fix() ExitCode {
+	if !file.Exists(kmodProcModulesPath) {
+		t.Infof("%s does not exist, kernel modules are not supported on this node\n", kmodProcModulesPath)
+		return ExitNotApplicable
+	}
+	t.SetVerbose(false)
+	e := ExitOk
+	for _, i := range t.Rules() {
+		rule := i.(CompKernelModule)
+		if t.checkRule(rule) == ExitOk {
+			continue
+		}
+		e = e.Merge(t.fixRule(rule))
+	}
+	return e
+}
+
+func (t CompKernelModules) Fixable() ExitCode {
+	return ExitNotApplicable
+}
+
+func (t CompKernelModules) Info() ObjInfo {
+	return compKernelModuleInfo
+}
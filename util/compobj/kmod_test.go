@@ -0,0 +1,82 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKernelModules(t *testing.T) {
+	oriProcModulesPath := kmodProcModulesPath
+	oriLoadDir := kmodLoadDir
+	oriProbeDir := kmodProbeDir
+	oriExecModprobe := execModprobe
+	defer func() {
+		kmodProcModulesPath = oriProcModulesPath
+		kmodLoadDir = oriLoadDir
+		kmodProbeDir = oriProbeDir
+		execModprobe = oriExecModprobe
+	}()
+
+	dir := t.TempDir()
+	kmodProcModulesPath = filepath.Join(dir, "modules")
+	kmodLoadDir = filepath.Join(dir, "modules-load.d")
+	kmodProbeDir = filepath.Join(dir, "modprobe.d")
+	loaded := map[string]bool{"usb_storage": true}
+	writeProcModules := func() {
+		var s string
+		for name := range loaded {
+			s += name + " 81920 0 - Live 0x0000000000000000\n"
+		}
+		require.NoError(t, os.WriteFile(kmodProcModulesPath, []byte(s), 0644))
+	}
+	writeProcModules()
+	var probes []string
+	execModprobe = func(args ...string) *exec.Cmd {
+		probes = append(probes, strings.Join(args, " "))
+		if args[0] == "-r" {
+			delete(loaded, kmodName(args[1]))
+		} else {
+			loaded[kmodName(args[0])] = true
+		}
+		writeProcModules()
+		return exec.Command("true")
+	}
+
+	obj := NewCompKernelModules().(I)
+	require.NoError(t, obj.Add(`[
+		{"module": "usb-storage", "state": "blacklisted"},
+		{"module": "dm_multipath", "state": "loaded"}
+	]`))
+	assert.Error(t, obj.Add(`[{"module": "dm_multipath", "state": "unloaded"}]`))
+	assert.Error(t, obj.Add(`[{"module": "../dm_multipath", "state": "loaded"}]`))
+
+	assert.Equal(t, ExitNok, obj.Check())
+	assert.Equal(t, ExitOk, obj.Fix())
+	assert.Equal(t, []string{"-r usb-storage", "dm_multipath"}, probes)
+	assert.Equal(t, ExitOk, obj.Check())
+
+	b, err := os.ReadFile(filepath.Join(kmodProbeDir, "opensvc-blacklist.conf"))
+	require.NoError(t, err)
+	assert.Equal(t, "blacklist usb-storage\n", string(b))
+	b, err = os.ReadFile(filepath.Join(kmodLoadDir, "opensvc.conf"))
+	require.NoError(t, err)
+	assert.Equal(t, "dm_multipath\n", string(b))
+
+	t.Run("existing configuration is detected", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(filepath.Join(kmodProbeDir, "cis.conf"), []byte("# cis\nblacklist sctp\n"), 0644))
+		obj := NewCompKernelModules().(I)
+		require.NoError(t, obj.Add(`[{"module": "sctp", "state": "blacklisted"}]`))
+		assert.Equal(t, ExitOk, obj.Check())
+	})
+
+	t.Run("not applicable without /proc/modules", func(t *testing.T) {
+		kmodProcModulesPath = filepath.Join(dir, "nonexistent")
+		assert.Equal(t, ExitNotApplicable, obj.Check())
+	})
+}
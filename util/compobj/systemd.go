@@ -0,0 +1,367 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/opensvc/om3/util/systemd"
+)
+
+type (
+	CompSystemdUnits struct {
+		*Obj
+	}
+	CompSystemdUnit struct {
+		Unit    string                  `json:"unit"`
+		State   string                  `json:"state,omitempty"`
+		Active  string                  `json:"active,omitempty"`
+		DropIns []CompSystemdUnitDropIn `json:"dropins,omitempty"`
+	}
+	CompSystemdUnitDropIn struct {
+		Name    string `json:"name"`
+		Content string `json:"content"`
+	}
+)
+
+var (
+	// for mocking purposes
+	hasSystemd          = systemd.HasSystemd
+	systemdGetUnitState = systemd.GetUnitState
+	systemdUnitActions  = map[string]func(string) error{
+		"enable":  systemd.Enable,
+		"disable": systemd.Disable,
+		"mask":    systemd.Mask,
+		"unmask":  systemd.Unmask,
+		"start":   systemd.Start,
+		"stop":    systemd.Stop,
+	}
+	systemdDaemonReload = systemd.DaemonReload
+	systemdDropInFile   = systemd.DropInFile
+
+	compSystemdUnitInfo = ObjInfo{
+		DefaultPrefix: "OSVC_COMP_SYSTEMD_",
+		ExampleValue: []CompSystemdUnit{
+			{
+				Unit:   "sshd.service",
+				State:  "enabled",
+				Active: "active",
+				DropIns: []CompSystemdUnitDropIn{
+					{
+						Name:    "hardening.conf",
+						Content: "[Service]\nProtectSystem=strict\nPrivateTmp=yes\n",
+					},
+				},
+			},
+			{
+				Unit:   "telnet.socket",
+				State:  "masked",
+				Active: "inactive",
+			},
+		},
+		Description: `* Verify the systemd units enablement state: enabled, disabled or masked
+* Verify the systemd units activation state: active or inactive
+* Verify the systemd units drop-in files exist in /etc/systemd/system/<unit>.d/ with the given content
+* In the 'fix', the drop-in files are written first, the systemd configuration is reloaded, then the units are enabled, disabled or masked, and started or stopped
+* The module is not applicable on nodes without systemd
+`,
+		FormDefinition: `Desc: |
+  A rule to set the enablement and activation states of systemd units, and their drop-in override files.
+Css: comp48
+
+Outputs:
+  -
+    Dest: compliance variable
+    Type: json
+    Format: list of dict
+    Class: systemd
+
+Inputs:
+  -
+    Id: unit
+    Label: Unit
+    DisplayModeLabel: unit
+    LabelCss: action16
+    Mandatory: Yes
+    Type: string
+    Help: The systemd unit name, with its type suffix. Example: sshd.service.
+
+  -
+    Id: state
+    Label: State
+    DisplayModeLabel: state
+    LabelCss: action16
+    Mandatory: No
+    Type: string
+    Candidates:
+      - enabled
+      - disabled
+      - masked
+    Help: The expected unit enablement state. Not verified if not set.
+
+  -
+    Id: active
+    Label: Active
+    DisplayModeLabel: active
+    LabelCss: action16
+    Mandatory: No
+    Type: string
+    Candidates:
+      - active
+      - inactive
+    Help: The expected unit activation state. Not verified if not set.
+
+  -
+    Id: dropins
+    Label: Drop-in files
+    DisplayModeLabel: dropins
+    LabelCss: action16
+    Mandatory: No
+    Type: list of dict
+    Help: The drop-in override files of the unit, as a list of {"name": <file name>, "content": <file content>}.
+`,
+	}
+)
+
+func init() {
+	m["systemd"] = NewCompSystemdUnits
+}
+
+func NewCompSystemdUnits() interface{} {
+	return &CompSystemdUnits{
+		Obj: NewObj(),
+	}
+}
+
+func (t *CompSystemdUnits) Add(s string) error {
+	var data []CompSystemdUnit
+	if err := json.Unmarshal([]byte(s), &data); err != nil {
+		return err
+	}
+	for _, rule := range data {
+		if rule.Unit == "" {
+			return fmt.Errorf("unit is mandatory in dict : %s", s)
+		}
+		switch rule.State {
+		case "", "enabled", "disabled", "masked":
+		default:
+			return fmt.Errorf("state must be enabled, disabled or masked in dict : %s", s)
+		}
+		switch rule.Active {
+		case "", "active", "inactive":
+		default:
+			return fmt.Errorf("active must be active or inactive in dict : %s", s)
+		}
+		for _, dropIn := range rule.DropIns {
+			if dropIn.Name == "" || filepath.Base(dropIn.Name) != dropIn.Name {
+				return fmt.Errorf("dropins name must be a file name in dict : %s", s)
+			}
+		}
+		t.Obj.Add(rule)
+	}
+	return nil
+}
+
+func (t CompSystemdUnits) checkState(rule CompSystemdUnit, state systemd.UnitState) ExitCode {
+	if rule.State == "" {
+		return ExitOk
+	}
+	var ok bool
+	switch rule.State {
+	case "enabled":
+		ok = state.IsEnabled()
+	case "disabled":
+		ok = !state.IsEnabled() && !state.IsMasked()
+	case "masked":
+		ok = state.IsMasked()
+	}
+	if !ok {
+		t.VerboseErrorf("unit %s is %s, should be %s\n", rule.Unit, state.UnitFileState, rule.State)
+		return ExitNok
+	}
+	t.VerboseInfof("unit %s is %s\n", rule.Unit, rule.State)
+	return ExitOk
+}
+
+func (t CompSystemdUnits) checkActive(rule CompSystemdUnit, state systemd.UnitState) ExitCode {
+	if rule.Active == "" {
+		return ExitOk
+	}
+	if state.IsActive() != (rule.Active == "active") {
+		t.VerboseErrorf("unit %s is %s, should be %s\n", rule.Unit, state.ActiveState, rule.Active)
+		return ExitNok
+	}
+	t.VerboseInfof("unit %s is %s\n", rule.Unit, rule.Active)
+	return ExitOk
+}
+
+func (t CompSystemdUnits) checkDropIn(rule CompSystemdUnit, dropIn CompSystemdUnitDropIn) ExitCode {
+	p := systemdDropInFile(rule.Unit, dropIn.Name)
+	b, err := os.ReadFile(p)
+	if os.IsNotExist(err) {
+		t.VerboseErrorf("unit %s drop-in file %s does not exist\n", rule.Unit, p)
+		return ExitNok
+	} else if err != nil {
+		t.VerboseErrorf("unit %s drop-in file %s: %s\n", rule.Unit, p, err)
+		return ExitNok
+	}
+	if !bytes.Equal(b, []byte(dropIn.Content)) {
+		t.VerboseErrorf("unit %s drop-in file %s content differs\n", rule.Unit, p)
+		return ExitNok
+	}
+	t.VerboseInfof("unit %s drop-in file %s is ok\n", rule.Unit, p)
+	return ExitOk
+}
+
+func (t CompSystemdUnits) checkRule(rule CompSystemdUnit) ExitCode {
+	e := ExitOk
+	for _, dropIn := range rule.DropIns {
+		e = e.Merge(t.checkDropIn(rule, dropIn))
+	}
+	if rule.State == "" && rule.Active == "" {
+		return e
+	}
+	state, err := systemdGetUnitState(rule.Unit)
+	if err != nil {
+		t.Errorf("%s\n", err)
+		return ExitNok
+	}
+	if state.LoadState == "not-found" && rule.State != "masked" && rule.State != "disabled" {
+		t.VerboseErrorf("unit %s not found\n", rule.Unit)
+		return ExitNok
+	}
+	e = e.Merge(t.checkState(rule, state))
+	e = e.Merge(t.checkActive(rule, state))
+	return e
+}
+
+func (t CompSystemdUnits) fixDropIn(rule CompSystemdUnit, dropIn CompSystemdUnitDropIn) ExitCode {
+	p := systemdDropInFile(rule.Unit, dropIn.Name)
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		t.Errorf("unit %s: create drop-in dir %s: %s\n", rule.Unit, filepath.Dir(p), err)
+		return ExitNok
+	}
+	if _, err := backup(p); err != nil {
+		t.Errorf("unit %s: %s\n", rule.Unit, err)
+		return ExitNok
+	}
+	if err := os.WriteFile(p, []byte(dropIn.Content), 0644); err != nil {
+		t.Errorf("unit %s: write drop-in file %s: %s\n", rule.Unit, p, err)
+		return ExitNok
+	}
+	t.Infof("unit %s: write drop-in file %s\n", rule.Unit, p)
+	return ExitOk
+}
+
+func (t CompSystemdUnits) unitAction(action, unit string) ExitCode {
+	if err := systemdUnitActions[action](unit); err != nil {
+		t.Errorf("%s\n", err)
+		return ExitNok
+	}
+	t.Infof("%s unit %s\n", action, unit)
+	return ExitOk
+}
+
+func (t CompSystemdUnits) fixDropIns(rule CompSystemdUnit) (ExitCode, bool) {
+	e := ExitOk
+	var reload bool
+	for _, dropIn := range rule.DropIns {
+		if t.checkDropIn(rule, dropIn) == ExitOk {
+			continue
+		}
+		e = e.Merge(t.fixDropIn(rule, dropIn))
+		reload = true
+	}
+	return e, reload
+}
+
+func (t CompSystemdUnits) fixUnitState(rule CompSystemdUnit) ExitCode {
+	if rule.State == "" && rule.Active == "" {
+		return ExitOk
+	}
+	state, err := systemdGetUnitState(rule.Unit)
+	if err != nil {
+		t.Errorf("%s\n", err)
+		return ExitNok
+	}
+	e := ExitOk
+	if t.checkState(rule, state) == ExitNok {
+		switch rule.State {
+		case "enabled":
+			if state.IsMasked() {
+				e = e.Merge(t.unitAction("unmask", rule.Unit))
+			}
+			e = e.Merge(t.unitAction("enable", rule.Unit))
+		case "disabled":
+			if state.IsMasked() {
+				e = e.Merge(t.unitAction("unmask", rule.Unit))
+			}
+			if state.IsEnabled() {
+				e = e.Merge(t.unitAction("disable", rule.Unit))
+			}
+		case "masked":
+			e = e.Merge(t.unitAction("mask", rule.Unit))
+		}
+	}
+	if t.checkActive(rule, state) == ExitNok {
+		switch rule.Active {
+		case "active":
+			e = e.Merge(t.unitAction("start", rule.Unit))
+		case "inactive":
+			e = e.Merge(t.unitAction("stop", rule.Unit))
+		}
+	}
+	return e
+}
+
+func (t CompSystemdUnits) Check() ExitCode {
+	if !hasSystemd() {
+		t.Infof("systemd is not running on this node\n")
+		return ExitNotApplicable
+	}
+	t.SetVerbose(true)
+	e := ExitOk
+	for _, i := range t.Rules() {
+		rule := i.(CompSystemdUnit)
+		e = e.Merge(t.checkRule(rule))
+	}
+	return e
+}
+
+func (t CompSystemdUnits) Fix() ExitCode {
+	if !hasSystemd() {
+		t.Infof("systemd is not running on this node\n")
+		return ExitNotApplicable
+	}
+	t.SetVerbose(false)
+	e := ExitOk
+	var reload bool
+	for _, i := range t.Rules() {
+		rule := i.(CompSystemdUnit)
+		o, changed := t.fixDropIns(rule)
+		e = e.Merge(o)
+		reload = reload || changed
+	}
+	if reload {
+		if err := systemdDaemonReload(); err != nil {
+			t.Errorf("%s\n", err)
+			return ExitNok
+		}
+		t.Infof("reload the systemd configuration\n")
+	}
+	for _, i := range t.Rules() {
+		rule := i.(CompSystemdUnit)
+		e = e.Merge(t.fixUnitState(rule))
+	}
+	return e
+}
+
+func (t CompSystemdUnits) Fixable() ExitCode {
+	return ExitNotApplicable
+}
+
+func (t CompSystemdUnits) Info() ObjInfo {
+	return compSystemdUnitInfo
+}
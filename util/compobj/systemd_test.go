@@ -0,0 +1,76 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/util/systemd"
+)
+
+func TestSystemdUnits(t *testing.T) {
+	oriHasSystemd := hasSystemd
+	oriGetUnitState := systemdGetUnitState
+	oriUnitActions := systemdUnitActions
+	oriDaemonReload := systemdDaemonReload
+	oriDropInFile := systemdDropInFile
+	defer func() {
+		hasSystemd = oriHasSystemd
+		systemdGetUnitState = oriGetUnitState
+		systemdUnitActions = oriUnitActions
+		systemdDaemonReload = oriDaemonReload
+		systemdDropInFile = oriDropInFile
+	}()
+
+	units := map[string]*systemd.UnitState{
+		"sshd.service":  {LoadState: "loaded", ActiveState: "inactive", UnitFileState: "disabled"},
+		"telnet.socket": {LoadState: "loaded", ActiveState: "active", UnitFileState: "enabled"},
+	}
+	dir := t.TempDir()
+	var reloaded bool
+	hasSystemd = func() bool { return true }
+	systemdGetUnitState = func(unit string) (systemd.UnitState, error) {
+		if state, ok := units[unit]; ok {
+			return *state, nil
+		}
+		return systemd.UnitState{LoadState: "not-found", ActiveState: "inactive"}, nil
+	}
+	systemdUnitActions = map[string]func(string) error{
+		"enable":  func(unit string) error { units[unit].UnitFileState = "enabled"; return nil },
+		"disable": func(unit string) error { units[unit].UnitFileState = "disabled"; return nil },
+		"mask":    func(unit string) error { units[unit].UnitFileState = "masked"; return nil },
+		"unmask":  func(unit string) error { units[unit].UnitFileState = "disabled"; return nil },
+		"start":   func(unit string) error { units[unit].ActiveState = "active"; return nil },
+		"stop":    func(unit string) error { units[unit].ActiveState = "inactive"; return nil },
+	}
+	systemdDaemonReload = func() error { reloaded = true; return nil }
+	systemdDropInFile = func(unit, name string) string { return filepath.Join(dir, unit+".d", name) }
+
+	obj := NewCompSystemdUnits().(I)
+	require.NoError(t, obj.Add(`[
+		{"unit": "sshd.service", "state": "enabled", "active": "active", "dropins": [{"name": "hardening.conf", "content": "[Service]\nPrivateTmp=yes\n"}]},
+		{"unit": "telnet.socket", "state": "masked", "active": "inactive"},
+		{"unit": "rsh.socket", "state": "disabled"}
+	]`))
+	assert.Error(t, obj.Add(`[{"unit": "sshd.service", "state": "on"}]`))
+	assert.Error(t, obj.Add(`[{"unit": "sshd.service", "dropins": [{"name": "../x.conf"}]}]`))
+
+	assert.Equal(t, ExitNok, obj.Check())
+	assert.Equal(t, ExitOk, obj.Fix())
+	assert.True(t, reloaded, "the systemd configuration is reloaded after the drop-in files change")
+	assert.Equal(t, ExitOk, obj.Check())
+
+	assert.Equal(t, "enabled", units["sshd.service"].UnitFileState)
+	assert.Equal(t, "active", units["sshd.service"].ActiveState)
+	assert.Equal(t, "masked", units["telnet.socket"].UnitFileState)
+	assert.Equal(t, "inactive", units["telnet.socket"].ActiveState)
+	b, err := os.ReadFile(filepath.Join(dir, "sshd.service.d", "hardening.conf"))
+	require.NoError(t, err)
+	assert.Equal(t, "[Service]\nPrivateTmp=yes\n", string(b))
+
+	hasSystemd = func() bool { return false }
+	assert.Equal(t, ExitNotApplicable, obj.Check())
+}
@@ -34,6 +34,14 @@ func (t T) Model() (string, error) {
 	return "", ErrNotApplicable
 }
 
+func (t T) Rescan() error {
+	return ErrNotApplicable
+}
+
+func (t T) ResizeMultipath() error {
+	return ErrNotApplicable
+}
+
 func (t T) Remove() error {
 	return ErrNotApplicable
 }
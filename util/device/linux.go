@@ -95,6 +95,16 @@ func (t T) Delete() error {
 	return os.WriteFile(p, []byte("1"), os.ModePerm)
 }
 
+// Rescan makes the kernel reread the size of the scsi device.
+func (t T) Rescan() error {
+	p, err := t.sysfsFile()
+	if err != nil {
+		return err
+	}
+	p = p + "/device/rescan"
+	return os.WriteFile(p, []byte("1"), os.ModePerm)
+}
+
 func (t T) SlaveHosts() ([]string, error) {
 	var errs error
 	l := make([]string, 0)
@@ -303,6 +313,24 @@ func (t T) Wipe() error {
 	return nil
 }
 
+// ResizeMultipath makes multipathd resize the map to the size of its
+// paths, which must be rescanned first.
+func (t T) ResizeMultipath() error {
+	cmd := command.New(
+		command.WithName("multipathd"),
+		command.WithVarArgs("resize", "map", filepath.Base(t.path)),
+		command.WithLogger(t.log),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	cmd.Run()
+	if cmd.ExitCode() != 0 {
+		return fmt.Errorf("%s error %d", cmd, cmd.ExitCode())
+	}
+	return nil
+}
+
 func (t T) RemoveMultipath() error {
 	cmd := command.New(
 		command.WithName("multipath"),
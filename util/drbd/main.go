@@ -351,6 +351,21 @@ func (t T) Up() error {
 	return retry(cmd)
 }
 
+// Resize makes the resource grow to the smallest size of the backing
+// devices of its connected peers.
+func (t T) Resize() error {
+	args := []string{"resize", t.res}
+	cmd := command.New(
+		command.WithName(drbdadm),
+		command.WithArgs(args),
+		command.WithLogger(t.log),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	return retry(cmd)
+}
+
 func (t T) CreateMD(maxPeers int) error {
 	args := []string{"create-md", "--force", "--max-peers", fmt.Sprint(maxPeers), t.res}
 	cmd := command.New(
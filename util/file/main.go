@@ -168,6 +168,22 @@ func ModTime(p string) (mtime time.Time) {
 	return
 }
 
+// LastModTime returns the most recent modification time of the files, or
+// an error if one of the files can not be stat'ed.
+func LastModTime(files ...string) (time.Time, error) {
+	var last time.Time
+	for _, name := range files {
+		info, err := os.Stat(name)
+		if err != nil {
+			return last, err
+		}
+		if info.ModTime().After(last) {
+			last = info.ModTime()
+		}
+	}
+	return last, nil
+}
+
 // Touch updates the atime and mtime of an existing file, or creates the file if it
 // does not exist yet.
 func Touch(p string, tm time.Time) error {
@@ -2,6 +2,7 @@ package file
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -37,3 +38,16 @@ func TestTouch(t *testing.T) {
 	mtime := ModTime(p)
 	assert.WithinDuration(t, now, mtime, 0)
 }
+
+func TestLastModTime(t *testing.T) {
+	dir := t.TempDir()
+	older, newer := filepath.Join(dir, "older"), filepath.Join(dir, "newer")
+	now := time.Now()
+	assert.NoError(t, Touch(older, now.Add(-time.Hour)))
+	assert.NoError(t, Touch(newer, now))
+	mtime, err := LastModTime(older, newer)
+	assert.NoError(t, err)
+	assert.WithinDuration(t, now, mtime, 0)
+	_, err = LastModTime(older, filepath.Join(dir, "missing"))
+	assert.Error(t, err)
+}
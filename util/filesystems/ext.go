@@ -68,3 +68,48 @@ func xMKFS(x string, s string, xargs []string, log *plog.Logger) error {
 	)
 	return cmd.Run()
 }
+
+func extGrow(s string, log *plog.Logger) error {
+	if _, err := exec.LookPath("resize2fs"); err != nil {
+		return errors.New("resize2fs not found")
+	}
+	cmd := command.New(
+		command.WithName("resize2fs"),
+		command.WithVarArgs(s),
+		command.WithLogger(log),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	return cmd.Run()
+}
+
+// extRegenerateUUID replays the journal and checks the filesystem, as
+// tune2fs refuses to change the uuid of a filesystem needing a recovery,
+// then sets a new random uuid.
+func extRegenerateUUID(s string, log *plog.Logger) error {
+	if _, err := exec.LookPath("tune2fs"); err != nil {
+		return errors.New("tune2fs not found")
+	}
+	fsck := command.New(
+		command.WithName("e2fsck"),
+		command.WithVarArgs("-f", "-y", s),
+		command.WithLogger(log),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.DebugLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+		command.WithIgnoredExitCodes(0, 1),
+	)
+	if err := fsck.Run(); err != nil {
+		return err
+	}
+	cmd := command.New(
+		command.WithName("tune2fs"),
+		command.WithVarArgs("-U", "random", s),
+		command.WithLogger(log),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	return cmd.Run()
+}
@@ -30,3 +30,7 @@ func (t Ext2) IsFormated(s string) (bool, error) {
 func (t Ext2) MKFS(s string, args []string) error {
 	return xMKFS("mkfs.ext2", s, args, t.log)
 }
+
+func (t Ext2) RegenerateUUID(s string) error {
+	return extRegenerateUUID(s, t.log)
+}
@@ -30,3 +30,11 @@ func (t Ext3) IsFormated(s string) (bool, error) {
 func (t Ext3) MKFS(s string, args []string) error {
 	return xMKFS("mkfs.ext3", s, args, t.log)
 }
+
+func (t Ext3) Grow(s, mnt string) error {
+	return extGrow(s, t.log)
+}
+
+func (t Ext3) RegenerateUUID(s string) error {
+	return extRegenerateUUID(s, t.log)
+}
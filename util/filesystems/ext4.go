@@ -30,3 +30,11 @@ func (t Ext4) IsFormated(s string) (bool, error) {
 func (t Ext4) MKFS(s string, args []string) error {
 	return xMKFS("mkfs.ext4", s, args, t.log)
 }
+
+func (t Ext4) Grow(s, mnt string) error {
+	return extGrow(s, t.log)
+}
+
+func (t Ext4) RegenerateUUID(s string) error {
+	return extRegenerateUUID(s, t.log)
+}
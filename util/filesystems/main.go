@@ -54,6 +54,20 @@ type (
 	MKFSer interface {
 		MKFS(string, []string) error
 	}
+
+	// Grower is implemented by the filesystems able to grow online to
+	// the size of their device. The arguments are the device path and
+	// the mount point.
+	Grower interface {
+		Grow(string, string) error
+	}
+
+	// UUIDRegenerater is implemented by the filesystems able to replace
+	// their uuid, so a block copy can be mounted next to its origin. The
+	// argument is the device path of the unmounted filesystem.
+	UUIDRegenerater interface {
+		RegenerateUUID(string) error
+	}
 )
 
 var (
@@ -3,6 +3,7 @@ package filesystems
 import (
 	"errors"
 	"fmt"
+	"os"
 	"os/exec"
 
 	"github.com/opensvc/om3/util/command"
@@ -55,6 +56,50 @@ func (t XFS) MKFS(devpath string, args []string) error {
 	return cmd.Run()
 }
 
+func (t XFS) Grow(devpath, mnt string) error {
+	if _, err := exec.LookPath("xfs_growfs"); err != nil {
+		return errors.New("xfs_growfs not found")
+	}
+	cmd := command.New(
+		command.WithName("xfs_growfs"),
+		command.WithVarArgs(mnt),
+		command.WithLogger(t.log),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	return cmd.Run()
+}
+
+// RegenerateUUID replays the log of the filesystem, mounting it with the
+// nouuid option in a temporary directory, as xfs_admin refuses to change
+// the uuid of a filesystem with a dirty log, then sets a new uuid.
+func (t XFS) RegenerateUUID(devpath string) error {
+	if _, err := exec.LookPath("xfs_admin"); err != nil {
+		return errors.New("xfs_admin not found")
+	}
+	mnt, err := os.MkdirTemp("", "xfs-uuid-")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(mnt) }()
+	if err := t.Mount(devpath, mnt, "nouuid"); err != nil {
+		return err
+	}
+	if err := t.Umount(mnt); err != nil {
+		return err
+	}
+	cmd := command.New(
+		command.WithName("xfs_admin"),
+		command.WithVarArgs("-U", "generate", devpath),
+		command.WithLogger(t.log),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	return cmd.Run()
+}
+
 func (t XFS) IsCapable() bool {
 	if _, err := exec.LookPath("mkfs.xfs"); err != nil {
 		return false
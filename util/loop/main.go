@@ -154,6 +154,35 @@ func (t T) Delete(devPath string) error {
 
 }
 
+// Refresh makes the loop device devPath reread the size of its
+// backing file.
+func (t T) Refresh(devPath string) error {
+	cmd := command.New(
+		command.WithName(losetup),
+		command.WithVarArgs("-c", devPath),
+		command.WithLogger(t.log),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	cmd.Run()
+	fcache.Clear("losetup")
+	if cmd.ExitCode() != 0 {
+		return fmt.Errorf("%s error %d", cmd, cmd.ExitCode())
+	}
+	return nil
+}
+
+// FileRefresh makes the loop device attached to filePath reread the
+// size of the file.
+func (t T) FileRefresh(filePath string) error {
+	i, err := t.FileGet(filePath)
+	if err != nil {
+		return err
+	}
+	return t.Refresh(i.Name)
+}
+
 func (t InfoEntries) File(s string) *InfoEntry {
 	for _, i := range t {
 		if i.BackFile == s {
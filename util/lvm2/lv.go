@@ -32,6 +32,8 @@ type (
 		ConvertPV       string `json:"convert_pv"`
 		MirrorLog       string `json:"mirror_log"`
 		Devices         string `json:"devices"`
+		PoolLV          string `json:"pool_lv"`
+		LVTime          string `json:"lv_time"`
 	}
 	LV struct {
 		driver
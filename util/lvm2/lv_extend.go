@@ -0,0 +1,55 @@
+//go:build linux
+
+package lvm2
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/rs/zerolog"
+
+	"github.com/opensvc/om3/util/command"
+)
+
+// Size returns the logical volume size in bytes.
+func (t *LV) Size() (int64, error) {
+	data := ShowData{}
+	fqn := t.FQN()
+	cmd := command.New(
+		command.WithName("lvs"),
+		command.WithVarArgs("--reportformat", "json", "--units", "b", "--nosuffix", "-o", "lv_size", fqn),
+		command.WithLogger(t.Log()),
+		command.WithCommandLogLevel(zerolog.DebugLevel),
+		command.WithStdoutLogLevel(zerolog.DebugLevel),
+		command.WithStderrLogLevel(zerolog.DebugLevel),
+		command.WithBufferedStdout(),
+	)
+	if err := cmd.Run(); err != nil {
+		if cmd.ExitCode() == 5 {
+			return 0, fmt.Errorf("%w: %s", ErrExist, fqn)
+		}
+		return 0, err
+	}
+	if err := json.Unmarshal(cmd.Stdout(), &data); err != nil {
+		return 0, err
+	}
+	if len(data.Report) != 1 || len(data.Report[0].LV) != 1 {
+		return 0, fmt.Errorf("%w: %s", ErrExist, fqn)
+	}
+	return strconv.ParseInt(data.Report[0].LV[0].LVSize, 10, 64)
+}
+
+// Extend grows the logical volume to size bytes. The filesystem it may
+// contain is not resized.
+func (t *LV) Extend(size int64) error {
+	cmd := command.New(
+		command.WithName("lvextend"),
+		command.WithVarArgs("-L", fmt.Sprintf("%dB", size), t.FQN()),
+		command.WithLogger(t.Log()),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	return cmd.Run()
+}
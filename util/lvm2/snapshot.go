@@ -0,0 +1,135 @@
+//go:build linux
+
+package lvm2
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/opensvc/om3/util/command"
+	"github.com/opensvc/om3/util/sizeconv"
+)
+
+type (
+	// SnapshotInfo describes a snapshot lv of an origin lv.
+	SnapshotInfo struct {
+		LVName    string
+		CreatedAt time.Time
+
+		// Size is the snapshot copy-on-write space size, in bytes.
+		Size int64
+
+		// Used is the allocated copy-on-write space, in bytes.
+		Used int64
+	}
+)
+
+// CreateSnapshot creates the <name> copy-on-write snapshot lv of the lv.
+// The <size> of the copy-on-write space is either a size or an extents
+// expression like "20%ORIGIN".
+func (t *LV) CreateSnapshot(name, size string) error {
+	args := []string{"-s"}
+	if strings.Contains(size, "%") {
+		args = append(args, "-l", size)
+	} else if i, err := sizeconv.FromSize(size); err == nil {
+		args = append(args, "-L", fmt.Sprintf("%dB", i))
+	} else {
+		args = append(args, "-L", size)
+	}
+	args = append(args, "--yes", "-n", name, t.FQN())
+	cmd := command.New(
+		command.WithName("lvcreate"),
+		command.WithArgs(args),
+		command.WithLogger(t.Log()),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	cmd.Run()
+	if cmd.ExitCode() != 0 {
+		return fmt.Errorf("%s error %d", cmd, cmd.ExitCode())
+	}
+	return nil
+}
+
+// Merge merges the lv, which must be a snapshot, into its origin lv. The
+// snapshot lv is removed by the merge. If the origin lv is open, the merge
+// is deferred to its next activation.
+func (t *LV) Merge() error {
+	cmd := command.New(
+		command.WithName("lvconvert"),
+		command.WithVarArgs("--merge", "--yes", t.FQN()),
+		command.WithLogger(t.Log()),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	cmd.Run()
+	if cmd.ExitCode() != 0 {
+		return fmt.Errorf("%s error %d", cmd, cmd.ExitCode())
+	}
+	return nil
+}
+
+// Snapshots returns the snapshot lvs of the lv.
+func (t *LV) Snapshots() ([]SnapshotInfo, error) {
+	data := ShowData{}
+	cmd := command.New(
+		command.WithName("lvs"),
+		command.WithVarArgs("--reportformat", "json", "--units", "b", "--nosuffix", "-o", "lv_name,lv_size,origin,lv_time,data_percent", t.VGName),
+		command.WithLogger(t.Log()),
+		command.WithCommandLogLevel(zerolog.DebugLevel),
+		command.WithStdoutLogLevel(zerolog.DebugLevel),
+		command.WithStderrLogLevel(zerolog.DebugLevel),
+		command.WithBufferedStdout(),
+	)
+	if err := cmd.Run(); err != nil {
+		if cmd.ExitCode() == 5 {
+			return nil, fmt.Errorf("%w: %s", ErrExist, t.VGName)
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(cmd.Stdout(), &data); err != nil {
+		return nil, err
+	}
+	if len(data.Report) != 1 {
+		return nil, fmt.Errorf("%s: no report", cmd)
+	}
+	return parseSnapshots(t.LVName, data.Report[0].LV)
+}
+
+func parseSnapshots(origin string, l []LVInfo) ([]SnapshotInfo, error) {
+	snaps := make([]SnapshotInfo, 0)
+	for _, lv := range l {
+		if lv.Origin != origin {
+			continue
+		}
+		snap := SnapshotInfo{LVName: lv.LVName}
+		size, err := sizeconv.FromSize(strings.TrimLeft(lv.LVSize, "<>+"))
+		if err != nil {
+			return nil, fmt.Errorf("snapshot %s size: %w", lv.LVName, err)
+		}
+		snap.Size = size
+		if lv.DataPercent != "" {
+			pct, err := strconv.ParseFloat(lv.DataPercent, 64)
+			if err != nil {
+				return nil, fmt.Errorf("snapshot %s data percent: %w", lv.LVName, err)
+			}
+			snap.Used = int64(float64(size) * pct / 100)
+		}
+		if lv.LVTime != "" {
+			tm, err := time.Parse("2006-01-02 15:04:05 -0700", lv.LVTime)
+			if err != nil {
+				return nil, fmt.Errorf("snapshot %s time: %w", lv.LVName, err)
+			}
+			snap.CreatedAt = tm
+		}
+		snaps = append(snaps, snap)
+	}
+	return snaps, nil
+}
@@ -0,0 +1,24 @@
+//go:build linux
+
+package lvm2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSnapshots(t *testing.T) {
+	l := []LVInfo{
+		{LVName: "lv1", LVSize: "10737418240"},
+		{LVName: "lv1_snap_daily", LVSize: "1073741824", Origin: "lv1", DataPercent: "50.00", LVTime: "2024-03-01 10:12:13 +0100"},
+		{LVName: "lv2_snap_daily", LVSize: "1073741824", Origin: "lv2"},
+	}
+	snaps, err := parseSnapshots("lv1", l)
+	require.NoError(t, err)
+	require.Len(t, snaps, 1)
+	require.Equal(t, "lv1_snap_daily", snaps[0].LVName)
+	require.Equal(t, int64(536870912), snaps[0].Used)
+	require.True(t, snaps[0].CreatedAt.Equal(time.Date(2024, 3, 1, 9, 12, 13, 0, time.UTC)))
+}
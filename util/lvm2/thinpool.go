@@ -0,0 +1,142 @@
+//go:build linux
+
+package lvm2
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog"
+
+	"github.com/opensvc/om3/util/command"
+	"github.com/opensvc/om3/util/sizeconv"
+)
+
+type (
+	// ThinPoolUsage describes the space usage of a thin pool lv.
+	ThinPoolUsage struct {
+		// Size is the thin pool data size, in bytes.
+		Size int64
+
+		// DataPercent is the percentage of the data space allocated.
+		DataPercent float64
+
+		// MetadataPercent is the percentage of the metadata space allocated.
+		MetadataPercent float64
+
+		// Virtual is the sum of the thin volumes virtual sizes, in bytes.
+		Virtual int64
+
+		// Volumes is the number of thin volumes in the thin pool.
+		Volumes int
+	}
+)
+
+// Used returns the allocated data space, in bytes.
+func (t ThinPoolUsage) Used() int64 {
+	return int64(float64(t.Size) * t.DataPercent / 100)
+}
+
+// Overcommit returns the ratio of the thin volumes virtual sizes to the
+// thin pool data size.
+func (t ThinPoolUsage) Overcommit() float64 {
+	if t.Size == 0 {
+		return 0
+	}
+	return float64(t.Virtual) / float64(t.Size)
+}
+
+// CreateThin creates the lv as a thin volume of virtual size <size> in the
+// <pool> thin pool of the vg.
+func (t *LV) CreateThin(pool, size string, args []string) error {
+	if i, err := sizeconv.FromSize(size); err == nil {
+		// default unit is not "B", explicitely tell
+		size = fmt.Sprintf("%dB", i)
+	}
+	args = append(args, "-V", size, "--thinpool", pool)
+	cmd := command.New(
+		command.WithName("lvcreate"),
+		command.WithArgs(append(args, "--yes", "-n", t.LVName, t.VGName)),
+		command.WithLogger(t.Log()),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	cmd.Run()
+	if cmd.ExitCode() != 0 {
+		return fmt.Errorf("%s error %d", cmd, cmd.ExitCode())
+	}
+	return nil
+}
+
+// ThinPoolUsage returns the space usage of the lv, which must be a thin pool.
+func (t *LV) ThinPoolUsage() (ThinPoolUsage, error) {
+	data := ShowData{}
+	cmd := command.New(
+		command.WithName("lvs"),
+		command.WithVarArgs("--reportformat", "json", "--units", "b", "--nosuffix", "-o", "lv_name,lv_size,data_percent,metadata_percent,pool_lv", t.VGName),
+		command.WithLogger(t.Log()),
+		command.WithCommandLogLevel(zerolog.DebugLevel),
+		command.WithStdoutLogLevel(zerolog.DebugLevel),
+		command.WithStderrLogLevel(zerolog.DebugLevel),
+		command.WithBufferedStdout(),
+	)
+	if err := cmd.Run(); err != nil {
+		if cmd.ExitCode() == 5 {
+			return ThinPoolUsage{}, fmt.Errorf("%w: %s", ErrExist, t.VGName)
+		}
+		return ThinPoolUsage{}, err
+	}
+	if err := json.Unmarshal(cmd.Stdout(), &data); err != nil {
+		return ThinPoolUsage{}, err
+	}
+	if len(data.Report) != 1 {
+		return ThinPoolUsage{}, fmt.Errorf("%s: no report", cmd)
+	}
+	return parseThinPoolUsage(t.LVName, data.Report[0].LV)
+}
+
+func parseThinPoolUsage(pool string, l []LVInfo) (ThinPoolUsage, error) {
+	var (
+		usage ThinPoolUsage
+		found bool
+		err   error
+	)
+	parseSize := func(s string) (int64, error) {
+		return sizeconv.FromSize(strings.TrimLeft(s, "<>+"))
+	}
+	parsePercent := func(s string) (float64, error) {
+		if s == "" {
+			return 0, nil
+		}
+		return strconv.ParseFloat(s, 64)
+	}
+	for _, lv := range l {
+		switch {
+		case lv.LVName == pool:
+			found = true
+			if usage.Size, err = parseSize(lv.LVSize); err != nil {
+				return usage, err
+			}
+			if usage.DataPercent, err = parsePercent(lv.DataPercent); err != nil {
+				return usage, err
+			}
+			if usage.MetadataPercent, err = parsePercent(lv.MetadataPercent); err != nil {
+				return usage, err
+			}
+		case lv.PoolLV == pool:
+			size, err := parseSize(lv.LVSize)
+			if err != nil {
+				return usage, err
+			}
+			usage.Virtual += size
+			usage.Volumes++
+		}
+	}
+	if !found {
+		return usage, fmt.Errorf("%w: thin pool %s", ErrExist, pool)
+	}
+	return usage, nil
+}
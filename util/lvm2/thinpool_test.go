@@ -0,0 +1,31 @@
+//go:build linux
+
+package lvm2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseThinPoolUsage(t *testing.T) {
+	l := []LVInfo{
+		{LVName: "tp1", LVSize: "10737418240", DataPercent: "25.00", MetadataPercent: "1.50"},
+		{LVName: "thin1", LVSize: "8589934592", PoolLV: "tp1", DataPercent: "10.00"},
+		{LVName: "thin2", LVSize: "8589934592", PoolLV: "tp1", DataPercent: "20.00"},
+		{LVName: "thin3", LVSize: "1073741824", PoolLV: "tp2"},
+		{LVName: "thick1", LVSize: "1073741824"},
+	}
+	usage, err := parseThinPoolUsage("tp1", l)
+	require.NoError(t, err)
+	require.Equal(t, int64(10737418240), usage.Size)
+	require.Equal(t, 25.0, usage.DataPercent)
+	require.Equal(t, 1.5, usage.MetadataPercent)
+	require.Equal(t, int64(17179869184), usage.Virtual)
+	require.Equal(t, 2, usage.Volumes)
+	require.Equal(t, int64(2684354560), usage.Used())
+	require.Equal(t, 1.6, usage.Overcommit())
+
+	_, err = parseThinPoolUsage("tp3", l)
+	require.ErrorIs(t, err, ErrExist)
+}
@@ -394,3 +394,32 @@ func (t *VG) ActiveLVs() (device.L, error) {
 	}
 	return l, nil
 }
+
+// ListVGs returns the name, attributes, size and free space of the volume
+// groups visible on the node.
+func ListVGs() ([]VGInfo, error) {
+	cmd := command.New(
+		command.WithName("vgs"),
+		command.WithVarArgs("--reportformat", "json", "--units", "b", "--nosuffix", "-o", "vg_name,vg_attr,vg_size,vg_free"),
+		command.WithCommandLogLevel(zerolog.DebugLevel),
+		command.WithStdoutLogLevel(zerolog.DebugLevel),
+		command.WithStderrLogLevel(zerolog.DebugLevel),
+		command.WithBufferedStdout(),
+	)
+	if err := cmd.Run(); err != nil {
+		return nil, err
+	}
+	return parseVGs(cmd.Stdout())
+}
+
+// parseVGs parses the json report of the 'vgs' command.
+func parseVGs(b []byte) ([]VGInfo, error) {
+	data := ShowData{}
+	if err := json.Unmarshal(b, &data); err != nil {
+		return nil, err
+	}
+	if len(data.Report) != 1 {
+		return nil, fmt.Errorf("vgs: no report")
+	}
+	return data.Report[0].VG, nil
+}
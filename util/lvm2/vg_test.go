@@ -33,3 +33,33 @@ func TestVGInfo_Size(t *testing.T) {
 		require.Equal(t, expected, size)
 	}
 }
+
+func TestParseVGs(t *testing.T) {
+	b := []byte(`  {
+      "report": [
+          {
+              "vg": [
+                  {"vg_name":"data", "vg_attr":"wz--n-", "vg_size":"10733223936", "vg_free":"2680160256"},
+                  {"vg_name":"root", "vg_attr":"wz--n-", "vg_size":"<21474836480", "vg_free":"0"}
+              ]
+          }
+      ]
+  }
+`)
+	l, err := parseVGs(b)
+	require.NoError(t, err)
+	require.Len(t, l, 2)
+	require.Equal(t, "data", l[0].VGName)
+	size, err := l[0].Size()
+	require.NoError(t, err)
+	require.Equal(t, int64(10733223936), size)
+	free, err := l[0].Free()
+	require.NoError(t, err)
+	require.Equal(t, int64(2680160256), free)
+	size, err = l[1].Size()
+	require.NoError(t, err)
+	require.Equal(t, int64(21474836480), size)
+
+	_, err = parseVGs([]byte(`{"report": []}`))
+	require.Error(t, err)
+}
@@ -0,0 +1,111 @@
+package md
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type (
+	// MdstatArray is the state of a md array, as reported by /proc/mdstat.
+	MdstatArray struct {
+		Name string
+
+		// State is active or inactive.
+		State string
+
+		// Level is the raid personality: raid0, raid1, raid5, ...
+		Level string
+
+		// Devices is the number of devices of a healthy array.
+		Devices int
+
+		// ActiveDevices is the number of devices in sync.
+		ActiveDevices int
+
+		// FailedDevices is the number of member devices flagged faulty.
+		FailedDevices int
+
+		// Action is the running recovery, resync, reshape or check action.
+		Action string
+	}
+)
+
+var (
+	// MdstatFile is the path of the md arrays state file. It can be
+	// replaced for tests.
+	MdstatFile = "/proc/mdstat"
+
+	reMdstatCounts = regexp.MustCompile(`\[(\d+)/(\d+)\]`)
+	reMdstatAction = regexp.MustCompile(`\b(recovery|resync|reshape|check)\s*=`)
+)
+
+// Degraded returns the number of missing devices of the array.
+func (t MdstatArray) Degraded() int {
+	if t.Devices <= t.ActiveDevices {
+		return 0
+	}
+	return t.Devices - t.ActiveDevices
+}
+
+// ParseMdstat returns the arrays described in a /proc/mdstat content.
+func ParseMdstat(b []byte) []MdstatArray {
+	l := make([]MdstatArray, 0)
+	var current *MdstatArray
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "md") && strings.Contains(line, " : ") {
+			if current != nil {
+				l = append(l, *current)
+			}
+			name, desc, _ := strings.Cut(line, " : ")
+			words := strings.Fields(desc)
+			current = &MdstatArray{Name: strings.TrimSpace(name)}
+			if len(words) > 0 {
+				current.State = words[0]
+				words = words[1:]
+			}
+			if len(words) > 0 && strings.HasPrefix(words[0], "(") {
+				// (read-only) or (auto-read-only)
+				words = words[1:]
+			}
+			if len(words) > 0 && !strings.Contains(words[0], "[") {
+				current.Level = words[0]
+				words = words[1:]
+			}
+			for _, word := range words {
+				if strings.HasSuffix(word, "(F)") {
+					current.FailedDevices++
+				}
+			}
+			continue
+		}
+		if current == nil {
+			continue
+		}
+		if match := reMdstatCounts.FindStringSubmatch(line); match != nil {
+			current.Devices, _ = strconv.Atoi(match[1])
+			current.ActiveDevices, _ = strconv.Atoi(match[2])
+		}
+		if match := reMdstatAction.FindStringSubmatch(line); match != nil {
+			current.Action = match[1]
+		}
+	}
+	if current != nil {
+		l = append(l, *current)
+	}
+	return l
+}
+
+// ListMdstatArrays returns the arrays described in /proc/mdstat.
+func ListMdstatArrays() ([]MdstatArray, error) {
+	b, err := os.ReadFile(MdstatFile)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMdstat(b), nil
+}
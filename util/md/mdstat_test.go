@@ -0,0 +1,29 @@
+package md
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMdstat(t *testing.T) {
+	b := []byte(`Personalities : [raid1] [raid6] [raid5] [raid4]
+md127 : active raid1 sdb1[1] sda1[0](F)
+      1046528 blocks super 1.2 [2/1] [_U]
+      [=>...................]  recovery =  8.5% (89344/1046528) finish=0.5min speed=29781K/sec
+
+md0 : active raid5 sdd[3] sdc[1] sdb[0]
+      2093056 blocks super 1.2 level 5, 512k chunk, algorithm 2 [3/3] [UUU]
+
+md126 : inactive sde[0](S)
+      1046528 blocks super 1.2
+
+unused devices: <none>
+`)
+	assert.Equal(t, []MdstatArray{
+		{Name: "md127", State: "active", Level: "raid1", Devices: 2, ActiveDevices: 1, FailedDevices: 1, Action: "recovery"},
+		{Name: "md0", State: "active", Level: "raid5", Devices: 3, ActiveDevices: 3},
+		{Name: "md126", State: "inactive"},
+	}, ParseMdstat(b))
+	assert.Equal(t, 1, ParseMdstat(b)[0].Degraded())
+}
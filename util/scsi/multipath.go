@@ -0,0 +1,101 @@
+package scsi
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+type (
+	// MultipathDevice is a device-mapper multipath map and its paths, as
+	// exposed in sysfs.
+	MultipathDevice struct {
+		// Name is the device-mapper name of the map, the /dev/mapper/<name>
+		// entry.
+		Name string
+
+		// Dev is the /dev/dm-<minor> path of the map.
+		Dev string
+
+		// WWID is the identifier of the lun, as set in the map uuid.
+		WWID string
+
+		Paths []MultipathPath
+	}
+
+	// MultipathPath is a scsi device used as a path of a multipath map.
+	MultipathPath struct {
+		// Dev is the /dev/sd<x> path of the scsi device.
+		Dev string
+
+		// State is the scsi device state: running, offline, blocked,
+		// transport-offline, ...
+		State string
+	}
+)
+
+var (
+	// SysBlockDir is the sysfs directory of the block devices. It can be
+	// replaced for tests.
+	SysBlockDir = "/sys/block"
+)
+
+func readSysfsString(p string) string {
+	b, err := os.ReadFile(p)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(b))
+}
+
+// IsRunning returns true if the scsi device of the path accepts io.
+func (t MultipathPath) IsRunning() bool {
+	return t.State == "running"
+}
+
+// RunningPaths returns the number of paths of the map accepting io.
+func (t MultipathDevice) RunningPaths() int {
+	n := 0
+	for _, p := range t.Paths {
+		if p.IsRunning() {
+			n++
+		}
+	}
+	return n
+}
+
+// ListMultipathDevices returns the device-mapper multipath maps with the
+// state of their paths.
+func ListMultipathDevices() ([]MultipathDevice, error) {
+	l := make([]MultipathDevice, 0)
+	dirs, err := filepath.Glob(filepath.Join(SysBlockDir, "dm-*"))
+	if err != nil {
+		return l, err
+	}
+	for _, dir := range dirs {
+		uuid := readSysfsString(filepath.Join(dir, "dm", "uuid"))
+		if !strings.HasPrefix(uuid, "mpath-") {
+			continue
+		}
+		dev := MultipathDevice{
+			Name: readSysfsString(filepath.Join(dir, "dm", "name")),
+			Dev:  "/dev/" + filepath.Base(dir),
+			WWID: strings.TrimPrefix(uuid, "mpath-"),
+		}
+		slaves, err := filepath.Glob(filepath.Join(dir, "slaves", "*"))
+		if err != nil {
+			return l, err
+		}
+		for _, slave := range slaves {
+			name := filepath.Base(slave)
+			dev.Paths = append(dev.Paths, MultipathPath{
+				Dev:   "/dev/" + name,
+				State: readSysfsString(filepath.Join(SysBlockDir, name, "device", "state")),
+			})
+		}
+		l = append(l, dev)
+	}
+	sort.Slice(l, func(i, j int) bool { return l[i].Name < l[j].Name })
+	return l, nil
+}
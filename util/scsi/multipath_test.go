@@ -0,0 +1,39 @@
+package scsi
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListMultipathDevices(t *testing.T) {
+	ori := SysBlockDir
+	defer func() { SysBlockDir = ori }()
+	SysBlockDir = t.TempDir()
+
+	writeFile := func(p, s string) {
+		p = filepath.Join(SysBlockDir, p)
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0755))
+		require.NoError(t, os.WriteFile(p, []byte(s+"\n"), 0644))
+	}
+	writeFile("dm-0/dm/uuid", "LVM-abcdef")
+	writeFile("dm-0/dm/name", "vg1-lv1")
+	writeFile("dm-1/dm/uuid", "mpath-3600a098038303053453f463045727a36")
+	writeFile("dm-1/dm/name", "mpatha")
+	writeFile("dm-1/slaves/sdb", "")
+	writeFile("dm-1/slaves/sdc", "")
+	writeFile("sdb/device/state", "running")
+	writeFile("sdc/device/state", "offline")
+
+	l, err := ListMultipathDevices()
+	require.NoError(t, err)
+	require.Len(t, l, 1)
+	assert.Equal(t, "mpatha", l[0].Name)
+	assert.Equal(t, "/dev/dm-1", l[0].Dev)
+	assert.Equal(t, "3600a098038303053453f463045727a36", l[0].WWID)
+	assert.Equal(t, []MultipathPath{{Dev: "/dev/sdb", State: "running"}, {Dev: "/dev/sdc", State: "offline"}}, l[0].Paths)
+	assert.Equal(t, 1, l[0].RunningPaths())
+}
@@ -6,6 +6,7 @@ import (
 	"bytes"
 	"crypto/md5"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
 	"io/fs"
@@ -41,6 +42,8 @@ type (
 		configReader    io.Reader
 		collectorClient *collector.Client
 		force           bool
+		snapshotKeep    int
+		snapshotMaxAge  time.Duration
 
 		// variable
 		changed      map[string]interface{}
@@ -74,6 +77,7 @@ func New() *T {
 			filepath.Join(rawconfig.Paths.Etc, "namespaces", "*", "*", "*.conf"),
 			filepath.Join(rawconfig.Paths.Etc, "sysreport.conf.d"),
 		},
+		snapshotKeep: DefaultSnapshotKeep,
 	}
 	return t
 }
@@ -90,10 +94,20 @@ func (t *T) SetConfigDir(path string) {
 	t.etcDir = path
 }
 
+// SetCollectorClient sets the client used to send the changes to the
+// collector. Without collector client, the sysreport is only stored as a
+// local snapshot.
 func (t *T) SetCollectorClient(c *collector.Client) {
 	t.collectorClient = c
 }
 
+// SetSnapshotRetention sets the maximum number and age of the local
+// snapshots.
+func (t *T) SetSnapshotRetention(keep int, maxAge time.Duration) {
+	t.snapshotKeep = keep
+	t.snapshotMaxAge = maxAge
+}
+
 func (t T) sysreportDir() string {
 	return filepath.Join(t.varDir, "sysreport")
 }
@@ -697,12 +711,59 @@ func (t *T) Do() error {
 	if err := t.updateStatsStat(); err != nil {
 		return err
 	}
+	if err := t.snapshot(); err != nil {
+		return err
+	}
+	if t.collectorClient == nil {
+		return nil
+	}
 	if err := t.send(); err != nil {
 		return err
 	}
 	return nil
 }
 
+// snapshot stores the collected files and command outputs as a local
+// snapshot.
+func (t *T) snapshot() error {
+	files := make(map[string][]byte)
+	for _, path := range sortedKeys(t.expanded) {
+		b, err := os.ReadFile(filepath.Join(t.collectFileDir(), path))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		files[path] = b
+	}
+	commands := make(map[string][]byte)
+	for _, command := range t.commands {
+		argv, err := shlex.Split(command, true)
+		if err != nil {
+			continue
+		}
+		b, err := os.ReadFile(stupidCommandToPath(t.collectCmdDir(), argv))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		commands[command] = b
+	}
+	store := NewSnapshotStore(SnapshotDir())
+	store.SetRetention(t.snapshotKeep, t.snapshotMaxAge)
+	snap, added, err := store.Add(time.Now(), files, commands)
+	if err != nil {
+		return fmt.Errorf("sysreport snapshot: %w", err)
+	}
+	if added {
+		srLog.Info().Str("id", snap.ID).Int("files", len(files)).Int("commands", len(commands)).Msg("Snapshot stored")
+	} else {
+		srLog.Info().Str("id", snap.ID).Msg("No change since the latest snapshot")
+	}
+	return nil
+}
+
 func (t *T) collectFiles() error {
 	for _, path := range sortedKeys(t.expanded) {
 		if err := t.collectFile(path); err != nil {
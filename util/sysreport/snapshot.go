@@ -0,0 +1,484 @@
+package sysreport
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hexops/gotextdiff"
+	"github.com/hexops/gotextdiff/myers"
+	"github.com/hexops/gotextdiff/span"
+
+	"github.com/opensvc/om3/core/rawconfig"
+	"github.com/opensvc/om3/util/converters"
+)
+
+type (
+	// Snapshot describes the files and command outputs collected by a
+	// sysreport run. The contents are stored once, as compressed blobs
+	// named after their sha256 sum, and shared by the snapshots.
+	Snapshot struct {
+		ID        string    `json:"id"`
+		CreatedAt time.Time `json:"created_at"`
+
+		// Checksum is the sum of the snapshot content. Two snapshots with
+		// the same files and command outputs have the same checksum.
+		Checksum string `json:"csum"`
+
+		// Files maps the collected file paths to their blob sum.
+		Files map[string]string `json:"files"`
+
+		// Commands maps the collected commands to their output blob sum.
+		Commands map[string]string `json:"commands"`
+	}
+
+	Snapshots []Snapshot
+
+	// Change is a file or command output difference between two
+	// snapshots.
+	Change struct {
+		From   string `json:"from"`
+		To     string `json:"to"`
+		Type   string `json:"type"`
+		Name   string `json:"name"`
+		Action string `json:"action"`
+		Diff   string `json:"diff"`
+	}
+
+	Changes []Change
+
+	// SnapshotStore is the local sysreport snapshots repository.
+	SnapshotStore struct {
+		dir    string
+		keep   int
+		maxAge time.Duration
+	}
+)
+
+const (
+	ChangeAdded   = "added"
+	ChangeChanged = "changed"
+	ChangeRemoved = "removed"
+
+	ChangeTypeFile    = "file"
+	ChangeTypeCommand = "command"
+
+	// DefaultSnapshotKeep is the number of snapshots kept by a store
+	// with no retention set.
+	DefaultSnapshotKeep = 100
+
+	snapshotIDLength = 12
+)
+
+var (
+	ErrSnapshotNotFound  = errors.New("sysreport snapshot not found")
+	ErrSnapshotAmbiguous = errors.New("ambiguous sysreport snapshot id")
+)
+
+// SnapshotDir returns the directory hosting the local sysreport snapshots.
+func SnapshotDir() string {
+	return filepath.Join(rawconfig.Paths.Var, "sysreport", "snapshot")
+}
+
+// NewSnapshotStore returns the snapshot store hosted in dir.
+func NewSnapshotStore(dir string) *SnapshotStore {
+	return &SnapshotStore{
+		dir:  dir,
+		keep: DefaultSnapshotKeep,
+	}
+}
+
+// SetRetention sets the maximum number and age of the snapshots kept when
+// a new snapshot is added. A zero value disables the limit. The latest
+// snapshot is always kept.
+func (t *SnapshotStore) SetRetention(keep int, maxAge time.Duration) {
+	t.keep = keep
+	t.maxAge = maxAge
+}
+
+// Add stores a new snapshot of the files and commands outputs. If the
+// content is the same as the latest snapshot, no snapshot is added and
+// the latest snapshot is returned with added false.
+func (t *SnapshotStore) Add(createdAt time.Time, files, commands map[string][]byte) (snap Snapshot, added bool, err error) {
+	snap = Snapshot{
+		CreatedAt: createdAt,
+		Files:     make(map[string]string),
+		Commands:  make(map[string]string),
+	}
+	for name, b := range files {
+		if snap.Files[name], err = t.addBlob(b); err != nil {
+			return
+		}
+	}
+	for name, b := range commands {
+		if snap.Commands[name], err = t.addBlob(b); err != nil {
+			return
+		}
+	}
+	snap.Checksum = snap.sum()
+	l, err := t.List()
+	if err != nil {
+		return
+	}
+	if n := len(l); n > 0 && l[n-1].Checksum == snap.Checksum {
+		return l[n-1], false, nil
+	}
+	h := sha256.New()
+	_, _ = fmt.Fprintf(h, "%d\n%s", createdAt.UnixNano(), snap.Checksum)
+	snap.ID = hex.EncodeToString(h.Sum(nil))[:snapshotIDLength]
+	b, err := json.Marshal(snap)
+	if err != nil {
+		return
+	}
+	if err = writeCompressed(t.manifestFile(snap.ID), b); err != nil {
+		return
+	}
+	return snap, true, t.prune()
+}
+
+// List returns the snapshots, the oldest first.
+func (t *SnapshotStore) List() (Snapshots, error) {
+	l := make(Snapshots, 0)
+	matches, err := filepath.Glob(filepath.Join(t.dir, "*.json.gz"))
+	if err != nil {
+		return l, err
+	}
+	for _, match := range matches {
+		id := strings.TrimSuffix(filepath.Base(match), ".json.gz")
+		if snap, err := t.load(id); err != nil {
+			continue
+		} else {
+			l = append(l, snap)
+		}
+	}
+	sort.Slice(l, func(i, j int) bool {
+		if l[i].CreatedAt.Equal(l[j].CreatedAt) {
+			return l[i].ID < l[j].ID
+		}
+		return l[i].CreatedAt.Before(l[j].CreatedAt)
+	})
+	return l, nil
+}
+
+// Get returns the snapshot identified by id. A unique id prefix is
+// accepted.
+func (t *SnapshotStore) Get(id string) (Snapshot, error) {
+	if !isSnapshotIDPrefix(id) {
+		return Snapshot{}, fmt.Errorf("%w: %s", ErrSnapshotNotFound, id)
+	}
+	if snap, err := t.load(id); err == nil {
+		return snap, nil
+	}
+	l, err := t.List()
+	if err != nil {
+		return Snapshot{}, err
+	}
+	var found Snapshots
+	for _, snap := range l {
+		if strings.HasPrefix(snap.ID, id) {
+			found = append(found, snap)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return Snapshot{}, fmt.Errorf("%w: %s", ErrSnapshotNotFound, id)
+	case 1:
+		return found[0], nil
+	default:
+		return Snapshot{}, fmt.Errorf("%w: %s", ErrSnapshotAmbiguous, id)
+	}
+}
+
+// Range returns the older and newer snapshots to compare.
+//
+// With rev set to <id>..<id>, the two designated snapshots are returned.
+// With rev set to <id>, the designated and the latest snapshots are
+// returned. With since set, the last snapshot taken before since and the
+// latest snapshot are returned. Otherwise the two latest snapshots are
+// returned.
+//
+// The older snapshot is empty if no snapshot precedes the newer one.
+func (t *SnapshotStore) Range(rev string, since time.Time) (Snapshot, Snapshot, error) {
+	var a, b Snapshot
+	l, err := t.List()
+	if err != nil {
+		return a, b, err
+	}
+	if len(l) == 0 {
+		return a, b, ErrSnapshotNotFound
+	}
+	latest := l[len(l)-1]
+	switch {
+	case rev != "":
+		revA, revB, _ := strings.Cut(rev, "..")
+		if a, err = t.Get(revA); err != nil {
+			return a, b, err
+		}
+		if revB == "" {
+			return a, latest, nil
+		}
+		b, err = t.Get(revB)
+		return a, b, err
+	case !since.IsZero():
+		for _, snap := range l {
+			if snap.CreatedAt.After(since) {
+				break
+			}
+			a = snap
+		}
+		return a, latest, nil
+	case len(l) == 1:
+		return a, latest, nil
+	default:
+		return l[len(l)-2], latest, nil
+	}
+}
+
+// Diff returns the file and command output changes from the a snapshot to
+// the b snapshot.
+func (t *SnapshotStore) Diff(a, b Snapshot) (Changes, error) {
+	changes := make(Changes, 0)
+	for _, typ := range []string{ChangeTypeFile, ChangeTypeCommand} {
+		sumsA, sumsB := a.Files, b.Files
+		if typ == ChangeTypeCommand {
+			sumsA, sumsB = a.Commands, b.Commands
+		}
+		names := make(map[string]any)
+		for name := range sumsA {
+			names[name] = nil
+		}
+		for name := range sumsB {
+			names[name] = nil
+		}
+		for _, name := range sortedKeys(names) {
+			sumA, okA := sumsA[name]
+			sumB, okB := sumsB[name]
+			change := Change{
+				From: a.ID,
+				To:   b.ID,
+				Type: typ,
+				Name: name,
+			}
+			switch {
+			case okA && okB && sumA == sumB:
+				continue
+			case !okA:
+				change.Action = ChangeAdded
+			case !okB:
+				change.Action = ChangeRemoved
+			default:
+				change.Action = ChangeChanged
+			}
+			dataA, err := t.readBlob(sumA)
+			if err != nil {
+				return changes, err
+			}
+			dataB, err := t.readBlob(sumB)
+			if err != nil {
+				return changes, err
+			}
+			nameA, nameB := name, name
+			if a.ID != "" {
+				nameA += "@" + a.ID
+			}
+			if b.ID != "" {
+				nameB += "@" + b.ID
+			}
+			edits := myers.ComputeEdits(span.URIFromPath(nameA), string(dataA), string(dataB))
+			change.Diff = fmt.Sprint(gotextdiff.ToUnified(nameA, nameB, string(dataA), edits))
+			changes = append(changes, change)
+		}
+	}
+	return changes, nil
+}
+
+// sum returns the checksum of the snapshot content, independent of the
+// snapshot creation time.
+func (t Snapshot) sum() string {
+	h := sha256.New()
+	for _, name := range sortedKeys(t.Files) {
+		_, _ = fmt.Fprintf(h, "file\x00%s\x00%s\n", name, t.Files[name])
+	}
+	for _, name := range sortedKeys(t.Commands) {
+		_, _ = fmt.Fprintf(h, "command\x00%s\x00%s\n", name, t.Commands[name])
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// isSnapshotIDPrefix returns true if s can be a snapshot id prefix. It
+// prevents the use of user submitted ids as paths outside of the store
+// directory.
+func isSnapshotIDPrefix(s string) bool {
+	if s == "" || len(s) > snapshotIDLength {
+		return false
+	}
+	_, err := hex.DecodeString(s + strings.Repeat("0", len(s)%2))
+	return err == nil
+}
+
+// prune removes the snapshots exceeding the retention, then the blobs no
+// longer referenced by a snapshot.
+func (t *SnapshotStore) prune() error {
+	l, err := t.List()
+	if err != nil {
+		return err
+	}
+	var errs error
+	kept := make(Snapshots, 0, len(l))
+	now := time.Now()
+	for i, snap := range l {
+		isLatest := i == len(l)-1
+		tooMany := t.keep > 0 && len(l)-i > t.keep
+		tooOld := t.maxAge > 0 && now.Sub(snap.CreatedAt) > t.maxAge
+		if isLatest || !(tooMany || tooOld) {
+			kept = append(kept, snap)
+			continue
+		}
+		if err := os.Remove(t.manifestFile(snap.ID)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			errs = errors.Join(errs, err)
+		}
+	}
+	if len(kept) == len(l) {
+		return errs
+	}
+	referenced := make(map[string]any)
+	for _, snap := range kept {
+		for _, sum := range snap.Files {
+			referenced[sum] = nil
+		}
+		for _, sum := range snap.Commands {
+			referenced[sum] = nil
+		}
+	}
+	err = filepath.WalkDir(filepath.Join(t.dir, "blob"), func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		sum := strings.TrimSuffix(d.Name(), ".gz")
+		if _, ok := referenced[sum]; ok {
+			return nil
+		}
+		return os.Remove(path)
+	})
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		errs = errors.Join(errs, err)
+	}
+	return errs
+}
+
+func (t *SnapshotStore) load(id string) (Snapshot, error) {
+	var snap Snapshot
+	b, err := readCompressed(t.manifestFile(id))
+	if err != nil {
+		return snap, err
+	}
+	if err := json.Unmarshal(b, &snap); err != nil {
+		return snap, fmt.Errorf("%s: %w", t.manifestFile(id), err)
+	}
+	return snap, nil
+}
+
+// addBlob stores the b content if not already stored, and returns its
+// sum.
+func (t *SnapshotStore) addBlob(b []byte) (string, error) {
+	csum := sha256.Sum256(b)
+	sum := hex.EncodeToString(csum[:])
+	filename := t.blobFile(sum)
+	if _, err := os.Stat(filename); err == nil {
+		return sum, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(filename), 0700); err != nil {
+		return sum, err
+	}
+	return sum, writeCompressed(filename, b)
+}
+
+// readBlob returns the content stored with the sum. An empty sum
+// designates an empty content.
+func (t *SnapshotStore) readBlob(sum string) ([]byte, error) {
+	if sum == "" {
+		return nil, nil
+	}
+	return readCompressed(t.blobFile(sum))
+}
+
+func (t *SnapshotStore) blobFile(sum string) string {
+	return filepath.Join(t.dir, "blob", sum[:2], sum+".gz")
+}
+
+func (t *SnapshotStore) manifestFile(id string) string {
+	return filepath.Join(t.dir, id+".json.gz")
+}
+
+func readCompressed(filename string) ([]byte, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	r, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filename, err)
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+// writeCompressed atomically installs the gzip compressed b content as the
+// filename file, so concurrent readers never see a partial file.
+func writeCompressed(filename string, b []byte) error {
+	var buff bytes.Buffer
+	w := gzip.NewWriter(&buff)
+	if _, err := w.Write(b); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(filename), 0700); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(filepath.Dir(filename), "."+filepath.Base(filename)+".*")
+	if err != nil {
+		return err
+	}
+	tmpFilename := f.Name()
+	defer os.Remove(tmpFilename)
+	if _, err := f.Write(buff.Bytes()); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmpFilename, filename)
+}
+
+// ParseSince returns the time designated by s, either a date like
+// "2006-01-02", "2006-01-02 15:04:05" or RFC3339, or a duration like "2d"
+// before now.
+func ParseSince(s string) (time.Time, error) {
+	for _, layout := range []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02"} {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	if d, err := converters.ParseDuration(s); err == nil {
+		return time.Now().Add(-d), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid date '%s': expected a date like 2006-01-02, 2006-01-02 15:04:05, RFC3339, or a duration like 2d", s)
+}
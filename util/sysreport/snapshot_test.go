@@ -0,0 +1,112 @@
+package sysreport
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotStore(t *testing.T) {
+	store := NewSnapshotStore(t.TempDir())
+	t0 := time.Now().Add(-time.Hour)
+
+	snap1, added, err := store.Add(t0, map[string][]byte{
+		"/etc/hosts":  []byte("127.0.0.1 localhost\n"),
+		"/etc/fstab":  []byte("/dev/sda1 / ext4\n"),
+		"/etc/passwd": []byte("root:x:0:0::/root:/bin/sh\n"),
+	}, map[string][]byte{
+		"uname -r": []byte("6.1.0\n"),
+	})
+	require.NoError(t, err)
+	require.True(t, added)
+
+	_, added, err = store.Add(t0.Add(time.Minute), map[string][]byte{
+		"/etc/hosts":  []byte("127.0.0.1 localhost\n"),
+		"/etc/fstab":  []byte("/dev/sda1 / ext4\n"),
+		"/etc/passwd": []byte("root:x:0:0::/root:/bin/sh\n"),
+	}, map[string][]byte{
+		"uname -r": []byte("6.1.0\n"),
+	})
+	require.NoError(t, err)
+	assert.False(t, added, "same content as the latest snapshot")
+
+	snap2, added, err := store.Add(t0.Add(2*time.Minute), map[string][]byte{
+		"/etc/hosts":  []byte("127.0.0.1 localhost\n10.0.0.1 node1\n"),
+		"/etc/passwd": []byte("root:x:0:0::/root:/bin/sh\n"),
+		"/etc/motd":   []byte("hello\n"),
+	}, map[string][]byte{
+		"uname -r": []byte("6.1.1\n"),
+	})
+	require.NoError(t, err)
+	require.True(t, added)
+
+	l, err := store.List()
+	require.NoError(t, err)
+	require.Len(t, l, 2)
+	assert.Equal(t, snap1.ID, l[0].ID)
+	assert.Equal(t, snap2.ID, l[1].ID)
+
+	t.Run("range", func(t *testing.T) {
+		a, b, err := store.Range("", time.Time{})
+		require.NoError(t, err)
+		assert.Equal(t, snap1.ID, a.ID)
+		assert.Equal(t, snap2.ID, b.ID)
+
+		a, b, err = store.Range(snap1.ID[:6], time.Time{})
+		require.NoError(t, err)
+		assert.Equal(t, snap1.ID, a.ID)
+		assert.Equal(t, snap2.ID, b.ID)
+
+		a, b, err = store.Range(snap2.ID+".."+snap1.ID, time.Time{})
+		require.NoError(t, err)
+		assert.Equal(t, snap2.ID, a.ID)
+		assert.Equal(t, snap1.ID, b.ID)
+
+		a, b, err = store.Range("", t0.Add(-time.Minute))
+		require.NoError(t, err)
+		assert.Equal(t, "", a.ID, "no snapshot before since")
+		assert.Equal(t, snap2.ID, b.ID)
+
+		_, _, err = store.Range("../etc", time.Time{})
+		assert.ErrorIs(t, err, ErrSnapshotNotFound)
+	})
+
+	t.Run("diff", func(t *testing.T) {
+		changes, err := store.Diff(snap1, snap2)
+		require.NoError(t, err)
+		actions := make(map[string]string)
+		for _, change := range changes {
+			actions[change.Type+":"+change.Name] = change.Action
+		}
+		assert.Equal(t, map[string]string{
+			"file:/etc/fstab":  ChangeRemoved,
+			"file:/etc/hosts":  ChangeChanged,
+			"file:/etc/motd":   ChangeAdded,
+			"command:uname -r": ChangeChanged,
+		}, actions)
+		for _, change := range changes {
+			if change.Name == "/etc/hosts" {
+				assert.Contains(t, change.Diff, "+10.0.0.1 node1")
+			}
+		}
+	})
+
+	t.Run("retention", func(t *testing.T) {
+		store.SetRetention(1, 0)
+		snap3, added, err := store.Add(t0.Add(3*time.Minute), map[string][]byte{
+			"/etc/hosts": []byte("127.0.0.1 localhost\n"),
+		}, nil)
+		require.NoError(t, err)
+		require.True(t, added)
+		l, err := store.List()
+		require.NoError(t, err)
+		require.Len(t, l, 1)
+		assert.Equal(t, snap3.ID, l[0].ID)
+		_, err = store.readBlob(snap2.Files["/etc/motd"])
+		assert.Error(t, err, "unreferenced blob is removed")
+		_, err = store.readBlob(snap3.Files["/etc/hosts"])
+		assert.NoError(t, err)
+	})
+}
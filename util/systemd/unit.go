@@ -0,0 +1,142 @@
+package systemd
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/opensvc/om3/util/command"
+)
+
+type (
+	// UnitState is the subset of the systemd unit properties describing
+	// the unit load, activation and enablement states.
+	UnitState struct {
+		LoadState     string
+		ActiveState   string
+		UnitFileState string
+	}
+)
+
+var (
+	// SystemUnitDir is the directory of the local system units
+	// configuration, hosting the units drop-in directories.
+	SystemUnitDir = "/etc/systemd/system"
+
+	// Systemctl runs systemctl with args and returns its stdout. It can be
+	// replaced for tests.
+	Systemctl = func(args ...string) ([]byte, error) {
+		cmd := command.New(
+			command.WithName("systemctl"),
+			command.WithArgs(args),
+			command.WithBufferedStdout(),
+		)
+		return cmd.Output()
+	}
+)
+
+// GetUnitState returns the load, active and unit file states of the unit.
+func GetUnitState(unit string) (UnitState, error) {
+	var state UnitState
+	b, err := Systemctl("show", "--property=LoadState,ActiveState,UnitFileState", unit)
+	if err != nil {
+		return state, fmt.Errorf("systemctl show %s: %w", unit, err)
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for scanner.Scan() {
+		k, v, ok := strings.Cut(scanner.Text(), "=")
+		if !ok {
+			continue
+		}
+		switch k {
+		case "LoadState":
+			state.LoadState = v
+		case "ActiveState":
+			state.ActiveState = v
+		case "UnitFileState":
+			state.UnitFileState = v
+		}
+	}
+	return state, scanner.Err()
+}
+
+// IsActive returns true if the unit is active or activating.
+func (t UnitState) IsActive() bool {
+	switch t.ActiveState {
+	case "active", "activating", "reloading":
+		return true
+	default:
+		return false
+	}
+}
+
+// IsEnabled returns true if the unit is enabled, statically or not.
+func (t UnitState) IsEnabled() bool {
+	switch t.UnitFileState {
+	case "enabled", "enabled-runtime", "static", "alias", "indirect", "generated":
+		return true
+	default:
+		return false
+	}
+}
+
+// IsMasked returns true if the unit is masked.
+func (t UnitState) IsMasked() bool {
+	return t.UnitFileState == "masked" || t.UnitFileState == "masked-runtime" || t.LoadState == "masked"
+}
+
+func unitCommand(action, unit string) error {
+	if _, err := Systemctl(action, unit); err != nil {
+		return fmt.Errorf("systemctl %s %s: %w", action, unit, err)
+	}
+	return nil
+}
+
+// Enable enables the unit.
+func Enable(unit string) error {
+	return unitCommand("enable", unit)
+}
+
+// Disable disables the unit.
+func Disable(unit string) error {
+	return unitCommand("disable", unit)
+}
+
+// Mask masks the unit.
+func Mask(unit string) error {
+	return unitCommand("mask", unit)
+}
+
+// Unmask unmasks the unit.
+func Unmask(unit string) error {
+	return unitCommand("unmask", unit)
+}
+
+// Start starts the unit.
+func Start(unit string) error {
+	return unitCommand("start", unit)
+}
+
+// Stop stops the unit.
+func Stop(unit string) error {
+	return unitCommand("stop", unit)
+}
+
+// DaemonReload reloads the systemd manager configuration, so the drop-in
+// files changes apply.
+func DaemonReload() error {
+	if _, err := Systemctl("daemon-reload"); err != nil {
+		return fmt.Errorf("systemctl daemon-reload: %w", err)
+	}
+	return nil
+}
+
+// DropInFile returns the path of the drop-in file name of the unit.
+func DropInFile(unit, name string) string {
+	if !strings.HasSuffix(name, ".conf") {
+		name += ".conf"
+	}
+	return filepath.Join(SystemUnitDir, unit+".d", name)
+}
@@ -0,0 +1,30 @@
+package systemd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetUnitState(t *testing.T) {
+	ori := Systemctl
+	defer func() { Systemctl = ori }()
+	var called []string
+	Systemctl = func(args ...string) ([]byte, error) {
+		called = args
+		return []byte("LoadState=loaded\nActiveState=active\nUnitFileState=masked-runtime\n"), nil
+	}
+	state, err := GetUnitState("sshd.service")
+	require.NoError(t, err)
+	assert.Equal(t, "sshd.service", called[len(called)-1])
+	assert.Equal(t, UnitState{LoadState: "loaded", ActiveState: "active", UnitFileState: "masked-runtime"}, state)
+	assert.True(t, state.IsActive())
+	assert.True(t, state.IsMasked())
+	assert.False(t, state.IsEnabled())
+}
+
+func TestDropInFile(t *testing.T) {
+	assert.Equal(t, "/etc/systemd/system/sshd.service.d/hardening.conf", DropInFile("sshd.service", "hardening"))
+	assert.Equal(t, "/etc/systemd/system/sshd.service.d/hardening.conf", DropInFile("sshd.service", "hardening.conf"))
+}
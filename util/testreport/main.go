@@ -0,0 +1,241 @@
+// Package testreport renders the compliance runs and the checks results
+// in the machine-readable report formats ingested by the CI pipelines:
+// JUnit XML and SARIF.
+//
+// Each compliance module or check instance maps to a test case, or a
+// SARIF rule and result.
+package testreport
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"time"
+)
+
+type (
+	// State is the outcome of a test case.
+	State string
+
+	// Case is a compliance module or a check instance.
+	Case struct {
+		Name      string
+		ClassName string
+		State     State
+
+		// Message is the short explanation of a fail or n/a state.
+		Message string
+
+		// Hint is the suggested action to fix a failed case.
+		Hint string
+
+		// Output is the log of the case.
+		Output   string
+		Duration time.Duration
+	}
+
+	// Suite is the set of cases of a compliance run or a checks run on
+	// a node.
+	Suite struct {
+		Name      string
+		Hostname  string
+		Timestamp time.Time
+		Duration  time.Duration
+		Cases     []Case
+	}
+
+	// Suiter is the interface implemented by the types that can be
+	// rendered as test suites.
+	Suiter interface {
+		TestSuites() []Suite
+	}
+)
+
+const (
+	StatePass State = "pass"
+	StateFail State = "fail"
+	StateNA   State = "n/a"
+
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+)
+
+type (
+	junitSuites struct {
+		XMLName  xml.Name     `xml:"testsuites"`
+		Tests    int          `xml:"tests,attr"`
+		Failures int          `xml:"failures,attr"`
+		Skipped  int          `xml:"skipped,attr"`
+		Suites   []junitSuite `xml:"testsuite"`
+	}
+	junitSuite struct {
+		Name      string      `xml:"name,attr"`
+		Hostname  string      `xml:"hostname,attr,omitempty"`
+		Timestamp string      `xml:"timestamp,attr,omitempty"`
+		Time      string      `xml:"time,attr"`
+		Tests     int         `xml:"tests,attr"`
+		Failures  int         `xml:"failures,attr"`
+		Skipped   int         `xml:"skipped,attr"`
+		Cases     []junitCase `xml:"testcase"`
+	}
+	junitCase struct {
+		Name      string        `xml:"name,attr"`
+		ClassName string        `xml:"classname,attr"`
+		Time      string        `xml:"time,attr"`
+		Failure   *junitMessage `xml:"failure,omitempty"`
+		Skipped   *junitMessage `xml:"skipped,omitempty"`
+		SystemOut string        `xml:"system-out,omitempty"`
+	}
+	junitMessage struct {
+		Message string `xml:"message,attr,omitempty"`
+		Text    string `xml:",chardata"`
+	}
+)
+
+func seconds(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
+
+// JUnit returns the JUnit XML report of the suites. The fix hint of a
+// failed case is the text of its failure element.
+func JUnit(suites []Suite) ([]byte, error) {
+	doc := junitSuites{Suites: make([]junitSuite, 0, len(suites))}
+	for _, suite := range suites {
+		js := junitSuite{
+			Name:     suite.Name,
+			Hostname: suite.Hostname,
+			Time:     seconds(suite.Duration),
+			Tests:    len(suite.Cases),
+			Cases:    make([]junitCase, 0, len(suite.Cases)),
+		}
+		if !suite.Timestamp.IsZero() {
+			js.Timestamp = suite.Timestamp.UTC().Format("2006-01-02T15:04:05")
+		}
+		for _, c := range suite.Cases {
+			jc := junitCase{
+				Name:      c.Name,
+				ClassName: c.ClassName,
+				Time:      seconds(c.Duration),
+				SystemOut: c.Output,
+			}
+			switch c.State {
+			case StateFail:
+				jc.Failure = &junitMessage{Message: c.Message, Text: c.Hint}
+				js.Failures++
+			case StateNA:
+				jc.Skipped = &junitMessage{Message: c.Message}
+				js.Skipped++
+			}
+			js.Cases = append(js.Cases, jc)
+		}
+		doc.Tests += js.Tests
+		doc.Failures += js.Failures
+		doc.Skipped += js.Skipped
+		doc.Suites = append(doc.Suites, js)
+	}
+	b, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), append(b, '\n')...), nil
+}
+
+type (
+	sarifLog struct {
+		Version string     `json:"version"`
+		Schema  string     `json:"$schema"`
+		Runs    []sarifRun `json:"runs"`
+	}
+	sarifRun struct {
+		Tool    sarifTool     `json:"tool"`
+		Results []sarifResult `json:"results"`
+	}
+	sarifTool struct {
+		Driver sarifDriver `json:"driver"`
+	}
+	sarifDriver struct {
+		Name  string      `json:"name"`
+		Rules []sarifRule `json:"rules"`
+	}
+	sarifRule struct {
+		ID               string        `json:"id"`
+		ShortDescription sarifText     `json:"shortDescription"`
+		Help             *sarifText    `json:"help,omitempty"`
+		Properties       *sarifRuleTag `json:"properties,omitempty"`
+	}
+	sarifRuleTag struct {
+		Tags []string `json:"tags"`
+	}
+	sarifText struct {
+		Text string `json:"text"`
+	}
+	sarifResult struct {
+		RuleID     string         `json:"ruleId"`
+		Kind       string         `json:"kind"`
+		Level      string         `json:"level"`
+		Message    sarifText      `json:"message"`
+		Properties map[string]any `json:"properties,omitempty"`
+	}
+)
+
+// SARIF returns the SARIF report of the suites, with a run per suite, a
+// rule per case class and name, and a result per case. The fix hint of a
+// case is the help text of its rule.
+func SARIF(suites []Suite) ([]byte, error) {
+	doc := sarifLog{
+		Version: sarifVersion,
+		Schema:  sarifSchema,
+		Runs:    make([]sarifRun, 0, len(suites)),
+	}
+	for _, suite := range suites {
+		run := sarifRun{
+			Tool: sarifTool{Driver: sarifDriver{
+				Name:  suite.Name,
+				Rules: make([]sarifRule, 0, len(suite.Cases)),
+			}},
+			Results: make([]sarifResult, 0, len(suite.Cases)),
+		}
+		for _, c := range suite.Cases {
+			id := c.Name
+			if c.ClassName != "" {
+				id = c.ClassName + "/" + c.Name
+			}
+			rule := sarifRule{ID: id, ShortDescription: sarifText{Text: c.Name}}
+			if c.Hint != "" {
+				rule.Help = &sarifText{Text: c.Hint}
+			}
+			if c.ClassName != "" {
+				rule.Properties = &sarifRuleTag{Tags: []string{c.ClassName}}
+			}
+			run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, rule)
+			result := sarifResult{
+				RuleID:  id,
+				Message: sarifText{Text: c.Message},
+			}
+			switch c.State {
+			case StateFail:
+				result.Kind = "fail"
+				result.Level = "error"
+			case StateNA:
+				result.Kind = "notApplicable"
+				result.Level = "none"
+			default:
+				result.Kind = "pass"
+				result.Level = "none"
+			}
+			if result.Message.Text == "" {
+				result.Message.Text = string(c.State)
+			}
+			if suite.Hostname != "" {
+				result.Properties = map[string]any{"hostname": suite.Hostname}
+			}
+			run.Results = append(run.Results, result)
+		}
+		doc.Runs = append(doc.Runs, run)
+	}
+	b, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '\n'), nil
+}
@@ -0,0 +1,71 @@
+package testreport
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testSuites() []Suite {
+	return []Suite{{
+		Name:      "compliance",
+		Hostname:  "node1",
+		Timestamp: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		Duration:  1500 * time.Millisecond,
+		Cases: []Case{
+			{Name: "motd", ClassName: "compliance", State: StatePass, Duration: time.Second},
+			{Name: "sysctl", ClassName: "compliance", State: StateFail, Message: "check failed with exit code 1", Hint: "fix with 'om node compliance fix --module sysctl'", Output: "check:\nvm.swappiness is 60\n"},
+			{Name: "nfs", ClassName: "compliance", State: StateNA, Message: "check not applicable"},
+		},
+	}}
+}
+
+func TestJUnit(t *testing.T) {
+	b, err := JUnit(testSuites())
+	require.NoError(t, err)
+
+	var doc junitSuites
+	require.NoError(t, xml.Unmarshal(b, &doc))
+	assert.Equal(t, 3, doc.Tests)
+	assert.Equal(t, 1, doc.Failures)
+	assert.Equal(t, 1, doc.Skipped)
+	require.Len(t, doc.Suites, 1)
+	suite := doc.Suites[0]
+	assert.Equal(t, "node1", suite.Hostname)
+	assert.Equal(t, "2024-01-02T03:04:05", suite.Timestamp)
+	assert.Equal(t, "1.500", suite.Time)
+	require.Len(t, suite.Cases, 3)
+	assert.Nil(t, suite.Cases[0].Failure)
+	assert.Nil(t, suite.Cases[0].Skipped)
+	require.NotNil(t, suite.Cases[1].Failure)
+	assert.Equal(t, "check failed with exit code 1", suite.Cases[1].Failure.Message)
+	assert.Equal(t, "fix with 'om node compliance fix --module sysctl'", suite.Cases[1].Failure.Text)
+	assert.Contains(t, suite.Cases[1].SystemOut, "vm.swappiness")
+	require.NotNil(t, suite.Cases[2].Skipped)
+}
+
+func TestSARIF(t *testing.T) {
+	b, err := SARIF(testSuites())
+	require.NoError(t, err)
+
+	var doc sarifLog
+	require.NoError(t, json.Unmarshal(b, &doc))
+	assert.Equal(t, "2.1.0", doc.Version)
+	require.Len(t, doc.Runs, 1)
+	run := doc.Runs[0]
+	assert.Equal(t, "compliance", run.Tool.Driver.Name)
+	require.Len(t, run.Tool.Driver.Rules, 3)
+	require.NotNil(t, run.Tool.Driver.Rules[1].Help)
+	assert.Equal(t, "fix with 'om node compliance fix --module sysctl'", run.Tool.Driver.Rules[1].Help.Text)
+	require.Len(t, run.Results, 3)
+	assert.Equal(t, "compliance/motd", run.Results[0].RuleID)
+	assert.Equal(t, "pass", run.Results[0].Kind)
+	assert.Equal(t, "fail", run.Results[1].Kind)
+	assert.Equal(t, "error", run.Results[1].Level)
+	assert.Equal(t, "notApplicable", run.Results[2].Kind)
+	assert.Equal(t, "node1", run.Results[0].Properties["hostname"])
+}
@@ -0,0 +1,99 @@
+package totp
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+type (
+	// Guard validates the codes presented by accounts. A code is accepted
+	// only once: the codes of the period of the last accepted code, and of
+	// the previous periods, are refused. An account presenting MaxFailures
+	// wrong codes in a row is locked out, for a duration doubled on each
+	// new lockout up to MaxLockout.
+	//
+	// The Guard state is held in memory, so it applies to the codes
+	// presented to the same process.
+	Guard struct {
+		MaxFailures int
+		Lockout     time.Duration
+		MaxLockout  time.Duration
+
+		mu       sync.Mutex
+		accounts map[string]*guardAccount
+	}
+
+	guardAccount struct {
+		// last is the counter of the last accepted code.
+		last uint64
+
+		// failures is the number of wrong codes presented in a row.
+		failures int
+
+		// lockouts is the number of lockouts since the last accepted code.
+		lockouts int
+
+		lockedUntil time.Time
+	}
+)
+
+var (
+	ErrInvalid = errors.New("wrong totp code")
+	ErrReplay  = errors.New("totp code already used")
+	ErrLocked  = errors.New("too many wrong totp codes, retry later")
+)
+
+// NewGuard returns a Guard locking out an account for one minute after 5
+// wrong codes, up to one hour on repeated lockouts.
+func NewGuard() *Guard {
+	return &Guard{
+		MaxFailures: 5,
+		Lockout:     time.Minute,
+		MaxLockout:  time.Hour,
+		accounts:    make(map[string]*guardAccount),
+	}
+}
+
+// Validate returns nil if s is a valid code of the secret at the time tm,
+// not already accepted for the account, and if the account is not locked
+// out.
+func (g *Guard) Validate(account, secret, s string, tm time.Time) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	a, ok := g.accounts[account]
+	if !ok {
+		a = &guardAccount{}
+		g.accounts[account] = a
+	}
+	if tm.Before(a.lockedUntil) {
+		return ErrLocked
+	}
+	c, ok := match(secret, s, tm)
+	switch {
+	case !ok:
+		return g.fail(a, tm, ErrInvalid)
+	case a.last != 0 && c <= a.last:
+		return g.fail(a, tm, ErrReplay)
+	}
+	a.last = c
+	a.failures = 0
+	a.lockouts = 0
+	return nil
+}
+
+func (g *Guard) fail(a *guardAccount, tm time.Time, err error) error {
+	a.failures++
+	if a.failures < g.MaxFailures {
+		return err
+	}
+	d := g.Lockout << a.lockouts
+	if d <= 0 || d > g.MaxLockout {
+		d = g.MaxLockout
+	} else {
+		a.lockouts++
+	}
+	a.failures = 0
+	a.lockedUntil = tm.Add(d)
+	return ErrLocked
+}
@@ -0,0 +1,62 @@
+package totp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGuardReplay(t *testing.T) {
+	secret, err := NewSecret()
+	require.NoError(t, err)
+	g := NewGuard()
+	now := time.Now()
+	s, err := Code(secret, now)
+	require.NoError(t, err)
+	assert.NoError(t, g.Validate("alice", secret, s, now))
+	assert.ErrorIs(t, g.Validate("alice", secret, s, now), ErrReplay)
+	assert.ErrorIs(t, g.Validate("alice", secret, s, now.Add(Period)), ErrReplay, "replay in the next period")
+	assert.NoError(t, g.Validate("bob", secret, s, now), "accounts are independent")
+
+	previous, err := Code(secret, now.Add(-Period))
+	require.NoError(t, err)
+	assert.ErrorIs(t, g.Validate("alice", secret, previous, now), ErrReplay, "older than the last accepted code")
+
+	next, err := Code(secret, now.Add(Period))
+	require.NoError(t, err)
+	assert.NoError(t, g.Validate("alice", secret, next, now.Add(Period)))
+}
+
+func TestGuardLockout(t *testing.T) {
+	secret, err := NewSecret()
+	require.NoError(t, err)
+	g := NewGuard()
+	now := time.Now()
+	s, err := Code(secret, now)
+	require.NoError(t, err)
+	wrong := "000000"
+	if s == wrong {
+		wrong = "111111"
+	}
+	for i := 1; i < g.MaxFailures; i++ {
+		assert.ErrorIs(t, g.Validate("alice", secret, wrong, now), ErrInvalid)
+	}
+	assert.ErrorIs(t, g.Validate("alice", secret, wrong, now), ErrLocked)
+	assert.ErrorIs(t, g.Validate("alice", secret, s, now), ErrLocked, "valid code refused while locked")
+
+	// the second lockout lasts twice longer
+	now = now.Add(g.Lockout)
+	s, err = Code(secret, now)
+	require.NoError(t, err)
+	for i := 0; i < g.MaxFailures; i++ {
+		_ = g.Validate("alice", secret, wrong, now)
+	}
+	assert.ErrorIs(t, g.Validate("alice", secret, s, now.Add(g.Lockout)), ErrLocked)
+
+	now = now.Add(2 * g.Lockout)
+	s, err = Code(secret, now)
+	require.NoError(t, err)
+	assert.NoError(t, g.Validate("alice", secret, s, now))
+}
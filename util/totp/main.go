@@ -0,0 +1,133 @@
+// Package totp implements the time-based one-time passwords of RFC 6238,
+// with the parameters supported by the common authenticator applications:
+// HMAC-SHA1, 6 digits and a 30 seconds period.
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// Digits is the number of digits of the codes.
+	Digits = 6
+
+	// Period is the validity period of a code.
+	Period = 30 * time.Second
+
+	// Skew is the number of periods before and after the current one
+	// whose codes are also accepted, to tolerate the clock drifts.
+	Skew = 1
+
+	// SecretSize is the size in bytes of the generated secrets.
+	SecretSize = 20
+)
+
+var (
+	encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+)
+
+// NewSecret returns a new random secret, base32 encoded.
+func NewSecret() (string, error) {
+	b := make([]byte, SecretSize)
+	if _, err := io.ReadFull(rand.Reader, b); err != nil {
+		return "", err
+	}
+	return encoding.EncodeToString(b), nil
+}
+
+// Code returns the code of the period including the time tm.
+func Code(secret string, tm time.Time) (string, error) {
+	k, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+	return code(k, counter(tm)), nil
+}
+
+// Validate returns true if s is the code of the period including the time
+// tm, or of one of the Skew adjacent periods.
+//
+// Validate does not refuse the replay of a code. Use a Guard to validate
+// the codes presented by the users.
+func Validate(secret, s string, tm time.Time) bool {
+	_, ok := match(secret, s, tm)
+	return ok
+}
+
+// match returns the counter of the period whose code is s, searched in the
+// period including the time tm and the Skew adjacent periods.
+func match(secret, s string, tm time.Time) (uint64, bool) {
+	k, err := decodeSecret(secret)
+	if err != nil {
+		return 0, false
+	}
+	s = strings.TrimSpace(s)
+	if len(s) != Digits {
+		return 0, false
+	}
+	c := counter(tm)
+	for i := -Skew; i <= Skew; i++ {
+		if subtle.ConstantTimeCompare([]byte(code(k, c+uint64(i))), []byte(s)) == 1 {
+			return c + uint64(i), true
+		}
+	}
+	return 0, false
+}
+
+// URI returns the otpauth uri of the secret, to display as a QR code or to
+// paste in an authenticator application.
+func URI(issuer, account, secret string) string {
+	v := url.Values{}
+	v.Set("secret", secret)
+	v.Set("issuer", issuer)
+	v.Set("algorithm", "SHA1")
+	v.Set("digits", fmt.Sprint(Digits))
+	v.Set("period", fmt.Sprint(int(Period.Seconds())))
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + account,
+		RawQuery: v.Encode(),
+	}
+	return u.String()
+}
+
+func decodeSecret(secret string) ([]byte, error) {
+	s := strings.ToUpper(strings.ReplaceAll(secret, " ", ""))
+	s = strings.TrimRight(s, "=")
+	k, err := encoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid totp secret: %w", err)
+	}
+	return k, nil
+}
+
+func counter(tm time.Time) uint64 {
+	return uint64(tm.Unix() / int64(Period.Seconds()))
+}
+
+// code returns the HOTP value of the counter c, as defined by RFC 4226.
+func code(k []byte, c uint64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], c)
+	h := hmac.New(sha1.New, k)
+	h.Write(msg[:])
+	sum := h.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	v := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	mod := uint32(1)
+	for i := 0; i < Digits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", Digits, v%mod)
+}
@@ -0,0 +1,46 @@
+package totp
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCode(t *testing.T) {
+	// RFC 6238 appendix B test vectors, truncated to 6 digits.
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+	cases := map[int64]string{
+		59:          "287082",
+		1111111109:  "081804",
+		1111111111:  "050471",
+		1234567890:  "005924",
+		2000000000:  "279037",
+		20000000000: "353130",
+	}
+	for unix, expected := range cases {
+		s, err := Code(secret, time.Unix(unix, 0))
+		require.NoError(t, err)
+		assert.Equal(t, expected, s, "at %d", unix)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret, err := NewSecret()
+	require.NoError(t, err)
+	now := time.Now()
+	s, err := Code(secret, now)
+	require.NoError(t, err)
+	assert.True(t, Validate(secret, s, now))
+	assert.True(t, Validate(secret, s, now.Add(Period)), "accepted in the next period")
+	assert.False(t, Validate(secret, s, now.Add(3*Period)), "expired")
+	assert.False(t, Validate(secret, "", now))
+	assert.False(t, Validate("invalid!", s, now))
+}
+
+func TestURI(t *testing.T) {
+	s := URI("cluster1", "alice", "JBSWY3DPEHPK3PXP")
+	assert.Equal(t, "otpauth://totp/cluster1:alice?algorithm=SHA1&digits=6&issuer=cluster1&period=30&secret=JBSWY3DPEHPK3PXP", s)
+}
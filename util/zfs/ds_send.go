@@ -0,0 +1,72 @@
+package zfs
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/rs/zerolog"
+
+	"github.com/opensvc/om3/util/command"
+)
+
+// SnapshotRename renames the <ds>@<name> snapshot to <ds>@<newName>.
+func SnapshotRename(ds Dataset, name, newName string) error {
+	return snapshotRun(ds, "rename", ds.GetName()+"@"+name, ds.GetName()+"@"+newName)
+}
+
+// SnapshotSend sends the <ds>@<name> snapshot to the <dst> dataset, which
+// is created or overwritten and left unmounted. If <from> is not empty,
+// only the changes since the <ds>@<from> snapshot are sent, and <dst>
+// must have a <dst>@<from> snapshot received from <ds>.
+func SnapshotSend(ds Dataset, from, name, dst string) error {
+	sendArgs := []string{"send"}
+	if from != "" {
+		sendArgs = append(sendArgs, "-i", "@"+from)
+	}
+	sendArgs = append(sendArgs, ds.GetName()+"@"+name)
+	send := exec.Command("zfs", sendArgs...)
+	recv := exec.Command("zfs", "receive", "-F", "-u", dst)
+	if log := ds.GetLog(); log != nil {
+		log.Infof("%s | %s", send, recv)
+	}
+	var sendStderr, recvStderr bytes.Buffer
+	send.Stderr = &sendStderr
+	recv.Stderr = &recvStderr
+	pipe, err := send.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	recv.Stdin = pipe
+	if err := recv.Start(); err != nil {
+		return err
+	}
+	if err := send.Run(); err != nil {
+		_ = recv.Wait()
+		return fmt.Errorf("%s: %w: %s", send, err, strings.TrimSpace(sendStderr.String()))
+	}
+	if err := recv.Wait(); err != nil {
+		return fmt.Errorf("%s: %w: %s", recv, err, strings.TrimSpace(recvStderr.String()))
+	}
+	return nil
+}
+
+// SnapshotExists returns true if the <ds>@<name> snapshot exists.
+func SnapshotExists(ds Dataset, name string) (bool, error) {
+	cmd := command.New(
+		command.WithName("zfs"),
+		command.WithVarArgs("list", "-H", "-t", "snapshot", ds.GetName()+"@"+name),
+		command.WithLogger(ds.GetLog()),
+		command.WithBufferedStderr(),
+		command.WithCommandLogLevel(zerolog.DebugLevel),
+	)
+	err := cmd.Run()
+	if err == nil {
+		return true, nil
+	} else if b := cmd.Stderr(); strings.Contains(string(b), "does not exist") {
+		return false, nil
+	} else {
+		return false, err
+	}
+}
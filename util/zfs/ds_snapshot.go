@@ -0,0 +1,115 @@
+package zfs
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/opensvc/om3/util/command"
+)
+
+type (
+	// SnapshotInfo is a parsed line of the 'zfs list -t snapshot' command.
+	SnapshotInfo struct {
+		Name     string
+		Dataset  string
+		Creation time.Time
+		Used     int64
+	}
+)
+
+// SnapshotCreate creates the <ds>@<name> snapshot.
+func SnapshotCreate(ds Dataset, name string) error {
+	return snapshotRun(ds, "snapshot", ds.GetName()+"@"+name)
+}
+
+// SnapshotDestroy destroys the <ds>@<name> snapshot.
+func SnapshotDestroy(ds Dataset, name string) error {
+	return snapshotRun(ds, "destroy", ds.GetName()+"@"+name)
+}
+
+// SnapshotRollback rolls the dataset back to the <ds>@<name> snapshot,
+// destroying the more recent snapshots.
+func SnapshotRollback(ds Dataset, name string) error {
+	return snapshotRun(ds, "rollback", "-r", ds.GetName()+"@"+name)
+}
+
+// SnapshotClone creates the <dst> dataset as a clone of the <ds>@<name>
+// snapshot, with the <props> "property=value" properties set.
+func SnapshotClone(ds Dataset, name, dst string, props ...string) error {
+	args := []string{"clone"}
+	for _, prop := range props {
+		args = append(args, "-o", prop)
+	}
+	args = append(args, ds.GetName()+"@"+name, dst)
+	return snapshotRun(ds, args...)
+}
+
+// SnapshotList returns the snapshots of the dataset, oldest first.
+func SnapshotList(ds Dataset) ([]SnapshotInfo, error) {
+	cmd := command.New(
+		command.WithName("zfs"),
+		command.WithVarArgs("list", "-H", "-p", "-t", "snapshot", "-d", "1", "-s", "creation", "-o", "name,creation,used", ds.GetName()),
+		command.WithBufferedStdout(),
+		command.WithLogger(ds.GetLog()),
+		command.WithCommandLogLevel(zerolog.DebugLevel),
+		command.WithStdoutLogLevel(zerolog.DebugLevel),
+		command.WithStderrLogLevel(zerolog.DebugLevel),
+	)
+	b, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+	return parseSnapshotList(b)
+}
+
+func parseSnapshotList(b []byte) ([]SnapshotInfo, error) {
+	l := make([]SnapshotInfo, 0)
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("unexpected 'zfs list -t snapshot' line: %s", line)
+		}
+		dataset, name, ok := strings.Cut(fields[0], "@")
+		if !ok {
+			return nil, fmt.Errorf("unexpected snapshot name: %s", fields[0])
+		}
+		creation, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("snapshot %s creation: %w", fields[0], err)
+		}
+		used, err := strconv.ParseInt(fields[2], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("snapshot %s used: %w", fields[0], err)
+		}
+		l = append(l, SnapshotInfo{
+			Name:     name,
+			Dataset:  dataset,
+			Creation: time.Unix(creation, 0),
+			Used:     used,
+		})
+	}
+	return l, nil
+}
+
+func snapshotRun(ds Dataset, args ...string) error {
+	cmd := command.New(
+		command.WithName("zfs"),
+		command.WithArgs(args),
+		command.WithLogger(ds.GetLog()),
+		command.WithCommandLogLevel(zerolog.InfoLevel),
+		command.WithStdoutLogLevel(zerolog.InfoLevel),
+		command.WithStderrLogLevel(zerolog.ErrorLevel),
+	)
+	return cmd.Run()
+}
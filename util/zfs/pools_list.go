@@ -0,0 +1,73 @@
+package zfs
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog"
+
+	"github.com/opensvc/om3/util/command"
+)
+
+type (
+	// PoolListItem represents a parsed line of the 'zpool list' command.
+	PoolListItem struct {
+		Name   string
+		Size   int64
+		Alloc  int64
+		Free   int64
+		Health string
+	}
+)
+
+// UsedPercent returns the percentage of allocated space of the pool.
+func (t PoolListItem) UsedPercent() int64 {
+	if t.Size == 0 {
+		return 0
+	}
+	return 100 * t.Alloc / t.Size
+}
+
+func parsePoolList(b []byte) ([]PoolListItem, error) {
+	data := make([]PoolListItem, 0)
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for scanner.Scan() {
+		l := strings.Fields(scanner.Text())
+		if len(l) == 0 {
+			continue
+		}
+		if len(l) != 5 {
+			return data, fmt.Errorf("unexpected 'zpool list' line: %s", scanner.Text())
+		}
+		item := PoolListItem{Name: l[0], Health: l[4]}
+		for i, p := range []*int64{&item.Size, &item.Alloc, &item.Free} {
+			if v, err := strconv.ParseInt(l[i+1], 10, 64); err != nil {
+				return data, fmt.Errorf("unexpected 'zpool list' value in line: %s", scanner.Text())
+			} else {
+				*p = v
+			}
+		}
+		data = append(data, item)
+	}
+	return data, scanner.Err()
+}
+
+// ListPools returns the size, usage and health of the imported pools.
+func ListPools() ([]PoolListItem, error) {
+	cmd := command.New(
+		command.WithName("zpool"),
+		command.WithVarArgs("list", "-Hp", "-o", "name,size,alloc,free,health"),
+		command.WithBufferedStdout(),
+		command.WithCommandLogLevel(zerolog.DebugLevel),
+		command.WithStdoutLogLevel(zerolog.DebugLevel),
+		command.WithStderrLogLevel(zerolog.DebugLevel),
+	)
+	b, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+	return parsePoolList(b)
+}
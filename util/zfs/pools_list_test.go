@@ -0,0 +1,27 @@
+package zfs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePoolList(t *testing.T) {
+	b := []byte("rpool\t16106127360\t4294967296\t11811160064\tONLINE\n" +
+		"tank\t107374182400\t96636764160\t10737418240\tDEGRADED\n" +
+		"\n")
+	l, err := parsePoolList(b)
+	require.NoError(t, err)
+	assert.Equal(t, []PoolListItem{
+		{Name: "rpool", Size: 16106127360, Alloc: 4294967296, Free: 11811160064, Health: "ONLINE"},
+		{Name: "tank", Size: 107374182400, Alloc: 96636764160, Free: 10737418240, Health: "DEGRADED"},
+	}, l)
+	assert.Equal(t, int64(26), l[0].UsedPercent())
+	assert.Equal(t, int64(90), l[1].UsedPercent())
+
+	_, err = parsePoolList([]byte("rpool\t15G\t4G\t11G\tONLINE\n"))
+	assert.Error(t, err, "human readable sizes")
+	_, err = parsePoolList([]byte("rpool\t16106127360\tONLINE\n"))
+	assert.Error(t, err, "missing columns")
+}